@@ -0,0 +1,150 @@
+package main
+
+import (
+	"sort"
+	"strings"
+)
+
+// architecture is one GOARCH with its levels and how its code is decoded and classified.
+// AssemblyMode counts the levels from 1, the highest level found is the verdict.
+type architecture struct {
+	Name     string   // GOARCH, as -arch and Go object headers spell it
+	Variable string   // environment variable that selects the level, GOAMD64 or GOARM64
+	Levels   []string // levels from the lowest, as Variable spells them
+	Labels   []string // headings of the levels in the statistics and the summary table, Levels when nil
+	Columns  int      // levels the summary table always has columns for
+	Check    string   // what code checks at run time before it uses an extension
+
+	// the level of each feature, features missing here and in Options are extensions
+	Features map[string]AssemblyMode
+
+	// features that Variable enables with an option, such as GOARM64=v8.0,lse
+	Options map[string]option
+
+	Decode   func(code []byte, base uint64, symbols []symbol, bits int, analysis *Analysis)
+	Classify func(analysis *Analysis, tokens []string, context string) (AssemblyMode, string, []string, int)
+}
+
+// option is a suffix of the level setting that enables a feature the level doesn't have.
+type option struct {
+	Name  string
+	Level AssemblyMode // level that implies the option, na when none does
+}
+
+var amd64Arch = &architecture{
+	Name:     "amd64",
+	Variable: "GOAMD64",
+	Levels:   []string{"v1", "v2", "v3", "v4", "apx"},
+	Labels:   []string{"x86", "v2", "v3", "v4", "apx"},
+	Columns:  5,
+	Check:    "CPUID",
+	Features: featureLevels,
+	Decode:   decodeX86,
+	Classify: (*Analysis).Classify,
+}
+
+// Architectures in the order of the summary tables.
+var architectures = []*architecture{amd64Arch, arm64Arch}
+
+// findArch returns the architecture of a GOARCH.
+func findArch(name string) (*architecture, bool) {
+	for _, arch := range architectures {
+		if arch.Name == name {
+			return arch, true
+		}
+	}
+
+	return nil, false
+}
+
+// LevelName spells mode as the level setting of the architecture does.
+func (arch *architecture) LevelName(mode AssemblyMode) string {
+	if mode < v1 || int(mode) > len(arch.Levels) {
+		return mode.String()
+	}
+
+	return arch.Levels[mode-1]
+}
+
+// Label is the heading of the level at index i in the statistics and the summary table.
+func (arch *architecture) Label(i int) string {
+	if arch.Labels == nil {
+		return arch.Levels[i]
+	}
+
+	return arch.Labels[i]
+}
+
+// Setting is the level setting for mode together with the options that
+// the features counted need and mode doesn't imply, as in v8.0,crypto,lse.
+func (arch *architecture) Setting(mode AssemblyMode, features map[string]int) string {
+	seen := make(map[string]bool)
+	var options []string
+	for feature := range features {
+		option, ok := arch.Options[feature]
+		if ok && !seen[option.Name] && (option.Level == na || mode < option.Level) {
+			seen[option.Name] = true
+			options = append(options, option.Name)
+		}
+	}
+
+	sort.Strings(options)
+	return strings.Join(append([]string{arch.LevelName(mode)}, options...), ",")
+}
+
+// isExtension tells the features outside the levels and options of the architecture,
+// such as AES, SHA or ADX on amd64. Code that uses them has to check for them first.
+func (arch *architecture) isExtension(feature string) bool {
+	_, level := arch.Features[feature]
+	_, option := arch.Options[feature]
+	return !level && !option
+}
+
+// featureLevel is the highest level among features.
+func (arch *architecture) featureLevel(features []string) AssemblyMode {
+	var level AssemblyMode = v1
+	for _, feature := range features {
+		if arch.Features[feature] > level {
+			level = arch.Features[feature]
+		}
+	}
+
+	return level
+}
+
+// sortedFeatures lists the features counted in counts that are extensions
+// when extension is true and part of a level or an option otherwise, without the baseline.
+func (arch *architecture) sortedFeatures(counts map[string]int, extension bool) []string {
+	features := make([]string, 0, len(counts))
+	for feature := range counts {
+		if feature != "" && arch.isExtension(feature) == extension {
+			features = append(features, feature)
+		}
+	}
+
+	sort.Strings(features)
+	return features
+}
+
+// SetArch switches the analysis to arch before anything is counted.
+func (analysis *Analysis) SetArch(arch *architecture) {
+	if analysis.Arch == arch {
+		return
+	}
+
+	analysis.Arch = arch
+	analysis.Operations = make([]int, len(arch.Levels))
+	analysis.Counts = make([]map[string]int, len(arch.Levels))
+	for i := range analysis.Counts {
+		analysis.Counts[i] = make(map[string]int)
+	}
+}
+
+// Level is the GO386 setting of 32-bit code and the level setting of the architecture otherwise.
+func (analysis *Analysis) Level() string {
+	if analysis.Bits == 32 {
+		return go386Names[analysis.go386Setting()]
+	}
+
+	return analysis.Arch.Setting(analysis.Mode, analysis.Features)
+}
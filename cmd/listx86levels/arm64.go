@@ -0,0 +1,344 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/arch/arm64/arm64asm"
+)
+
+// The GOARM64 levels, the Armv8.x and Armv9.x architecture versions.
+const (
+	v8_0 AssemblyMode = iota + 1
+	v8_1
+	v8_2
+	v8_3
+	v8_4
+	v8_5
+	v8_6
+	v8_7
+	v8_8
+	v8_9
+	v9_0
+	v9_1
+	v9_2
+	v9_3
+	v9_4
+	v9_5
+)
+
+var arm64Arch = &architecture{
+	Name:     "arm64",
+	Variable: "GOARM64",
+	Levels:   []string{"v8.0", "v8.1", "v8.2", "v8.3", "v8.4", "v8.5", "v8.6", "v8.7", "v8.8", "v8.9", "v9.0", "v9.1", "v9.2", "v9.3", "v9.4", "v9.5"},
+	Columns:  1,
+	Check:    "HWCAP",
+	Features: arm64FeatureLevels,
+	Options:  arm64Options,
+	Decode:   decodeARM64,
+	Classify: (*Analysis).classifyARM64Line,
+}
+
+// The architecture version that makes each feature mandatory. Armv9.x includes Armv8.(x+5),
+// so no feature here needs more than v8.9; what Armv9 adds, SVE2 and SME, are extensions.
+var arm64FeatureLevels = map[string]AssemblyMode{
+	"": v8_0,
+
+	"CRC32": v8_1,
+	"LOR":   v8_1,
+	"RDM":   v8_1,
+
+	"DPB": v8_2,
+
+	"FCMA":  v8_3,
+	"JSCVT": v8_3,
+	"LRCPC": v8_3,
+	"PAUTH": v8_3,
+
+	"DOTPROD": v8_4,
+	"FLAGM":   v8_4,
+	"LRCPC2":  v8_4,
+
+	"DPB2":    v8_5,
+	"FLAGM2":  v8_5,
+	"FRINTTS": v8_5,
+	"SB":      v8_5,
+
+	"BF16": v8_6,
+	"I8MM": v8_6,
+
+	"WFXT": v8_7,
+
+	"HBC":  v8_8,
+	"MOPS": v8_8,
+
+	"CSSC": v8_9,
+}
+
+// The features that the GOARM64 options enable. LSE comes with v8.1, the crypto
+// instructions with no level, as they are optional in every architecture version.
+var arm64Options = map[string]option{
+	"LSE":   {Name: "lse", Level: v8_1},
+	"AES":   {Name: "crypto"},
+	"PMULL": {Name: "crypto"},
+	"SHA1":  {Name: "crypto"},
+	"SHA2":  {Name: "crypto"},
+}
+
+// arm64Encoding is a group of instructions that needs one feature, matched by the bits that encode it.
+type arm64Encoding struct {
+	Mask    uint32
+	Value   uint32
+	Name    string
+	Feature string
+}
+
+// The instructions newer than Armv8.0 and the optional ones, which arm64asm mostly
+// doesn't decode. The first match counts. The hint instructions of pointer
+// authentication and BTI, such as PACIASP, run as NOP on older processors and
+// are part of the baseline.
+var arm64Encodings = []arm64Encoding{
+	// LS64 shares the encodings of the atomic memory operations
+	{0xFFFFFC00, 0xF83FD000, "LD64B", "LS64"},
+	{0xFFFFFC00, 0xF83F9000, "ST64B", "LS64"},
+	{0xFFE0FC00, 0xF820B000, "ST64BV", "LS64"},
+	{0xFFE0FC00, 0xF820A000, "ST64BV0", "LS64"},
+	{0x3FFFFC00, 0x38BFC000, "LDAPR", "LRCPC"},
+
+	{0x3F20FC00, 0x38200000, "LDADD", "LSE"},
+	{0x3F20FC00, 0x38201000, "LDCLR", "LSE"},
+	{0x3F20FC00, 0x38202000, "LDEOR", "LSE"},
+	{0x3F20FC00, 0x38203000, "LDSET", "LSE"},
+	{0x3F20FC00, 0x38204000, "LDSMAX", "LSE"},
+	{0x3F20FC00, 0x38205000, "LDSMIN", "LSE"},
+	{0x3F20FC00, 0x38206000, "LDUMAX", "LSE"},
+	{0x3F20FC00, 0x38207000, "LDUMIN", "LSE"},
+	{0x3F20FC00, 0x38208000, "SWP", "LSE"},
+	{0x3FA07C00, 0x08A07C00, "CAS", "LSE"},
+	{0xBFA07C00, 0x08207C00, "CASP", "LSE"},
+
+	{0x3FFFFC00, 0x08DF7C00, "LDLAR", "LOR"},
+	{0x3FFFFC00, 0x089F7C00, "STLLR", "LOR"},
+	{0x3FE00C00, 0x19000000, "STLUR", "LRCPC2"},
+	{0x3F200C00, 0x19000000, "LDAPUR", "LRCPC2"},
+	{0x3BE00C00, 0x19C00400, "SETP", "MOPS"},
+	{0x3B200C00, 0x19000400, "CPYP", "MOPS"},
+
+	{0x7FE0F000, 0x1AC04000, "CRC32", "CRC32"},
+	{0x7FE0F000, 0x1AC05000, "CRC32C", "CRC32"},
+
+	{0xBF20FC00, 0x2E008400, "SQRDMLAH", "RDM"},
+	{0xBF20FC00, 0x2E008C00, "SQRDMLSH", "RDM"},
+	{0xFF20FC00, 0x7E008400, "SQRDMLAH", "RDM"},
+	{0xFF20FC00, 0x7E008C00, "SQRDMLSH", "RDM"},
+	{0xBF00F400, 0x2F00D000, "SQRDMLAH", "RDM"},
+	{0xBF00F400, 0x2F00F000, "SQRDMLSH", "RDM"},
+	{0xFF00F400, 0x7F00D000, "SQRDMLAH", "RDM"},
+	{0xFF00F400, 0x7F00F000, "SQRDMLSH", "RDM"},
+
+	{0xFFFFFFE0, 0xD50B7C20, "DC CVAP", "DPB"},
+	{0xFFFFFFE0, 0xD50B7D20, "DC CVADP", "DPB2"},
+
+	{0xFFFF0000, 0xDAC10000, "PAC", "PAUTH"},
+	{0xFFE0FC00, 0x9AC03000, "PACGA", "PAUTH"},
+	{0xFEFFF800, 0xD61F0800, "BRAA", "PAUTH"},
+	{0xFEFFF800, 0xD63F0800, "BLRAA", "PAUTH"},
+	{0xFE1FF800, 0xD61F0800, "RETAA", "PAUTH"},
+	{0xFF200400, 0xF8200400, "LDRAA", "PAUTH"},
+	{0xFFFFFC00, 0x1E7E0000, "FJCVTZS", "JSCVT"},
+	{0xBF20E400, 0x2E00C400, "FCMLA", "FCMA"},
+	{0xBF20EC00, 0x2E00E400, "FCADD", "FCMA"},
+
+	{0xBFE0FC00, 0x0E809400, "SDOT", "DOTPROD"},
+	{0xBFE0FC00, 0x2E809400, "UDOT", "DOTPROD"},
+	{0xBFC0F400, 0x0F80E000, "SDOT", "DOTPROD"},
+	{0xBFC0F400, 0x2F80E000, "UDOT", "DOTPROD"},
+	{0xFFFFFFFF, 0xD500401F, "CFINV", "FLAGM"},
+	{0xFFE07C10, 0xBA000400, "RMIF", "FLAGM"},
+	{0xFFFFBC1F, 0x3A00080D, "SETF", "FLAGM"},
+
+	{0xFFFFFFFF, 0xD500403F, "XAFLAG", "FLAGM2"},
+	{0xFFFFFFFF, 0xD500405F, "AXFLAG", "FLAGM2"},
+	{0xFFBFFC00, 0x1E284000, "FRINT32Z", "FRINTTS"},
+	{0xFFBFFC00, 0x1E28C000, "FRINT32X", "FRINTTS"},
+	{0xFFBFFC00, 0x1E294000, "FRINT64Z", "FRINTTS"},
+	{0xFFBFFC00, 0x1E29C000, "FRINT64X", "FRINTTS"},
+	{0xFFFFFFFF, 0xD50330FF, "SB", "SB"},
+
+	{0xBFE0FC00, 0x2E40FC00, "BFDOT", "BF16"},
+	{0xBFC0F400, 0x0F40F000, "BFDOT", "BF16"},
+	{0xFFE0FC00, 0x6E40EC00, "BFMMLA", "BF16"},
+	{0xBFE0FC00, 0x2EC0FC00, "BFMLAL", "BF16"},
+	{0xFFFFFC00, 0x1E634000, "BFCVT", "BF16"},
+	{0xBFFFFC00, 0x0EA16800, "BFCVTN", "BF16"},
+	{0xFFE0FC00, 0x4E80A400, "SMMLA", "I8MM"},
+	{0xFFE0FC00, 0x6E80A400, "UMMLA", "I8MM"},
+	{0xFFE0FC00, 0x4E80AC00, "USMMLA", "I8MM"},
+	{0xBFE0FC00, 0x0E809C00, "USDOT", "I8MM"},
+	{0xBFC0F400, 0x0F00F000, "SUDOT", "I8MM"},
+	{0xBFC0F400, 0x0F80F000, "USDOT", "I8MM"},
+
+	{0xFFFFFFE0, 0xD5031000, "WFET", "WFXT"},
+	{0xFFFFFFE0, 0xD5031020, "WFIT", "WFXT"},
+	{0xFF000010, 0x54000010, "BC", "HBC"},
+
+	{0x7FFFFC00, 0x5AC01800, "CTZ", "CSSC"},
+	{0x7FFFFC00, 0x5AC01C00, "CNT", "CSSC"},
+	{0x7FFFFC00, 0x5AC02000, "ABS", "CSSC"},
+	{0x7FE0FC00, 0x1AC06000, "SMAX", "CSSC"},
+	{0x7FE0FC00, 0x1AC06400, "UMAX", "CSSC"},
+	{0x7FE0FC00, 0x1AC06800, "SMIN", "CSSC"},
+	{0x7FE0FC00, 0x1AC06C00, "UMIN", "CSSC"},
+	{0x7FFC0000, 0x11C00000, "SMAX", "CSSC"},
+	{0x7FFC0000, 0x11C40000, "UMAX", "CSSC"},
+	{0x7FFC0000, 0x11C80000, "SMIN", "CSSC"},
+	{0x7FFC0000, 0x11CC0000, "UMIN", "CSSC"},
+
+	// GOARM64=crypto
+	{0xFFFFFC00, 0x4E284800, "AESE", "AES"},
+	{0xFFFFFC00, 0x4E285800, "AESD", "AES"},
+	{0xFFFFFC00, 0x4E286800, "AESMC", "AES"},
+	{0xFFFFFC00, 0x4E287800, "AESIMC", "AES"},
+	{0xBFE0FC00, 0x0EE0E000, "PMULL", "PMULL"},
+	{0xFFE0FC00, 0x5E000000, "SHA1C", "SHA1"},
+	{0xFFE0FC00, 0x5E001000, "SHA1P", "SHA1"},
+	{0xFFE0FC00, 0x5E002000, "SHA1M", "SHA1"},
+	{0xFFE0FC00, 0x5E003000, "SHA1SU0", "SHA1"},
+	{0xFFFFFC00, 0x5E280800, "SHA1H", "SHA1"},
+	{0xFFFFFC00, 0x5E281800, "SHA1SU1", "SHA1"},
+	{0xFFE0FC00, 0x5E004000, "SHA256H", "SHA2"},
+	{0xFFE0FC00, 0x5E005000, "SHA256H2", "SHA2"},
+	{0xFFE0FC00, 0x5E006000, "SHA256SU1", "SHA2"},
+	{0xFFFFFC00, 0x5E282800, "SHA256SU0", "SHA2"},
+
+	// extensions
+	{0xFFE0FC00, 0xCE608000, "SHA512H", "SHA512"},
+	{0xFFE0FC00, 0xCE608400, "SHA512H2", "SHA512"},
+	{0xFFE0FC00, 0xCE608800, "SHA512SU1", "SHA512"},
+	{0xFFFFFC00, 0xCEC08000, "SHA512SU0", "SHA512"},
+	{0xFFE0FC00, 0xCE608C00, "RAX1", "SHA3"},
+	{0xFFE08000, 0xCE000000, "EOR3", "SHA3"},
+	{0xFFE08000, 0xCE200000, "BCAX", "SHA3"},
+	{0xFFE00000, 0xCE800000, "XAR", "SHA3"},
+	{0xFFE08000, 0xCE400000, "SM3SS1", "SM3"},
+	{0xFFE0C000, 0xCE408000, "SM3TT", "SM3"},
+	{0xFFE0FC00, 0xCE60C000, "SM3PARTW1", "SM3"},
+	{0xFFE0FC00, 0xCE60C400, "SM3PARTW2", "SM3"},
+	{0xFFFFFC00, 0xCEC08400, "SM4E", "SM4"},
+	{0xFFE0FC00, 0xCE60C800, "SM4EKEY", "SM4"},
+	{0xFFE0FC00, 0x9AC01000, "IRG", "MTE"},
+	{0xFFE0FC00, 0x9AC01400, "GMI", "MTE"},
+	{0xDFE0FC00, 0x9AC00000, "SUBP", "MTE"},
+	{0xFFC0C000, 0x91800000, "ADDG", "MTE"},
+	{0xFFC0C000, 0xD1800000, "SUBG", "MTE"},
+	{0xFF200000, 0xD9200000, "STG", "MTE"},
+	{0xFFFFFFC0, 0xD53B2400, "RNDR", "RNG"},
+}
+
+// The SVE and SME encoding spaces. They are large enough that the literal pools Go
+// places behind a function often fall into them, so they only count in reachable code.
+var arm64Spaces = []arm64Encoding{
+	{0x1E000000, 0x04000000, "SVE", "SVE"},
+	{0x9E000000, 0x80000000, "SME", "SME"},
+}
+
+// decodeWord returns the name of one arm64 instruction, its features and its text in Go
+// syntax. Words that are neither known nor decoded are not code, nor are the SVE and SME
+// spaces when reachable is false.
+func decodeWord(word uint32, addr uint64, reachable bool) (string, []string, string, bool) {
+	for _, encoding := range arm64Encodings {
+		if word&encoding.Mask == encoding.Value {
+			return encoding.Name, []string{encoding.Feature}, encoding.Name, true
+		}
+	}
+
+	code := make([]byte, 4)
+	binary.LittleEndian.PutUint32(code, word)
+	inst, err := arm64asm.Decode(code)
+	if err != nil {
+		for _, space := range arm64Spaces {
+			if reachable && word&space.Mask == space.Value {
+				return space.Name, []string{space.Feature}, space.Name, true
+			}
+		}
+		return "", nil, "?", false
+	}
+
+	text := arm64asm.GoSyntax(inst, addr, nil, nil)
+	return strings.Fields(text)[0], nil, text, true
+}
+
+// classifyWord returns the level of one arm64 instruction, its name, features and text.
+func (analysis *Analysis) classifyWord(word uint32, addr uint64, function string, context string) (AssemblyMode, string, []string, string) {
+	instruction, features, text, ok := decodeWord(word, addr, !analysis.afterBranch)
+	if !ok {
+		return na, "", nil, text
+	}
+
+	// JMP is B and BR, calls such as BL, BLR and BLRAA return to the next word
+	analysis.afterBranch = instruction == "JMP" || instruction == "RET" || instruction == "BRAA" || instruction == "RETAA"
+
+	mode := analysis.Arch.featureLevel(features)
+	if analysis.Verbose && mode > v8_0 {
+		fmt.Printf("Found %s instruction %s (%s) in function %s %s\n", analysis.Arch.LevelName(mode), instruction, strings.Join(features, ","), function, context)
+	}
+
+	return mode, instruction, features, text
+}
+
+// decodeARM64 classifies every instruction word of code loaded at base. symbols must be sorted by address.
+func decodeARM64(code []byte, base uint64, symbols []symbol, bits int, analysis *Analysis) {
+	var context string = ""
+	next := 0
+	for pc := 0; pc+4 <= len(code); pc += 4 {
+		addr := base + uint64(pc)
+		for next < len(symbols) && symbols[next].Addr <= addr {
+			context = symbols[next].Name
+			next++
+		}
+
+		mode, instruction, features, text := analysis.classifyWord(binary.LittleEndian.Uint32(code[pc:]), addr, fmt.Sprintf("%#x", addr), context)
+		analysis.Count(mode, instruction, features, 0, context)
+		analysis.List(addr, code[pc:pc+4], mode, features, text)
+	}
+}
+
+// go tool objdump prints arm64 instructions as a word of eight hex digits, as in f9400b90.
+var arm64Word = regexp.MustCompile(`^[0-9a-f]{8}$`)
+
+// classifyARM64Line classifies the instruction word on one line of go tool objdump output.
+func (analysis *Analysis) classifyARM64Line(tokens []string, context string) (AssemblyMode, string, []string, int) {
+	for _, token := range tokens[1:] {
+		if !arm64Word.MatchString(token) {
+			continue
+		}
+
+		word, _ := strconv.ParseUint(token, 16, 32)
+		mode, instruction, features, _ := analysis.classifyWord(uint32(word), 0, tokens[0], context)
+		return mode, instruction, features, 0
+	}
+
+	return na, "", nil, 0
+}
+
+// isARM64Objdump tells go tool objdump output for arm64 from amd64 by the code column,
+// which holds words of eight hex digits on every line, where x86 instructions vary in length.
+func isARM64Objdump(head []byte) bool {
+	words := 0
+	for _, line := range strings.Split(string(head), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || !strings.HasPrefix(fields[1], "0x") {
+			continue
+		}
+
+		if !arm64Word.MatchString(fields[2]) {
+			return false
+		}
+		words++
+	}
+
+	return words > 0
+}
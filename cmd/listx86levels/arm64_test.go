@@ -0,0 +1,81 @@
+package main
+
+import "testing"
+
+func TestDecodeWord(t *testing.T) {
+	tests := []struct {
+		word        uint32
+		reachable   bool
+		instruction string
+		feature     string
+		setting     string
+		ok          bool
+	}{
+		// LD64B shares its encoding with LDADD, the first match wins
+		{0xF83FD020, true, "LD64B", "LS64", "v8.0", true},
+		{0xB8210040, true, "LDADD", "LSE", "v8.0,lse", true},
+		{0xC8E1FC62, true, "CAS", "LSE", "v8.0,lse", true},
+		{0xB8BFC020, true, "LDAPR", "LRCPC", "v8.3", true},
+		{0x9AC25C20, true, "CRC32C", "CRC32", "v8.1", true},
+		{0x4E284820, true, "AESE", "AES", "v8.0,crypto", true},
+		{0x0EE2E020, true, "PMULL", "PMULL", "v8.0,crypto", true},
+		{0x5E024020, true, "SHA256H", "SHA2", "v8.0,crypto", true},
+		{0xCE628020, true, "SHA512H", "SHA512", "v8.0", true},
+		{0xCE020020, true, "EOR3", "SHA3", "v8.0", true},
+		{0xD53B2400, true, "RNDR", "RNG", "v8.0", true},
+
+		// ADD X2, X1, X0 is left to arm64asm
+		{0x8B020020, true, "ADD", "", "v8.0", true},
+
+		// ADD Z0.S, Z0.S, Z0.S counts as SVE only where code can be reached
+		{0x04A00000, true, "SVE", "SVE", "v8.0", true},
+		{0x04A00000, false, "", "", "", false},
+	}
+
+	for _, test := range tests {
+		instruction, features, _, ok := decodeWord(test.word, 0, test.reachable)
+		feature := ""
+		if len(features) > 0 {
+			feature = features[0]
+		}
+
+		if instruction != test.instruction || feature != test.feature || ok != test.ok {
+			t.Errorf("decodeWord(%#08x, %v) = %q, %v, %v, want %q, %q, %v", test.word, test.reachable, instruction, features, ok, test.instruction, test.feature, test.ok)
+			continue
+		}
+
+		if ok {
+			counts := map[string]int{feature: 1}
+			if setting := arm64Arch.Setting(arm64Arch.featureLevel(features), counts); setting != test.setting {
+				t.Errorf("decodeWord(%#08x) needs GOARM64=%s, want %s", test.word, setting, test.setting)
+			}
+		}
+	}
+}
+
+func TestAfterBranch(t *testing.T) {
+	tests := []struct {
+		word        uint32
+		afterBranch bool
+	}{
+		{0x14000010, true},  // B
+		{0x94000010, false}, // BL
+		{0xD61F0020, true},  // BR X1
+		{0xD63F0020, false}, // BLR X1
+		{0xD61F0820, true},  // BRAA X1, X0
+		{0xD63F0820, false}, // BLRAA X1, X0
+		{0xD63F083F, false}, // BLRAAZ X1
+		{0xD65F03C0, true},  // RET
+		{0xD65F0BFF, true},  // RETAA
+		{0x54000040, false}, // B.EQ
+	}
+
+	for _, test := range tests {
+		analysis := NewAnalysis(false)
+		analysis.Arch = arm64Arch
+		analysis.classifyWord(test.word, 0x1000, "f", "")
+		if analysis.afterBranch != test.afterBranch {
+			t.Errorf("classifyWord(%#08x) leaves afterBranch %v, want %v", test.word, analysis.afterBranch, test.afterBranch)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+// 0000000000401000 <_init>: or <_init>:
+var gnuFunctionHeader = regexp.MustCompile(`^(?:[0-9a-fA-F]+ )?<([^>]+)>:\s*$`)
+
+// Instruction prefixes that GNU objdump prints in front of the mnemonic, sorted.
+// {vex} marks the VEX forms of instructions that have EVEX forms too, such as AVX-VNNI.
+var gnuPrefixes = []string{
+	"addr32",
+	"bnd",
+	"cs",
+	"data16",
+	"ds",
+	"es",
+	"fs",
+	"gs",
+	"lock",
+	"notrack",
+	"rep",
+	"repe",
+	"repne",
+	"repnz",
+	"repz",
+	"ss",
+	"xacquire",
+	"xrelease",
+	"{evex}",
+	"{vex3}",
+	"{vex}",
+}
+
+// AT&T mnemonics that are not a size suffix away from their Intel name.
+var attMnemonics = map[string]string{
+	"CBTW":   "CBW",
+	"CLTD":   "CDQ",
+	"CLTQ":   "CDQE",
+	"CQTO":   "CQO",
+	"CWTD":   "CWD",
+	"CWTL":   "CWDE",
+	"MOVABS": "MOV",
+	"MOVSBL": "MOVSX",
+	"MOVSBQ": "MOVSX",
+	"MOVSBW": "MOVSX",
+	"MOVSLQ": "MOVSXD",
+	"MOVSWL": "MOVSX",
+	"MOVSWQ": "MOVSX",
+	"MOVZBL": "MOVZX",
+	"MOVZBQ": "MOVZX",
+	"MOVZBW": "MOVZX",
+	"MOVZWL": "MOVZX",
+	"MOVZWQ": "MOVZX",
+}
+
+// objdump spells the comparison predicate and the PCLMULQDQ immediate into the mnemonic,
+// cmpltps for CMPPS with 1 and vpclmulhqlqdq for VPCLMULQDQ with 0x01. Each pattern
+// captures the two halves of the base mnemonic around the predicate.
+var mnemonicAliases = []*regexp.Regexp{
+	regexp.MustCompile(`^(CMP)(?:EQ|LT|LE|UNORD|NEQ|NLT|NLE|ORD)(PS|PD|SS|SD)$`),
+	regexp.MustCompile(`^(VCMP)(?:EQ|LT|LE|UNORD|NEQ|NLT|NLE|ORD|NGE|NGT|FALSE|GE|GT|TRUE)(?:_[OU][QS]|_S)?(PS|PD|SS|SD|PH|SH)$`),
+	regexp.MustCompile(`^(VPCMP)(?:EQ|LT|LE|FALSE|NEQ|NLT|NLE|TRUE)(U?[BWDQ])$`),
+	regexp.MustCompile(`^(V?PCLMUL)(?:LQ|HQ)[LH](QDQ)$`),
+}
+
+var vectorRegisterPrefixes = map[string]string{
+	"XMM": "X",
+	"YMM": "Y",
+	"ZMM": "Z",
+}
+
+func knownMnemonic(mnemonic string) bool {
+	_, ok := mnemonicForms[mnemonic]
+	return ok
+}
+
+// normalizeMnemonic maps a GNU mnemonic onto the names in the instruction tables.
+// AT&T adds a b, w, l or q size suffix which is dropped when that gives a known name,
+// and the Go assembler the EVEX suffixes such as .Z, .BCST and .SAE which are dropped.
+func normalizeMnemonic(mnemonic string) string {
+	mnemonic = strings.ToUpper(mnemonic)
+	if i := strings.IndexByte(mnemonic, '.'); i > 0 {
+		mnemonic = mnemonic[:i]
+	}
+	if name, ok := attMnemonics[mnemonic]; ok {
+		return name
+	}
+
+	if knownMnemonic(mnemonic) {
+		return mnemonic
+	}
+
+	for _, alias := range mnemonicAliases {
+		if match := alias.FindStringSubmatch(mnemonic); match != nil && knownMnemonic(match[1]+match[2]) {
+			return match[1] + match[2]
+		}
+	}
+
+	if n := len(mnemonic); n > 1 && strings.ContainsRune("BWLQ", rune(mnemonic[n-1])) {
+		if trimmed := mnemonic[:n-1]; knownMnemonic(trimmed) {
+			return trimmed
+		}
+	}
+
+	return mnemonic
+}
+
+// trimSizeSuffix drops the size suffix that AT&T adds where the operands don't tell the
+// size, so that addq is counted with add, and x87 fildll with fild. The Go names that
+// normalizeMnemonic keeps, such as ADDQ, lose it too; Intel names such as MOVQ and PSUBB stay.
+func trimSizeSuffix(mnemonic string) string {
+	n := len(mnemonic)
+	if n > 3 && strings.HasSuffix(mnemonic, "LL") && knownMnemonic(mnemonic[:n-2]) {
+		return mnemonic[:n-2]
+	}
+	if n < 2 || !strings.ContainsRune("BWLQ", rune(mnemonic[n-1])) || !knownMnemonic(mnemonic[:n-1]) {
+		return mnemonic
+	}
+
+	for _, f := range mnemonicForms[mnemonic] {
+		if f.Intel == mnemonic {
+			return mnemonic
+		}
+	}
+
+	return mnemonic[:n-1]
+}
+
+// normalizeRegister spells a register the way the Go assembler does, xmm3 as X3 and zmm17 as Z17.
+func normalizeRegister(register string) string {
+	register = strings.ToUpper(strings.TrimPrefix(register, "%"))
+	if len(register) > 3 {
+		if prefix, ok := vectorRegisterPrefixes[register[:3]]; ok {
+			return prefix + register[3:]
+		}
+	}
+
+	return register
+}
+
+var attRegister = regexp.MustCompile(`%[a-zA-Z][a-zA-Z0-9]*`)
+
+// The opmask register of an EVEX decoration, {%k1} in AT&T and {k1} in Intel syntax.
+var opmaskDecoration = regexp.MustCompile(`\{%?([kK][1-7])\}`)
+
+// splitDecoration drops the EVEX decorations such as {%k1}{z} from an operand and returns
+// their opmask register as Go writes it, K1, or "" when there is none. Go syntax has the
+// opmask as an operand of its own in front of the destination, and only EVEX encodes it.
+func splitDecoration(operand string) (string, string) {
+	mask := ""
+	if match := opmaskDecoration.FindStringSubmatch(operand); match != nil {
+		mask = strings.ToUpper(match[1])
+	}
+
+	if i := strings.Index(operand, "{"); i > 0 {
+		operand = strings.TrimSpace(operand[:i])
+	}
+
+	return operand, mask
+}
+
+// normalizeATTOperand rewrites the registers in one AT&T operand and drops
+// EVEX decorations such as {%k1}{z}, returning their opmask register as splitDecoration does.
+func normalizeATTOperand(operand string) (string, string) {
+	operand, mask := splitDecoration(operand)
+	return attRegister.ReplaceAllStringFunc(operand, normalizeRegister), mask
+}
+
+// splitOperands splits an operand list on the commas that are not inside parentheses or brackets.
+func splitOperands(operands string) []string {
+	var result []string
+	depth := 0
+	start := 0
+	for i, c := range operands {
+		switch c {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				result = append(result, strings.TrimSpace(operands[start:i]))
+				start = i + 1
+			}
+		}
+	}
+
+	if rest := strings.TrimSpace(operands[start:]); rest != "" {
+		result = append(result, rest)
+	}
+
+	return result
+}
+
+// One instruction line from GNU objdump -d or llvm-objdump -d.
+//
+//	401004:	48 83 ec 08          	sub    $0x8,%rsp
+//	401004: 48 83 ec 08                  	subq	$8, %rsp
+var gnuInstructionLine = regexp.MustCompile(`^\s*([0-9a-fA-F]+):\s+(?:[0-9a-fA-F]{2}\s)*(.*)$`)
+
+// splitGNULine splits a line of objdump output into address and instruction,
+// without the comments and symbol annotations objdump appends.
+func splitGNULine(text string) (string, string, bool) {
+	match := gnuInstructionLine.FindStringSubmatch(text)
+	if match == nil {
+		return "", "", false
+	}
+
+	instruction := strings.ReplaceAll(match[2], "\t", " ")
+	if i := strings.Index(instruction, "#"); i >= 0 {
+		instruction = instruction[:i]
+	}
+	if i := strings.Index(instruction, "<"); i >= 0 {
+		instruction = instruction[:i]
+	}
+
+	instruction = strings.TrimSpace(instruction)
+	return match[1], instruction, instruction != ""
+}
+
+// splitMnemonic removes the prefixes from an instruction and returns its mnemonic and operands,
+// together with the pseudo-prefix {vex}, {vex3} or {evex} that selects the encoding, if any.
+func splitMnemonic(instruction string) (string, string, string) {
+	encoding := ""
+	for {
+		fields := strings.Fields(instruction)
+		mnemonic := fields[0]
+		operands := strings.TrimSpace(strings.TrimPrefix(instruction, mnemonic))
+
+		if !contains(gnuPrefixes, mnemonic) || operands == "" {
+			return encoding, mnemonic, operands
+		}
+		if strings.HasPrefix(mnemonic, "{") {
+			encoding = mnemonic
+		}
+		instruction = operands
+	}
+}
+
+// parseATT reads the AT&T syntax printed by binutils objdump -d.
+func parseATT(text string, context *string) [][]string {
+	if match := gnuFunctionHeader.FindStringSubmatch(text); match != nil {
+		*context = match[1]
+		return nil
+	}
+
+	address, instruction, ok := splitGNULine(text)
+	if !ok {
+		return nil
+	}
+
+	encoding, mnemonic, operands := splitMnemonic(instruction)
+	tokens := []string{address, trimSizeSuffix(normalizeMnemonic(mnemonic))}
+	if encoding != "" {
+		tokens = []string{address, encoding, trimSizeSuffix(normalizeMnemonic(mnemonic))}
+	}
+	parts := splitOperands(operands)
+	for i, operand := range parts {
+		operand, mask := normalizeATTOperand(operand)
+		if mask != "" {
+			tokens = append(tokens, mask+",")
+		}
+		if i < len(parts)-1 {
+			operand += ","
+		}
+		tokens = append(tokens, operand)
+	}
+
+	return [][]string{tokens}
+}
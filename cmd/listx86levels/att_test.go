@@ -0,0 +1,69 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+type parserTest struct {
+	line        string
+	mode        AssemblyMode
+	instruction string
+	features    string
+}
+
+// testParser classifies the instruction on each line the way analyzeInput does.
+func testParser(t *testing.T, name string, parse func(string, *string) [][]string, tests []parserTest) {
+	t.Helper()
+	for _, test := range tests {
+		context := ""
+		lines := parse(test.line, &context)
+		if len(lines) != 1 {
+			t.Errorf("%s(%q) = %q, want one instruction", name, test.line, lines)
+			continue
+		}
+
+		mode, instruction, features, _ := NewAnalysis(false).Classify(lines[0], context)
+		if mode != test.mode || instruction != test.instruction || strings.Join(features, ",") != test.features {
+			t.Errorf("%s(%q) = %q, classified %s %s [%s], want %s %s [%s]", name, test.line, lines[0], mode, instruction, strings.Join(features, ","), test.mode, test.instruction, test.features)
+		}
+	}
+}
+
+func TestParseATT(t *testing.T) {
+	testParser(t, "parseATT", parseATT, []parserTest{
+		{"  401000:\tc5 f1 fe c2          \tvpaddd %xmm2,%xmm1,%xmm0", v3, "VPADDD", "AVX"},
+		// only EVEX has opmask registers
+		{"  401000:\t62 f1 75 09 fe c2    \tvpaddd %xmm2,%xmm1,%xmm0{%k1}", v4, "VPADDD", "AVX512F,AVX512VL"},
+		{"  401000:\t62 f1 75 89 fe c2    \tvpaddd %xmm2,%xmm1,%xmm0{%k1}{z}", v4, "VPADDD", "AVX512F,AVX512VL"},
+		{"  401000:\t62 f1 7e 29 7f 00    \tvmovdqu32 %ymm0,(%rax){%k1}", v4, "VMOVDQU32", "AVX512F,AVX512VL"},
+
+		// objdump spells the predicate or the immediate into the mnemonic
+		{"  401000:\t0f c2 c1 01          \tcmpltps %xmm1,%xmm0", v1, "CMPPS", "SSE"},
+		{"  401000:\tf2 0f c2 c1 02       \tcmplesd %xmm1,%xmm0", v1, "CMPSD", "SSE2"},
+		{"  401000:\tc5 f0 c2 c2 00       \tvcmpeqps %xmm2,%xmm1,%xmm0", v3, "VCMPPS", "AVX"},
+		{"  401000:\tc5 f4 c2 c2 11       \tvcmplt_oqps %ymm2,%ymm1,%ymm0", v3, "VCMPPS", "AVX"},
+		{"  401000:\t62 f3 75 48 3f c2 04 \tvpcmpneqb %zmm2,%zmm1,%k0", v4, "VPCMPB", "AVX512BW,AVX512F"},
+		{"  401000:\t62 f3 75 48 3e c2 01 \tvpcmpltub %zmm2,%zmm1,%k0", v4, "VPCMPUB", "AVX512BW,AVX512F"},
+		{"  401000:\t62 f3 75 48 3e c2 04 \tvpcmpnequb %zmm2,%zmm1,%k0", v4, "VPCMPUB", "AVX512BW,AVX512F"},
+		{"  401000:\t66 0f 3a 44 c1 00    \tpclmullqlqdq %xmm1,%xmm0", v1, "PCLMULQDQ", "PCLMULQDQ"},
+		{"  401000:\tc4 e3 75 44 c2 11    \tvpclmulhqhqdq %ymm2,%ymm1,%ymm0", v3, "VPCLMULQDQ", "VPCLMULQDQ,AVX"},
+		// the pseudo-prefix picks the encoding
+		{"  401000:\tc4 e2 71 50 c2       \t{vex} vpdpbusd %xmm2,%xmm1,%xmm0", v3, "VPDPBUSD", "AVXVNNI,AVX"},
+		{"  401000:\t62 f2 75 08 50 c2    \t{evex} vpdpbusd %xmm2,%xmm1,%xmm0", v4, "VPDPBUSD", "AVX512VNNI,AVX512F,AVX512VL"},
+		{"  401000:\tc4 e2 71 50 c2       \tvpdpbusd %xmm2,%xmm1,%xmm0", v3, "VPDPBUSD", "AVXVNNI,AVX"},
+
+		// the size suffix is dropped, so that addq is counted with add
+		{"  401000:\t48 01 c3             \tadd    %rax,%rbx", v1, "ADD", ""},
+		{"  401000:\t48 83 00 01          \taddq   $0x1,(%rax)", v1, "ADD", ""},
+		{"  401000:\t83 00 01             \taddl   $0x1,(%rax)", v1, "ADD", ""},
+		{"  401000:\tdf 28                \tfildll (%rax)", v1, "FILD", "FPU"},
+		{"  401000:\tf2 48 0f 38 f1 00    \tcrc32q (%rax),%rax", v2, "CRC32", "SSE4_2"},
+		{"  401000:\t66 48 0f 7e c0       \tmovq   %xmm0,%rax", v1, "MOVQ", "SSE2"},
+		{"  401000:\t66 0f f8 c1          \tpsubb  %xmm1,%xmm0", v1, "PSUBB", "SSE2"},
+
+		// real mnemonics that look like aliases
+		{"  401000:\tc5 f1 74 c2          \tvpcmpeqb %xmm2,%xmm1,%xmm0", v3, "VPCMPEQB", "AVX"},
+		{"  401000:\t48 0f c7 0e          \tcmpxchg16b (%rsi)", v2, "CMPXCHG16B", "CMPXCHG16B"},
+	})
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"debug/elf"
+	"debug/macho"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+)
+
+// Result is the analysis of one file in a batch.
+type Result struct {
+	Path     string
+	Analysis *Analysis
+	Err      error
+}
+
+// isExecutable tells whether a file found in a directory is worth decoding: an x86 or
+// arm64 ELF executable or shared object, a PE or Mach-O file, or a universal file with an x86 slice.
+func isExecutable(path string) bool {
+	file, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	magic := make([]byte, 8)
+	if _, err := io.ReadFull(file, magic); err != nil {
+		return false
+	}
+
+	if string(magic[:4]) == "\x7fELF" {
+		executable, err := elf.NewFile(file)
+		if err != nil {
+			return false
+		}
+
+		machine := executable.Machine == elf.EM_X86_64 || executable.Machine == elf.EM_386 || executable.Machine == elf.EM_AARCH64
+		return machine && (executable.Type == elf.ET_EXEC || executable.Type == elf.ET_DYN)
+	}
+
+	if binary.BigEndian.Uint32(magic) == macho.MagicFat && isMachO(magic) {
+		fat, err := macho.NewFatFile(file)
+		if err != nil {
+			return false
+		}
+		defer fat.Close()
+
+		for _, arch := range fat.Arches {
+			if arch.Cpu == macho.CpuAmd64 || arch.Cpu == macho.Cpu386 {
+				return true
+			}
+		}
+		return false
+	}
+
+	return string(magic[:2]) == "MZ" || isMachO(magic)
+}
+
+func analyzeFile(path string, options Options, verbose bool) Result {
+	result := Result{Path: path, Analysis: NewAnalysis(verbose)}
+	file, err := os.Open(path)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	defer file.Close()
+
+	result.Err = analyzeInput(file, options, result.Analysis)
+	return result
+}
+
+func analyzePackage(pkg string, options Options, verbose bool) Result {
+	output, cleanup, err := buildPackage(pkg, options.BuildFlags)
+	if err != nil {
+		return Result{Path: pkg, Err: err}
+	}
+	defer cleanup()
+
+	result := analyzeFile(output, options, verbose)
+	result.Path = pkg
+	return result
+}
+
+// analyzePaths analyzes every file and package named on the command line. Directories
+// without Go files are walked and the executables and shared objects in them analyzed,
+// symbolic links are not followed.
+func analyzePaths(paths []string, options Options, verbose bool) []Result {
+	var results []Result
+	for _, root := range paths {
+		if isGoPackage(root) {
+			results = append(results, analyzePackage(root, options, verbose))
+			continue
+		}
+
+		info, err := os.Stat(root)
+		if err != nil {
+			results = append(results, Result{Path: root, Err: err})
+			continue
+		}
+
+		if !info.IsDir() {
+			results = append(results, analyzeFile(root, options, verbose))
+			continue
+		}
+
+		err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				results = append(results, Result{Path: path, Err: err})
+				return nil
+			}
+
+			if entry.Type().IsRegular() && isExecutable(path) {
+				results = append(results, analyzeFile(path, options, verbose))
+			}
+			return nil
+		})
+		if err != nil {
+			results = append(results, Result{Path: root, Err: err})
+		}
+	}
+
+	return results
+}
+
+// extensionList joins the extensions outside the levels for the summary table.
+func extensionList(analysis *Analysis) string {
+	if extensions := analysis.Arch.sortedFeatures(analysis.Features, true); len(extensions) > 0 {
+		return strings.Join(extensions, ",")
+	}
+
+	return "-"
+}
+
+// resultArch is the architecture a result is listed under, files that
+// could not be read under the first one.
+func resultArch(result Result) *architecture {
+	if result.Analysis == nil {
+		return architectures[0]
+	}
+
+	return result.Analysis.Arch
+}
+
+// printSummary prints a table for each architecture with one row per file, its level setting, the counts
+// per level and extensions, followed by the totals over all files and the highest level. The columns
+// go up to the highest level found, or more as the architecture asks. Files that reach a higher level
+// only in code they pick at run time are listed below, and files that could not be read are logged.
+func printSummary(results []Result) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	tables := 0
+	for _, arch := range architectures {
+		var rows []Result
+		columns := arch.Columns
+		for _, result := range results {
+			if resultArch(result) != arch {
+				continue
+			}
+
+			rows = append(rows, result)
+			if result.Err == nil && int(result.Analysis.Mode) > columns {
+				columns = int(result.Analysis.Mode)
+			}
+		}
+
+		if len(rows) == 0 {
+			continue
+		}
+
+		if tables > 0 {
+			fmt.Fprintln(writer)
+		}
+		tables++
+		printTable(writer, arch, rows, columns)
+	}
+	writer.Flush()
+
+	dispatched := false
+	for _, result := range results {
+		if result.Err == nil && requiredLevel(result.Analysis) < result.Analysis.Mode {
+			if !dispatched {
+				fmt.Println()
+				dispatched = true
+			}
+			fmt.Printf("%s reaches %s=%s only in code it picks for the CPU at run time, such as IFUNC variants\n", result.Path, result.Analysis.Arch.Variable, result.Analysis.Level())
+		}
+	}
+
+	for _, result := range results {
+		if result.Err != nil {
+			log.Println(result.Path, result.Err)
+		}
+	}
+}
+
+// printTable prints the rows of one architecture with columns for the lowest levels. The level
+// of a row is the one its file requires, without the code it picks for the CPU at run time.
+// 32-bit x86 files don't count toward the highest GOAMD64 level of the total.
+func printTable(writer io.Writer, arch *architecture, rows []Result, columns int) {
+	header := []string{"FILE", "LEVEL"}
+	for i := 0; i < columns; i++ {
+		header = append(header, arch.Label(i))
+	}
+	fmt.Fprintln(writer, strings.Join(append(header, "EXTENSIONS"), "\t"))
+
+	total := NewAnalysis(false)
+	total.SetArch(arch)
+	for _, result := range rows {
+		if result.Err != nil {
+			fmt.Fprintf(writer, "%s\terror%s\t-\n", result.Path, strings.Repeat("\t-", columns))
+			continue
+		}
+
+		fmt.Fprintf(writer, "%s\t%s%s\t%s\n", result.Path, requiredSetting(result.Analysis), countColumns(result.Analysis.Operations, columns), extensionList(result.Analysis))
+		for i, count := range result.Analysis.Operations {
+			total.Operations[i] += count
+		}
+		for feature, count := range result.Analysis.Features {
+			total.Features[feature] += count
+		}
+		if level := requiredLevel(result.Analysis); result.Analysis.Bits != 32 && level > total.Mode {
+			total.Mode = level
+		}
+	}
+
+	level := "-"
+	if total.Mode != na {
+		level = total.Level()
+	}
+
+	fmt.Fprintf(writer, "total\t%s%s\t%s\n", level, countColumns(total.Operations, columns), extensionList(total))
+}
+
+// countColumns formats the counts of the lowest levels as table cells.
+func countColumns(operations []int, columns int) string {
+	var cells strings.Builder
+	for _, count := range operations[:columns] {
+		fmt.Fprintf(&cells, "\t%d", count)
+	}
+
+	return cells.String()
+}
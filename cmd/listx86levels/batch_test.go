@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"debug/macho"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPrintTable(t *testing.T) {
+	executable := NewAnalysis(false)
+	executable.Mode = v3
+	executable.Operations[0], executable.Operations[2] = 10, 2
+
+	// glibc reaches v4 only in its IFUNC variants
+	libc := NewAnalysis(false)
+	libc.Mode = v4
+	libc.Functions = map[string]AssemblyMode{"strlen": v1, "IFUNC variant 0x1000": v4}
+	libc.Dispatched["IFUNC variant 0x1000"] = true
+	libc.Operations[0], libc.Operations[3] = 20, 5
+
+	i386 := NewAnalysis(false)
+	i386.Mode = v4
+	i386.Bits = 32
+	i386.Operations[0] = 1
+
+	tests := []struct {
+		rows []Result
+		want []string
+	}{
+		{
+			[]Result{{Path: "ls", Analysis: executable}, {Path: "libc.so.6", Analysis: libc}},
+			[]string{"ls\tv3\t10\t0\t2\t0\t-", "libc.so.6\tv1\t20\t0\t0\t5\t-", "total\tv3\t30\t0\t2\t5\t-"},
+		},
+		{
+			[]Result{{Path: "libc.so.6", Analysis: libc}, {Path: "missing.so", Err: errors.New("not found")}},
+			[]string{"libc.so.6\tv1\t20\t0\t0\t5\t-", "missing.so\terror\t-\t-\t-\t-\t-", "total\tv1\t20\t0\t0\t5\t-"},
+		},
+		// 32-bit files don't raise the GOAMD64 level of the total
+		{
+			[]Result{{Path: "ls", Analysis: executable}, {Path: "ls-386", Analysis: i386}},
+			[]string{"ls\tv3\t10\t0\t2\t0\t-", "ls-386\tsoftfloat\t1\t0\t0\t0\t-", "total\tv3\t11\t0\t2\t0\t-"},
+		},
+	}
+
+	for _, test := range tests {
+		var out bytes.Buffer
+		printTable(&out, amd64Arch, test.rows, 4)
+		lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+		if got, want := strings.Join(lines[1:], "\n"), strings.Join(test.want, "\n"); got != want {
+			t.Errorf("printTable rows\n%s\nwant\n%s", got, want)
+		}
+	}
+}
+
+// universalFile is a universal file with an empty 64-bit Mach-O slice for every cpu.
+func universalFile(cpus ...macho.Cpu) []byte {
+	header := binary.BigEndian.AppendUint32(nil, macho.MagicFat)
+	header = binary.BigEndian.AppendUint32(header, uint32(len(cpus)))
+	var slices []byte
+	for _, cpu := range cpus {
+		offset := 8 + 20*len(cpus) + len(slices)
+		for _, value := range []uint32{uint32(cpu), 3, uint32(offset), 32, 0} {
+			header = binary.BigEndian.AppendUint32(header, value)
+		}
+		for _, value := range []uint32{macho.Magic64, uint32(cpu), 3, uint32(macho.TypeExec), 0, 0, 0, 0} {
+			slices = binary.LittleEndian.AppendUint32(slices, value)
+		}
+	}
+
+	return append(header, slices...)
+}
+
+func TestIsExecutable(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    []byte
+		executable bool
+	}{
+		{"amd64", universalFile(macho.CpuAmd64), true},
+		{"arm64+amd64", universalFile(macho.CpuArm64, macho.CpuAmd64), true},
+		{"arm64", universalFile(macho.CpuArm64), false},
+		// a Java class file of version 52
+		{"Main.class", []byte("\xca\xfe\xba\xbe\x00\x00\x00\x34\x00\x1d\x0a\x00\x06\x00\x0f"), false},
+		{"script", []byte("#!/bin/sh\necho\n"), false},
+	}
+
+	dir := t.TempDir()
+	for _, test := range tests {
+		path := filepath.Join(dir, test.name)
+		if err := os.WriteFile(path, test.content, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if executable := isExecutable(path); executable != test.executable {
+			t.Errorf("isExecutable(%s) = %v, want %v", test.name, executable, test.executable)
+		}
+	}
+
+	// the test binary itself
+	if !isExecutable(os.Args[0]) {
+		t.Errorf("isExecutable(%s) = false, want true", os.Args[0])
+	}
+}
+
+func TestIsGoPackage(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "gen.go"), []byte("package main\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	relative, err := filepath.Rel(wd, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		arg string
+		pkg bool
+	}{
+		{".", true},
+		{relative, true},
+		// a directory of binaries with a stray Go file is searched
+		{dir, false},
+		{filepath.Join(dir, "gen.go"), false},
+	}
+
+	for _, test := range tests {
+		if pkg := isGoPackage(test.arg); pkg != test.pkg {
+			t.Errorf("isGoPackage(%s) = %v, want %v", test.arg, pkg, test.pkg)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// isGoPackage tells whether a command line argument names a Go package rather than a file:
+// a directory with Go files in it, written like the go command writes one, such as ./cmd/server,
+// or an import path that the go command resolves. Other directories are searched for executables.
+func isGoPackage(arg string) bool {
+	info, err := os.Stat(arg)
+	if errors.Is(err, os.ErrNotExist) {
+		return !filepath.IsAbs(arg) && exec.Command("go", "list", "-find", arg).Run() == nil
+	}
+
+	if err != nil || !info.IsDir() || !isLocalPath(arg) {
+		return false
+	}
+
+	files, _ := filepath.Glob(filepath.Join(arg, "*.go"))
+	return len(files) > 0
+}
+
+// isLocalPath tells whether path is relative to the current directory in the way the
+// go command knows directories from import paths: ".", "..", or starting with ./ or ../.
+func isLocalPath(path string) bool {
+	path = filepath.ToSlash(path)
+	return path == "." || path == ".." || strings.HasPrefix(path, "./") || strings.HasPrefix(path, "../")
+}
+
+// buildPackage builds a package into a temporary directory with the go command.
+// GOOS, GOARCH, GOAMD64 and the rest of the environment are passed on to it,
+// and so are buildFlags. The returned function removes the directory again.
+func buildPackage(pkg string, buildFlags []string) (string, func(), error) {
+	dir, err := os.MkdirTemp("", "listx86levels")
+	if err != nil {
+		return "", nil, err
+	}
+
+	cleanup := func() {
+		os.RemoveAll(dir)
+	}
+
+	// a directory is built from inside, so that its own go.mod is used
+	target := pkg
+	var workDir string
+	if info, err := os.Stat(pkg); err == nil && info.IsDir() {
+		target, workDir = ".", pkg
+	}
+
+	output := filepath.Join(dir, "out")
+	args := append(append([]string{"build", "-o", output}, buildFlags...), target)
+	command := exec.Command("go", args...)
+	command.Dir = workDir
+	command.Stdout = os.Stderr
+	command.Stderr = os.Stderr
+	if err := command.Run(); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+
+	return output, cleanup, nil
+}
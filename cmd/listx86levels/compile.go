@@ -0,0 +1,47 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+// x.Add STEXT nosplit size=4 args=0x10 locals=0x0 funcid=0x0 align=0x0
+var compileSymbolHeader = regexp.MustCompile(`^(\S*) (S[A-Z0-9]+)\b`)
+
+// 0x0003 00003 (/tmp/x.go:2)	ADDQ	BX, AX
+var compileInstructionLine = regexp.MustCompile(`^\s+0x[0-9a-f]+ \d+ (\([^)]*\))\s+(.*)$`)
+
+// Pseudo-instructions that carry metadata rather than machine code.
+var compilePseudoInstructions = []string{
+	"FUNCDATA",
+	"PCALIGN",
+	"PCDATA",
+	"TEXT",
+}
+
+// parseCompile reads the assembly printed by go build -gcflags=-S or go tool compile -S.
+// Each STEXT symbol header starts a function, and the hex dumps of every symbol are skipped.
+func parseCompile(text string, context *string) [][]string {
+	if match := compileSymbolHeader.FindStringSubmatch(text); match != nil {
+		if match[2] == "STEXT" || match[2] == "STEXTFIPS" {
+			*context = match[1]
+		} else {
+			*context = ""
+		}
+		return nil
+	}
+
+	match := compileInstructionLine.FindStringSubmatch(text)
+	if match == nil || *context == "" {
+		return nil
+	}
+
+	fields := strings.Fields(match[2])
+	if len(fields) == 0 || contains(compilePseudoInstructions, fields[0]) {
+		return nil
+	}
+
+	// the compiler spells the operand size into the mnemonic, as in POPCNTQ
+	fields[0] = normalizeMnemonic(fields[0])
+	return [][]string{append([]string{match[1]}, fields...)}
+}
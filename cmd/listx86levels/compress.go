@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+
+	"github.com/ulikunitz/xz"
+)
+
+var (
+	gzipMagic  = []byte{0x1f, 0x8b}
+	bzip2Magic = []byte("BZh")
+	xzMagic    = []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}
+)
+
+// decompress streams gzip, bzip2 and xz input through a decompressor and
+// returns everything else unchanged. The name of the compression is returned
+// too, empty for none.
+func decompress(input io.Reader) (io.Reader, string, error) {
+	magic := make([]byte, len(xzMagic))
+	reader := input
+	if file, ok := regularFile(input); ok {
+		n, _ := file.ReadAt(magic, 0)
+		magic = magic[:n]
+	} else {
+		buffered := bufio.NewReader(input)
+		magic, _ = buffered.Peek(len(xzMagic))
+		reader = buffered
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		decompressed, err := gzip.NewReader(reader)
+		return decompressed, "gzip", err
+	case bytes.HasPrefix(magic, bzip2Magic):
+		return bzip2.NewReader(reader), "bzip2", nil
+	case bytes.HasPrefix(magic, xzMagic):
+		decompressed, err := xz.NewReader(reader)
+		return decompressed, "xz", err
+	}
+
+	return reader, "", nil
+}
@@ -0,0 +1,436 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"golang.org/x/arch/x86/x86asm"
+)
+
+// symbol marks where a function starts in decoded machine code.
+type symbol struct {
+	Name string
+	Addr uint64
+}
+
+// readerAt gives random access to the input and its size. Files are used
+// as they are, anything else such as stdin is read into memory.
+func readerAt(input io.Reader) (io.ReaderAt, int64, error) {
+	if file, ok := regularFile(input); ok {
+		if info, err := file.Stat(); err == nil {
+			return file, info.Size(), nil
+		}
+	}
+
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return bytes.NewReader(data), int64(len(data)), nil
+}
+
+// analyzeBinary picks the file format from the magic number at the start of the file.
+func analyzeBinary(input io.Reader, analysis *Analysis) error {
+	r, size, err := readerAt(input)
+	if err != nil {
+		return err
+	}
+
+	magic := make([]byte, len(archiveMagic))
+	if _, err := r.ReadAt(magic, 0); err != nil && err != io.EOF {
+		return err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, []byte("\x7fELF")):
+		return analyzeELF(r, analysis)
+	case bytes.HasPrefix(magic, []byte("MZ")):
+		return analyzePE(r, analysis)
+	case isMachO(magic):
+		return analyzeMachO(r, analysis)
+	case string(magic) == archiveMagic:
+		return analyzeArchive(r, size, analysis)
+	case bytes.HasPrefix(magic, []byte(goObjectHeader[:len(archiveMagic)])):
+		pkg := ""
+		return analyzeGoObject(io.NewSectionReader(r, 0, size), &pkg, analysis)
+	}
+
+	return errors.New("unknown executable format")
+}
+
+func sortSymbols(symbols []symbol) {
+	sort.SliceStable(symbols, func(i, j int) bool {
+		return symbols[i].Addr < symbols[j].Addr
+	})
+}
+
+// The Go linker starts the text of Mach-O and PE files with the build ID, \xff Go build ID: "…"\n \xff,
+// which is no code.
+const (
+	goBuildIDStart = "\xff Go build ID: \""
+	goBuildIDEnd   = "\"\n \xff"
+)
+
+// goBuildIDLength is the length of the Go build ID at the start of code, 0 when there is none.
+func goBuildIDLength(code []byte) int {
+	if !bytes.HasPrefix(code, []byte(goBuildIDStart)) {
+		return 0
+	}
+
+	end := bytes.Index(code, []byte(goBuildIDEnd))
+	if end < 0 {
+		return 0
+	}
+
+	return end + len(goBuildIDEnd)
+}
+
+// decodeX86 disassembles code loaded at base and classifies every instruction
+// the same way as a line from go tool objdump. symbols must be sorted by address.
+func decodeX86(code []byte, base uint64, symbols []symbol, bits int, analysis *Analysis) {
+	var context string = ""
+	next := 0
+	if bits == 32 {
+		analysis.Bits = 32
+	}
+
+	for pc := 0; pc < len(code); {
+		addr := base + uint64(pc)
+		for next < len(symbols) && symbols[next].Addr <= addr {
+			context = symbols[next].Name
+			next++
+		}
+
+		if length, instruction, ok := rex2Length(code[pc:], bits); ok {
+			features := []string{"APXF"}
+			if analysis.Verbose {
+				fmt.Printf("Found %s instruction %s (%s) in function %#x %s\n", apx, instruction, features[0], addr, context)
+			}
+			analysis.Count(apx, instruction, features, 0, context)
+			analysis.List(addr, code[pc:pc+length], apx, features, instruction)
+			pc += length
+			continue
+		}
+
+		inst, err := x86asm.Decode(code[pc:], bits)
+
+		// x86asm knows only part of the VEX space and none of EVEX, and
+		// sometimes decodes a VEX prefix as a legacy opcode. Measure those
+		// instructions ourselves and look them up by their opcode, or
+		// count them by their prefix when the tables don't have them.
+		if length, evex, ok := vexLength(code[pc:], bits); ok {
+			if err != nil || inst.Len != length || !strings.HasPrefix(inst.Op.String(), "V") {
+				var mode AssemblyMode = v3
+				var instruction = "VEX"
+				var features = []string{"AVX"}
+				var vectorLength = 0
+				if evex {
+					mode, instruction, features = v4, "EVEX", []string{"AVX512F"}
+					_, vectorLength, _, _, _ = vexFields(code[pc : pc+length])
+				}
+
+				if f, fLength := classifyEncoding(code[pc : pc+length]); f != nil {
+					mode, instruction, features, vectorLength = f.Level, f.Intel, f.Features, fLength
+				}
+
+				if extended, promoted := apxEVEX(code[pc:]); evex && promoted {
+					mode, instruction, features, vectorLength = apx, map4Mnemonic(code[pc:pc+length]), []string{"APXF"}, 0
+				} else if evex && extended {
+					mode, features = apx, append(append([]string(nil), features...), "APXF")
+				}
+
+				if analysis.Verbose {
+					fmt.Printf("Found %s instruction %s (%s) in function %#x %s\n", mode, instruction, strings.Join(features, ","), addr, context)
+				}
+				analysis.Count(mode, instruction, features, vectorLength, context)
+				analysis.List(addr, code[pc:pc+length], mode, features, instruction)
+				pc += length
+				continue
+			}
+		}
+
+		if length, key, w, digit, ok := legacyLength(code[pc:], bits); ok && (err != nil || inst.Op == 0) {
+			if f := classifyLegacy(key, w, digit); f != nil {
+				if analysis.Verbose {
+					fmt.Printf("Found %s instruction %s (%s) in function %#x %s\n", f.Level, f.Intel, strings.Join(f.Features, ","), addr, context)
+				}
+				analysis.Count(f.Level, f.Intel, f.Features, 0, context)
+				analysis.List(addr, code[pc:pc+length], f.Level, f.Features, f.Intel)
+				pc += length
+				continue
+			}
+		}
+
+		if err != nil || inst.Len == 0 || inst.Op == 0 {
+			analysis.List(addr, code[pc:pc+1], na, nil, "?")
+			pc++
+			continue
+		}
+
+		text := x86asm.GoSyntax(inst, addr, nil)
+		fields := strings.Fields(text)
+		for i, field := range fields {
+			// prefixes such as REP; and LOCK are printed before the mnemonic
+			if !contains(gnuPrefixes, strings.ToLower(strings.TrimSuffix(field, ";"))) {
+				fields[i] = normalizeMnemonic(field)
+				break
+			}
+		}
+		tokens := append([]string{fmt.Sprintf("%#x", addr)}, fields...)
+		mode, features := analysis.Add(tokens, context)
+		analysis.List(addr, code[pc:pc+inst.Len], mode, features, text)
+		pc += inst.Len
+	}
+}
+
+// Legacy prefixes that may come before a REX2 prefix.
+var legacyPrefixes = []byte{0x26, 0x2E, 0x36, 0x3E, 0x64, 0x65, 0x66, 0x67, 0xF0, 0xF2, 0xF3}
+
+// rex2Length measures an instruction with the APX REX2 prefix, 0xD5 and a payload byte,
+// which x86asm rejects as AAD is invalid in 64-bit mode. The rest is a legacy instruction
+// in map 0 or, with REX2.M0, map 1, which x86asm decodes once the prefix is replaced by
+// 0x0F and by REX.W for REX2.W. It returns the length and the mnemonic.
+func rex2Length(code []byte, bits int) (int, string, bool) {
+	if bits != 64 {
+		return 0, "", false
+	}
+
+	p := 0
+	for p < len(code) && bytes.IndexByte(legacyPrefixes, code[p]) >= 0 {
+		p++
+	}
+	if p+2 >= len(code) || code[p] != 0xD5 {
+		return 0, "", false
+	}
+
+	payload := code[p+1]
+	legacy := append([]byte(nil), code[:p]...)
+	if payload&0x08 != 0 {
+		legacy = append(legacy, 0x48)
+	}
+	if payload&0x80 != 0 {
+		legacy = append(legacy, 0x0F)
+	}
+	added := len(legacy) - p
+	legacy = append(legacy, code[p+2:]...)
+
+	inst, err := x86asm.Decode(legacy, bits)
+	if err != nil || inst.Len == 0 {
+		return 0, "", false
+	}
+
+	return inst.Len - added + 2, inst.Op.String(), true
+}
+
+// Opcodes that APX moves from map 1 into EVEX map 4 and that x86asm can't name from map 1.
+var map4Mnemonics = map[byte]string{
+	0x24: "SHLD",
+	0x2C: "SHRD",
+	0x60: "MOVBE",
+	0x61: "MOVBE",
+	0xF0: "CRC32",
+	0xF1: "CRC32",
+}
+
+// map4Mnemonic names an APX instruction in EVEX map 4: the instructions APX adds by their
+// opcode and ModRM digit, and the promoted legacy ones, like rex2Length, by decoding them
+// in map 0 or map 1 with REX.W for EVEX.W. Other opcodes are named by their encoding, EVEX.MAP4 F8.
+func map4Mnemonic(code []byte) string {
+	opcode, digit, w := code[4], -1, code[2]&0x80 != 0
+	if len(code) > 5 {
+		digit = int(code[5] >> 3 & 7)
+	}
+
+	switch {
+	case opcode == 0xFF && digit == 6 && w:
+		return "PUSH2P"
+	case opcode == 0xFF && digit == 6:
+		return "PUSH2"
+	case opcode == 0x8F && digit == 0 && w:
+		return "POP2P"
+	case opcode == 0x8F && digit == 0:
+		return "POP2"
+	// CMP and TEST have no promoted forms, in map 4 they are the conditional ones
+	case opcode >= 0x38 && opcode <= 0x3B, opcode >= 0x80 && opcode <= 0x83 && digit == 7:
+		return "CCMP"
+	case opcode == 0x84, opcode == 0x85, (opcode == 0xF6 || opcode == 0xF7) && digit == 0:
+		return "CTEST"
+	case opcode&0xF0 == 0x40 && code[2]&0x03 == 3:
+		return "SETZU"
+	// EVEX.NF turns CMOVcc into CFCMOVcc
+	case opcode&0xF0 == 0x40 && code[3]&0x04 != 0:
+		return "CFCMOV"
+	case opcode == 0x66 && code[2]&0x03 == 1:
+		return "ADCX"
+	case opcode == 0x66 && code[2]&0x03 == 2:
+		return "ADOX"
+	}
+	if name, ok := map4Mnemonics[opcode]; ok {
+		return name
+	}
+
+	var legacy []byte
+	if w {
+		legacy = append(legacy, 0x48)
+	}
+	switch {
+	// CMOVcc, SHLD, SHRD and IMUL come from map 1
+	case opcode&0xF0 == 0x40, opcode == 0xA5, opcode == 0xAD, opcode == 0xAF:
+		legacy = append(legacy, 0x0F)
+	// ADD to XOR, IMUL with an immediate, and the groups of shifts, NOT, NEG, INC and DEC
+	case opcode < 0x34 && opcode&0x07 < 4, opcode == 0x69, opcode == 0x6B, opcode >= 0x80 && opcode <= 0x83,
+		opcode == 0xC0, opcode == 0xC1, opcode >= 0xD0 && opcode <= 0xD3, opcode == 0xF6, opcode == 0xF7, opcode == 0xFE, opcode == 0xFF:
+	default:
+		return fmt.Sprintf("EVEX.MAP4 %02X", opcode)
+	}
+	legacy = append(legacy, code[4:]...)
+	if inst, err := x86asm.Decode(legacy, 64); err == nil && inst.Op != 0 {
+		return inst.Op.String()
+	}
+
+	return fmt.Sprintf("EVEX.MAP4 %02X", opcode)
+}
+
+// vexLength measures an instruction that starts with a VEX or EVEX prefix.
+// Only 64-bit code is handled, where 0xC4, 0xC5 and 0x62 are always prefixes.
+func vexLength(code []byte, bits int) (int, bool, bool) {
+	if bits != 64 || len(code) < 2 {
+		return 0, false, false
+	}
+
+	var prefix int
+	var opcodeMap byte
+	var evex bool
+	switch code[0] {
+	case 0xC5:
+		prefix, opcodeMap = 2, 1
+	case 0xC4:
+		prefix, opcodeMap = 3, code[1]&0x1F
+	case 0x62:
+		prefix, opcodeMap, evex = 4, code[1]&0x07, true
+	default:
+		return 0, false, false
+	}
+
+	if len(code) <= prefix {
+		return 0, false, false
+	}
+
+	// VZEROUPPER and VZEROALL are the only ones without ModRM
+	opcode := code[prefix]
+	if opcodeMap == 1 && opcode == 0x77 {
+		return prefix + 1, evex, true
+	}
+
+	operands, ok := modrmLength(code[prefix+1:])
+	if !ok {
+		return 0, false, false
+	}
+	length := prefix + 1 + operands
+	modrm := code[prefix+1]
+
+	// APX promotes legacy instructions to map 4, some of them with an immediate
+	// of one byte, or of four bytes or two with the 0x66 prefix
+	digit := modrm >> 3 & 7
+	switch {
+	case opcodeMap == 3:
+		length++
+	case opcodeMap == 1 && (opcode >= 0x70 && opcode <= 0x73 || opcode == 0xC2 || opcode >= 0xC4 && opcode <= 0xC6):
+		length++
+	case opcodeMap == 4 && (opcode == 0x80 || opcode == 0x83 || opcode == 0xC0 || opcode == 0xC1 || opcode == 0x6B || opcode == 0x24 || opcode == 0x2C || opcode == 0xF6 && digit <= 1):
+		length++
+	case opcodeMap == 4 && (opcode == 0x81 || opcode == 0x69 || opcode == 0xF7 && digit <= 1):
+		if code[2]&0x03 == 1 {
+			length += 2
+		} else {
+			length += 4
+		}
+	}
+
+	if len(code) < length {
+		return 0, false, false
+	}
+
+	return length, evex, true
+}
+
+// modrmLength measures the ModRM byte at the start of code with the SIB byte
+// and the displacement that follow it, using 32-bit or 64-bit addressing.
+func modrmLength(code []byte) (int, bool) {
+	if len(code) < 1 {
+		return 0, false
+	}
+
+	length := 1
+	modrm := code[0]
+	mod := modrm >> 6
+	rm := modrm & 0x07
+	if mod != 3 && rm == 4 {
+		if len(code) < 2 {
+			return 0, false
+		}
+		sib := code[1]
+		length++
+		if mod == 0 && sib&0x07 == 5 {
+			length += 4
+		}
+	}
+
+	switch {
+	case mod == 1:
+		length++
+	case mod == 2, mod == 0 && rm == 5:
+		length += 4
+	}
+
+	return length, true
+}
+
+// legacyLength measures a legacy instruction in map 0F38 or 0F3A, which x86asm
+// doesn't know all of, such as SHA256RNDS2. It returns the length and the key,
+// REX.W and ModRM.reg that classifyLegacy looks the instruction up by.
+func legacyLength(code []byte, bits int) (int, encodingKey, int, int, bool) {
+	key := encodingKey{Legacy: true}
+	w := 0
+	p := 0
+	for p < len(code) && bytes.IndexByte(legacyPrefixes, code[p]) >= 0 {
+		switch {
+		case code[p] == 0x67 && bits != 64:
+			// 16-bit addressing
+			return 0, key, 0, 0, false
+		case code[p] == 0xF2 || code[p] == 0xF3:
+			key.Prefix = code[p]
+		case code[p] == 0x66 && key.Prefix == 0:
+			key.Prefix = code[p]
+		}
+		p++
+	}
+	if bits == 64 && p < len(code) && code[p]&0xF0 == 0x40 {
+		w = int(code[p] >> 3 & 1)
+		p++
+	}
+
+	if p+3 >= len(code) || code[p] != 0x0F || code[p+1] != 0x38 && code[p+1] != 0x3A {
+		return 0, key, 0, 0, false
+	}
+	key.Map, key.Opcode = 2, code[p+2]
+	if code[p+1] == 0x3A {
+		key.Map = 3
+	}
+
+	operands, ok := modrmLength(code[p+3:])
+	length := p + 3 + operands
+	if key.Map == 3 {
+		length++
+	}
+	if !ok || len(code) < length {
+		return 0, key, 0, 0, false
+	}
+
+	return length, key, w, int(code[p+3] >> 3 & 7), true
+}
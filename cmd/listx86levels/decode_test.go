@@ -0,0 +1,142 @@
+package main
+
+import "testing"
+
+func TestRex2Length(t *testing.T) {
+	tests := []struct {
+		code        []byte
+		bits        int
+		length      int
+		instruction string
+		ok          bool
+	}{
+		// ADD R16, R8 with REX2.W
+		{[]byte{0xD5, 0x48, 0x01, 0xC0}, 64, 4, "ADD", true},
+		// IMUL in map 1 with REX2.M0
+		{[]byte{0xD5, 0x80, 0xAF, 0xC1}, 64, 4, "IMUL", true},
+		// ADD with an immediate of two bytes behind the 0x66 prefix
+		{[]byte{0x66, 0xD5, 0x10, 0x81, 0xC0, 0x34, 0x12}, 64, 7, "ADD", true},
+		// PUSH R16 without ModRM
+		{[]byte{0xD5, 0x10, 0x50}, 64, 3, "PUSH", true},
+		// AAD in 32-bit code
+		{[]byte{0xD5, 0x0A, 0x90}, 32, 0, "", false},
+		{[]byte{0x48, 0x01, 0xC0}, 64, 0, "", false},
+		{[]byte{0xD5, 0x48}, 64, 0, "", false},
+	}
+
+	for _, test := range tests {
+		length, instruction, ok := rex2Length(test.code, test.bits)
+		if length != test.length || instruction != test.instruction || ok != test.ok {
+			t.Errorf("rex2Length(% x, %d) = %d, %q, %v, want %d, %q, %v", test.code, test.bits, length, instruction, ok, test.length, test.instruction, test.ok)
+		}
+	}
+}
+
+func TestAPXEVEX(t *testing.T) {
+	tests := []struct {
+		code     []byte
+		extended bool
+		promoted bool
+	}{
+		// ADD in map 4
+		{[]byte{0x62, 0xF4, 0x7C, 0x18, 0x01, 0xC0}, true, true},
+		// VADDPS ZMM0, ZMM1, ZMM2
+		{[]byte{0x62, 0xF1, 0x74, 0x48, 0x58, 0xC2}, false, false},
+		// B4 selects R16 and up
+		{[]byte{0x62, 0xF9, 0x74, 0x48, 0x58, 0xC2}, true, false},
+		// X4 selects R16 and up, inverted
+		{[]byte{0x62, 0xF1, 0x70, 0x48, 0x58, 0x04, 0x08}, true, false},
+	}
+
+	for _, test := range tests {
+		extended, promoted := apxEVEX(test.code)
+		if extended != test.extended || promoted != test.promoted {
+			t.Errorf("apxEVEX(% x) = %v, %v, want %v, %v", test.code, extended, promoted, test.extended, test.promoted)
+		}
+	}
+}
+
+func TestVEXLength(t *testing.T) {
+	tests := []struct {
+		code   []byte
+		length int
+		evex   bool
+		ok     bool
+	}{
+		// VZEROUPPER
+		{[]byte{0xC5, 0xF8, 0x77}, 3, false, true},
+		// VPALIGNR in map 3 with an immediate
+		{[]byte{0xC4, 0xE3, 0x79, 0x0F, 0xC1, 0x03}, 6, false, true},
+		// VPSHUFD in map 1 with an immediate
+		{[]byte{0xC5, 0xF9, 0x70, 0xC1, 0x1B}, 5, false, true},
+		// VMOVDQU64 with a SIB byte and a displacement of four bytes
+		{[]byte{0x62, 0xF1, 0xFE, 0x48, 0x6F, 0x84, 0x24, 0x00, 0x01, 0x00, 0x00}, 11, true, true},
+
+		// ADD with an immediate of one byte in map 4
+		{[]byte{0x62, 0xF4, 0x7C, 0x18, 0x83, 0xC0, 0x05}, 7, true, true},
+		// ADD with an immediate of four bytes in map 4
+		{[]byte{0x62, 0xF4, 0x7C, 0x18, 0x81, 0xC0, 0x78, 0x56, 0x34, 0x12}, 10, true, true},
+		// ADD with an immediate of two bytes in map 4, with the 0x66 prefix
+		{[]byte{0x62, 0xF4, 0x7D, 0x18, 0x81, 0xC0, 0x34, 0x12}, 8, true, true},
+		// IMUL with an immediate of one byte in map 4
+		{[]byte{0x62, 0xF4, 0x7C, 0x18, 0x6B, 0xC1, 0x05}, 7, true, true},
+		// TEST is F6 /0 with an immediate, NEG is F6 /3 without
+		{[]byte{0x62, 0xF4, 0x7C, 0x08, 0xF6, 0xC0, 0x05}, 7, true, true},
+		{[]byte{0x62, 0xF4, 0x7C, 0x18, 0xF6, 0xD8}, 6, true, true},
+		// SHL by an immediate of one byte in map 4
+		{[]byte{0x62, 0xF4, 0x7C, 0x18, 0xC1, 0xE0, 0x04}, 7, true, true},
+		// truncated before the immediate
+		{[]byte{0x62, 0xF4, 0x7C, 0x18, 0x81, 0xC0, 0x78}, 0, false, false},
+
+		{[]byte{0x48, 0x01, 0xC0}, 0, false, false},
+	}
+
+	for _, test := range tests {
+		length, evex, ok := vexLength(test.code, 64)
+		if length != test.length || evex != test.evex || ok != test.ok {
+			t.Errorf("vexLength(% x) = %d, %v, %v, want %d, %v, %v", test.code, length, evex, ok, test.length, test.evex, test.ok)
+		}
+	}
+
+	if _, _, ok := vexLength([]byte{0xC5, 0xF8, 0x77}, 32); ok {
+		t.Errorf("vexLength measured 32-bit code")
+	}
+}
+
+func TestDecodeX86(t *testing.T) {
+	tests := []struct {
+		code        []byte
+		mode        AssemblyMode
+		instruction string
+		feature     string
+	}{
+		{[]byte{0xD5, 0x48, 0x01, 0xC0}, apx, "ADD", "APXF"},
+		// map 4 is named like map 0, or from the tables and map4Mnemonics
+		{[]byte{0x62, 0xF4, 0x7C, 0x18, 0x83, 0xC0, 0x05}, apx, "ADD", "APXF"},
+		{[]byte{0x62, 0xF4, 0xFC, 0x18, 0x01, 0xC8}, apx, "ADD", "APXF"},
+		{[]byte{0x62, 0xF4, 0x7C, 0x18, 0xC1, 0xE0, 0x04}, apx, "SHL", "APXF"},
+		{[]byte{0x62, 0xF4, 0x7C, 0x18, 0xF6, 0xD8}, apx, "NEG", "APXF"},
+		{[]byte{0x62, 0xF4, 0x7C, 0x18, 0xAF, 0xC1}, apx, "IMUL", "APXF"},
+		{[]byte{0x62, 0xF4, 0x7C, 0x18, 0xFF, 0xF1}, apx, "PUSH2", "APXF"},
+		{[]byte{0x62, 0xF4, 0x7C, 0x18, 0x8F, 0xC1}, apx, "POP2", "APXF"},
+		{[]byte{0x62, 0xF4, 0xFC, 0x08, 0x39, 0xC8}, apx, "CCMP", "APXF"},
+		{[]byte{0x62, 0xF4, 0xFC, 0x18, 0x44, 0xC1}, apx, "CMOVE", "APXF"},
+		{[]byte{0x62, 0xF4, 0xFC, 0x0C, 0x44, 0xC1}, apx, "CFCMOV", "APXF"},
+		{[]byte{0x62, 0xF4, 0x7D, 0x08, 0xF8, 0x01}, apx, "EVEX.MAP4 F8", "APXF"},
+		{[]byte{0xC5, 0xF8, 0x77}, v3, "VZEROUPPER", "AVX"},
+		// x86asm doesn't know SHA, it is found in the tables
+		{[]byte{0x0F, 0x38, 0xCB, 0xD1}, v1, "SHA256RNDS2", "SHA"},
+		{[]byte{0x0F, 0x3A, 0xCC, 0xC1, 0x01}, v1, "SHA1RNDS4", "SHA"},
+		{[]byte{0x66, 0x0F, 0x38, 0xCF, 0xC1}, v1, "GF2P8MULB", "GFNI"},
+		{[]byte{0xF2, 0x48, 0x0F, 0x38, 0xF1, 0xC3}, v2, "CRC32", "SSE4_2"},
+	}
+
+	for _, test := range tests {
+		analysis := NewAnalysis(false)
+		decodeX86(test.code, 0, nil, 64, analysis)
+		key := countKey(test.instruction, []string{test.feature})
+		if analysis.Mode != test.mode || analysis.Counts[test.mode-1][key] != 1 {
+			t.Errorf("decodeX86(% x) = %s %v, want %s %s", test.code, analysis.Mode, analysis.Counts[test.mode-1], test.mode, key)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"debug/elf"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Directories the dynamic loader searches last for each machine, below the sysroot.
+// /etc/ld.so.conf and its cache are not read.
+var defaultLibraryPaths = map[elf.Machine][]string{
+	elf.EM_X86_64: {
+		"/lib64",
+		"/usr/lib64",
+		"/lib/x86_64-linux-gnu",
+		"/usr/lib/x86_64-linux-gnu",
+		"/lib",
+		"/usr/lib",
+	},
+	elf.EM_AARCH64: {
+		"/lib64",
+		"/usr/lib64",
+		"/lib/aarch64-linux-gnu",
+		"/usr/lib/aarch64-linux-gnu",
+		"/lib",
+		"/usr/lib",
+	},
+	elf.EM_386: {
+		"/lib/i386-linux-gnu",
+		"/usr/lib/i386-linux-gnu",
+		"/lib32",
+		"/usr/lib32",
+		"/lib",
+		"/usr/lib",
+	},
+}
+
+// defaultLibraryPath is the last resort for machines missing in defaultLibraryPaths.
+var defaultLibraryPath = []string{"/lib", "/usr/lib"}
+
+// dynamicPaths splits a DT_RPATH or DT_RUNPATH value and expands $ORIGIN. Other absolute
+// directories are taken below the sysroot, like the default directories.
+func dynamicPaths(values []string, origin string, sysroot string) []string {
+	var paths []string
+	for _, value := range values {
+		for _, path := range strings.Split(value, ":") {
+			expanded := strings.ReplaceAll(path, "${ORIGIN}", origin)
+			expanded = strings.ReplaceAll(expanded, "$ORIGIN", origin)
+			if expanded == path && filepath.IsAbs(path) {
+				expanded = filepath.Join(sysroot, path)
+			}
+			if expanded != "" {
+				paths = append(paths, expanded)
+			}
+		}
+	}
+
+	return paths
+}
+
+// findLibrary looks for a DT_NEEDED entry the way the dynamic loader does: DT_RPATH when
+// there is no DT_RUNPATH, then the library path given by the user in place of
+// LD_LIBRARY_PATH, then DT_RUNPATH and last the default directories, all but the
+// library path below the sysroot.
+// Only a library for the same machine as the object that needs it is accepted.
+func findLibrary(name string, object *elf.File, origin string, sysroot string, libraryPath []string) (string, bool) {
+	if strings.Contains(name, "/") {
+		return name, true
+	}
+
+	runpath, _ := object.DynString(elf.DT_RUNPATH)
+	var directories []string
+	if len(runpath) == 0 {
+		rpath, _ := object.DynString(elf.DT_RPATH)
+		directories = append(directories, dynamicPaths(rpath, origin, sysroot)...)
+	}
+
+	directories = append(directories, libraryPath...)
+	directories = append(directories, dynamicPaths(runpath, origin, sysroot)...)
+	defaults, ok := defaultLibraryPaths[object.Machine]
+	if !ok {
+		defaults = defaultLibraryPath
+	}
+	for _, directory := range defaults {
+		directories = append(directories, filepath.Join(sysroot, directory))
+	}
+
+	for _, directory := range directories {
+		candidate := filepath.Join(directory, name)
+		library, err := elf.Open(candidate)
+		if err != nil {
+			continue
+		}
+
+		matches := library.Machine == object.Machine && library.Class == object.Class
+		library.Close()
+		if matches {
+			return candidate, true
+		}
+	}
+
+	return "", false
+}
+
+// loadSet lists an ELF executable and every shared library it loads, breadth
+// first from its DT_NEEDED entries. Libraries that cannot be found are returned as errors.
+func loadSet(path string, sysroot string, libraryPath []string) ([]string, []Result) {
+	paths := []string{path}
+	seen := map[string]bool{}
+	var missing []Result
+	for i := 0; i < len(paths); i++ {
+		object, err := elf.Open(paths[i])
+		if err != nil {
+			missing = append(missing, Result{Path: paths[i], Err: err})
+			continue
+		}
+
+		needed, _ := object.ImportedLibraries()
+		origin, _ := filepath.Abs(filepath.Dir(paths[i]))
+		for _, name := range needed {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			library, ok := findLibrary(name, object, origin, sysroot, libraryPath)
+			if !ok {
+				missing = append(missing, Result{Path: name, Err: fmt.Errorf("needed by %s, not found", paths[i])})
+				continue
+			}
+			paths = append(paths, library)
+		}
+		object.Close()
+	}
+
+	return paths, missing
+}
+
+// requiredLevel is the level an object of the load set needs, without the IFUNC variants it picks
+// for the CPU at run time, as glibc does. The glibc dynamic loader picks its trampolines without
+// IFUNC symbols and counts at the level of its ISA note, or at the baseline without one.
+func requiredLevel(analysis *Analysis) AssemblyMode {
+	if analysis.Bits == 32 || analysis.Mode == na {
+		return analysis.Mode
+	}
+
+	if analysis.Loader {
+		if analysis.ISANote && analysis.ISANeeded != 0 {
+			return isaLevel(analysis.ISANeeded)
+		}
+		return v1
+	}
+
+	if len(analysis.Dispatched) == 0 {
+		return analysis.Mode
+	}
+
+	var level AssemblyMode = v1
+	for context, mode := range analysis.Functions {
+		if !analysis.Dispatched[context] && mode > level {
+			level = mode
+		}
+	}
+
+	return level
+}
+
+// requiredSetting is the setting of requiredLevel, with the options of the whole analysis.
+func requiredSetting(analysis *Analysis) string {
+	if level := requiredLevel(analysis); level < analysis.Mode {
+		return analysis.Arch.LevelName(level)
+	}
+
+	return analysis.Level()
+}
+
+// analyzeLoadSet analyzes an executable together with its shared libraries and
+// tells which library raises the level of the whole load set above the executable's own.
+func analyzeLoadSet(path string, sysroot string, libraryPath []string, options Options, verbose bool) {
+	paths, missing := loadSet(path, sysroot, libraryPath)
+	results := analyzePaths(paths, options, verbose)
+	printSummary(append(results, missing...))
+
+	executable := results[0]
+	highest := executable
+	for _, result := range results[1:] {
+		if result.Err == nil && (highest.Err != nil || requiredLevel(result.Analysis) > requiredLevel(highest.Analysis)) {
+			highest = result
+		}
+	}
+
+	if highest.Err != nil {
+		return
+	}
+
+	fmt.Println()
+	setting := requiredSetting(highest.Analysis)
+	if executable.Err == nil && highest.Path == executable.Path {
+		fmt.Printf("Minimum required %s=%s, no library raises it\n", highest.Analysis.Arch.Variable, setting)
+	} else {
+		fmt.Printf("Minimum required %s=%s, raised by %s\n", highest.Analysis.Arch.Variable, setting, highest.Path)
+	}
+
+	if len(missing) > 0 {
+		fmt.Fprintln(os.Stderr, "Some libraries were not found, the level may be higher")
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"debug/elf"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// compileC runs gcc on source with the arguments and returns the output file.
+func compileC(t *testing.T, source string, output string, arguments ...string) string {
+	file := filepath.Join(t.TempDir(), "source.c")
+	if err := os.WriteFile(file, []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(output), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	command := exec.Command("gcc", append([]string{"-o", output, file}, arguments...)...)
+	if out, err := command.CombinedOutput(); err != nil {
+		t.Skipf("gcc: %v\n%s", err, out)
+	}
+
+	return output
+}
+
+func TestFindLibrary(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds C programs")
+	}
+	if _, err := exec.LookPath("gcc"); err != nil {
+		t.Skip("needs a C compiler")
+	}
+
+	dir := t.TempDir()
+	library := compileC(t, "int dep(void) { return 1; }\n", filepath.Join(dir, "build", "libdep.so"), "-shared", "-fPIC", "-Wl,-soname,libdep.so")
+	data, err := os.ReadFile(library)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sysroot := filepath.Join(dir, "root")
+	libraryPath := filepath.Join(dir, "libpath")
+	for _, directory := range []string{filepath.Join(sysroot, "opt/lib"), filepath.Join(sysroot, "usr/lib"), libraryPath, filepath.Join(dir, "app/lib")} {
+		if err := os.MkdirAll(directory, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(directory, "libdep.so"), data, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	executable := func(name string, flags ...string) string {
+		arguments := append([]string{"-L" + filepath.Dir(library), "-ldep"}, flags...)
+		return compileC(t, "int dep(void);\nint main(void) { return dep(); }\n", filepath.Join(dir, "app/bin", name), arguments...)
+	}
+
+	tests := []struct {
+		path        string
+		name        string
+		libraryPath []string
+		want        string
+	}{
+		// absolute DT_RUNPATH and DT_RPATH entries are below the sysroot
+		{executable("runpath", "-Wl,--enable-new-dtags,-rpath,/opt/lib"), "libdep.so", nil, filepath.Join(sysroot, "opt/lib/libdep.so")},
+		{executable("rpath", "-Wl,--disable-new-dtags,-rpath,/opt/lib"), "libdep.so", nil, filepath.Join(sysroot, "opt/lib/libdep.so")},
+		// the library path comes after DT_RPATH and before DT_RUNPATH
+		{filepath.Join(dir, "app/bin/runpath"), "libdep.so", []string{libraryPath}, filepath.Join(libraryPath, "libdep.so")},
+		{filepath.Join(dir, "app/bin/rpath"), "libdep.so", []string{libraryPath}, filepath.Join(sysroot, "opt/lib/libdep.so")},
+		// $ORIGIN is where the object is, not below the sysroot
+		{executable("origin", "-Wl,--enable-new-dtags,-rpath,$ORIGIN/../lib"), "libdep.so", nil, filepath.Join(dir, "app/bin/../lib/libdep.so")},
+		{executable("missing", "-Wl,--enable-new-dtags,-rpath,/missing"), "libdep.so", nil, filepath.Join(sysroot, "usr/lib/libdep.so")},
+		{executable("default"), "libdep.so", nil, filepath.Join(sysroot, "usr/lib/libdep.so")},
+		{filepath.Join(dir, "app/bin/default"), "libnone.so", nil, ""},
+		{filepath.Join(dir, "app/bin/default"), "/lib/libdep.so", nil, "/lib/libdep.so"},
+	}
+
+	for _, test := range tests {
+		object, err := elf.Open(test.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, ok := findLibrary(test.name, object, filepath.Dir(test.path), sysroot, test.libraryPath)
+		object.Close()
+		if got != test.want || ok != (test.want != "") {
+			t.Errorf("findLibrary(%s) for %s with %v = %s, %v, want %s", test.name, filepath.Base(test.path), test.libraryPath, got, ok, test.want)
+		}
+	}
+}
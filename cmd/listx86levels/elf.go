@@ -0,0 +1,86 @@
+package main
+
+import (
+	"debug/elf"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// analyzeELF decodes the executable sections of an ELF file, such as .text.
+func analyzeELF(r io.ReaderAt, analysis *Analysis) error {
+	file, err := elf.NewFile(r)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var bits int
+	switch file.Machine {
+	case elf.EM_X86_64:
+		bits = 64
+	case elf.EM_386:
+		bits = 32
+	case elf.EM_AARCH64:
+		analysis.SetArch(arm64Arch)
+	default:
+		return fmt.Errorf("unsupported ELF machine %s", file.Machine)
+	}
+
+	if needed, used, ok := readISANote(file); ok && analysis.Arch == amd64Arch {
+		analysis.ISANote = true
+		analysis.ISANeeded |= needed
+		analysis.ISAUsed |= used
+	}
+
+	elfSymbols, _ := file.Symbols()
+	dynamicSymbols, _ := file.DynamicSymbols()
+	// a stripped file still names its exported functions in .dynsym
+	functionSymbols := elfSymbols
+	if len(functionSymbols) == 0 {
+		functionSymbols = dynamicSymbols
+	}
+
+	if soname, _ := file.DynString(elf.DT_SONAME); len(soname) > 0 && strings.HasPrefix(soname[0], "ld-linux") {
+		analysis.Loader = true
+	}
+
+	var sections []*elf.Section
+	var codes [][]byte
+	for _, section := range file.Sections {
+		if section.Type != elf.SHT_PROGBITS || section.Flags&elf.SHF_EXECINSTR == 0 {
+			continue
+		}
+
+		code, err := section.Data()
+		if err != nil {
+			return fmt.Errorf("reading section %s: %w", section.Name, err)
+		}
+		sections = append(sections, section)
+		codes = append(codes, code)
+	}
+
+	resolvers := ifuncResolvers(file, append(dynamicSymbols, elfSymbols...))
+	variants := make(map[uint64]bool)
+	for i, section := range sections {
+		for addr := range resolvers {
+			for _, variant := range ifuncVariants(file.Machine, codes[i], section.Addr, addr) {
+				variants[variant] = true
+			}
+		}
+	}
+
+	for i, section := range sections {
+		var symbols []symbol
+		for _, s := range functionSymbols {
+			if elf.ST_TYPE(s.Info) == elf.STT_FUNC && s.Value >= section.Addr && s.Value < section.Addr+section.Size {
+				symbols = append(symbols, symbol{Name: s.Name, Addr: s.Value})
+			}
+		}
+		symbols = ifuncSymbols(symbols, resolvers, variants, section.Addr, section.Size, analysis)
+
+		analysis.Arch.Decode(codes[i], section.Addr, symbols, bits, analysis)
+	}
+
+	return nil
+}
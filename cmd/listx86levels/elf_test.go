@@ -0,0 +1,42 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestAnalyzeELF(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds C libraries")
+	}
+	if _, err := exec.LookPath("gcc"); err != nil {
+		t.Skip("needs a C compiler")
+	}
+
+	source := "int exported(int *a, int n) { int s = 0; for (int i = 0; i < n; i++) s += a[i] * 3; return s; }\n"
+	tests := [][]string{
+		{"-shared", "-fPIC"},
+		// without .symtab the functions are named from .dynsym
+		{"-shared", "-fPIC", "-s"},
+	}
+
+	for _, flags := range tests {
+		library := compileC(t, source, filepath.Join(t.TempDir(), "libexported.so"), flags...)
+		file, err := os.Open(library)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		analysis := NewAnalysis(false)
+		err = analyzeELF(file, analysis)
+		file.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := analysis.Functions["exported"]; !ok {
+			t.Errorf("analyzeELF of a library built with %v found no function exported in %v", flags, analysis.Functions)
+		}
+	}
+}
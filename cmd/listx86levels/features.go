@@ -0,0 +1,490 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// The GOAMD64 level that brings each CPUID feature, as the x86-64 psABI and internal/buildcfg
+// define the levels. Instructions without CPUID flags are part of the baseline, features
+// missing here are outside the levels. OSFXSR and OSXSAVE are operating system support
+// for the SSE and AVX state, no instruction needs them on its own.
+var featureLevels = map[string]AssemblyMode{
+	"": v1,
+
+	// x86-64 baseline
+	"CMOV":   v1,
+	"CX8":    v1,
+	"FPU":    v1,
+	"FXSR":   v1,
+	"MMX":    v1,
+	"OSFXSR": v1,
+	"SCE":    v1,
+	"SSE":    v1,
+	"SSE2":   v1,
+
+	// x86-64-v2
+	"CMPXCHG16B": v2,
+	"LAHF-SAHF":  v2,
+	"POPCNT":     v2,
+	"SSE3":       v2,
+	"SSE4_1":     v2,
+	"SSE4_2":     v2,
+	"SSSE3":      v2,
+
+	// x86-64-v3
+	"AVX":     v3,
+	"AVX2":    v3,
+	"BMI1":    v3,
+	"BMI2":    v3,
+	"F16C":    v3,
+	"FMA":     v3,
+	"LZCNT":   v3,
+	"MOVBE":   v3,
+	"OSXSAVE": v3,
+
+	// x86-64-v4
+	"AVX512BW": v4,
+	"AVX512CD": v4,
+	"AVX512DQ": v4,
+	"AVX512F":  v4,
+	"AVX512VL": v4,
+
+	// Intel APX
+	"APXF": apx,
+}
+
+// form is an Instruction with its CPUID flags and encoding taken apart.
+type form struct {
+	*Instruction
+	Features []string
+	Level    AssemblyMode
+	VEX      bool
+	EVEX     bool
+	Length   int  // vector length in bits, 0 when any or none
+	W        int  // VEX.W or EVEX.W, -1 when ignored
+	Map      byte // 1 for 0F, 2 for 0F38, 3 for 0F3A, 4 to 6 for MAP4 to MAP6
+	Prefix   byte // the implied prefix, 0x66, 0xF3 or 0xF2
+	Opcode   byte
+	Digit    int // ModRM.reg as an opcode extension, -1 for /r
+	ModRM    int // the whole ModRM byte when the encoding fixes it, -1 otherwise
+}
+
+type encodingKey struct {
+	Legacy bool
+	EVEX   bool
+	Map    byte
+	Prefix byte
+	Opcode byte
+}
+
+// Instruction forms by every mnemonic they are written with, and VEX, EVEX and legacy 0F38 and 0F3A forms by their opcode.
+var mnemonicForms = make(map[string][]*form)
+var encodingForms = make(map[encodingKey][]*form)
+
+func init() {
+	for i := range x86Instructions {
+		f := newForm(&x86Instructions[i])
+		mnemonicForms[f.Intel] = append(mnemonicForms[f.Intel], f)
+		for _, name := range strings.Split(f.Go, "/") {
+			if name != "" && name != f.Intel {
+				mnemonicForms[name] = append(mnemonicForms[name], f)
+			}
+		}
+
+		// the APX forms in map 4 share opcodes across condition codes, they are found by prefix
+		if f.VEX || f.EVEX && f.Map != 4 {
+			key := encodingKey{EVEX: f.EVEX, Map: f.Map, Prefix: f.Prefix, Opcode: f.Opcode}
+			encodingForms[key] = append(encodingForms[key], f)
+		} else if f.Map == 2 || f.Map == 3 {
+			key := encodingKey{Legacy: true, Map: f.Map, Prefix: f.Prefix, Opcode: f.Opcode}
+			encodingForms[key] = append(encodingForms[key], f)
+		}
+	}
+}
+
+func newForm(instruction *Instruction) *form {
+	f := &form{Instruction: instruction, W: -1, Digit: -1, ModRM: -1}
+	if instruction.CPUID != "" {
+		f.Features = strings.Split(instruction.CPUID, ",")
+	}
+	f.Level = amd64Arch.featureLevel(f.Features)
+
+	fields := strings.Fields(instruction.Encoding)
+	if len(fields) < 2 || !strings.HasPrefix(fields[0], "VEX.") && !strings.HasPrefix(fields[0], "EVEX.") {
+		switch {
+		case strings.Contains(instruction.Operands, "xmm"):
+			f.Length = 128
+		case strings.Contains(instruction.Operands, "mm"):
+			f.Length = 64
+		}
+		legacyEncoding(f, fields)
+		return f
+	}
+
+	for i, part := range strings.Split(fields[0], ".") {
+		switch part {
+		case "VEX":
+			f.VEX = i == 0
+		case "EVEX":
+			f.EVEX = i == 0
+		case "128", "L0", "L128":
+			f.Length = 128
+		case "256", "L1", "L256":
+			f.Length = 256
+		case "512":
+			f.Length = 512
+		case "66":
+			f.Prefix = 0x66
+		case "F3":
+			f.Prefix = 0xF3
+		case "F2":
+			f.Prefix = 0xF2
+		case "0F":
+			f.Map = 1
+		case "0F38":
+			f.Map = 2
+		case "0F3A":
+			f.Map = 3
+		case "MAP4":
+			f.Map = 4
+		case "MAP5":
+			f.Map = 5
+		case "MAP6":
+			f.Map = 6
+		case "W0":
+			f.W = 0
+		case "W1":
+			f.W = 1
+		}
+	}
+
+	if opcode, err := strconv.ParseUint(fields[1], 16, 8); err == nil {
+		f.Opcode = byte(opcode)
+	}
+	if len(fields) > 2 && strings.HasPrefix(fields[2], "/") {
+		if digit, err := strconv.Atoi(fields[2][1:]); err == nil {
+			f.Digit = digit
+		}
+	} else if len(fields) > 2 {
+		if modrm, err := strconv.ParseUint(fields[2], 16, 8); err == nil {
+			f.ModRM = int(modrm)
+			f.Digit = int(modrm >> 3 & 7)
+		}
+	}
+
+	return f
+}
+
+// legacyEncoding takes apart the encoding of a legacy form in map 0F38 or 0F3A, such as
+// F2 REX.W 0F 38 F1 /r, the ones x86asm may not know. Other legacy forms are left alone.
+func legacyEncoding(f *form, fields []string) {
+	for i := 0; i+2 < len(fields); i++ {
+		switch fields[i] {
+		case "66", "F3", "F2":
+			if prefix, err := strconv.ParseUint(fields[i], 16, 8); err == nil {
+				f.Prefix = byte(prefix)
+			}
+		case "REX.W":
+			f.W = 1
+		case "0F":
+			if fields[i+1] != "38" && fields[i+1] != "3A" {
+				return
+			}
+			opcode, err := strconv.ParseUint(fields[i+2], 16, 8)
+			if err != nil {
+				return
+			}
+
+			f.Map, f.Opcode = 2, byte(opcode)
+			if fields[i+1] == "3A" {
+				f.Map = 3
+			}
+			if i+3 < len(fields) && strings.HasPrefix(fields[i+3], "/") {
+				if digit, err := strconv.Atoi(fields[i+3][1:]); err == nil {
+					f.Digit = digit
+				}
+			}
+			return
+		}
+	}
+}
+
+var vectorRegister = regexp.MustCompile(`\b([MXYZ])([0-9]+)\b`)
+var maskRegister = regexp.MustCompile(`\bK[0-7]\b`)
+
+var tileRegister = regexp.MustCompile(`\bTMM[0-7]\b`)
+
+// The general purpose registers R16 to R31 that APX adds, also as R16D, R16W and R16B.
+var extendedRegister = regexp.MustCompile(`\bR(1[6-9]|2[0-9]|3[01])[BWD]?\b`)
+
+// Legacy instructions that APX gives a new data destination (NDD) as one more operand, such as ADD R8, R9, R10. Sorted.
+var nddInstructions = []string{
+	"ADC",
+	"ADCX",
+	"ADD",
+	"ADOX",
+	"AND",
+	"DEC",
+	"INC",
+	"NEG",
+	"NOT",
+	"OR",
+	"RCL",
+	"RCR",
+	"ROL",
+	"ROR",
+	"SAR",
+	"SBB",
+	"SHL",
+	"SHLD",
+	"SHR",
+	"SHRD",
+	"SUB",
+	"XOR",
+}
+
+// usesAPX tells whether an instruction of a form without APX flags needs APX all the same,
+// as it names R16 to R31, or has a new data destination: more operands than any of the forms
+// its mnemonic is written for. Go spells SHLD as SHLQ with three operands, for one.
+func usesAPX(forms []*form, f *form, operands []string) bool {
+	for _, operand := range operands {
+		if extendedRegister.MatchString(operand) {
+			return true
+		}
+	}
+
+	if !contains(nddInstructions, f.Intel) {
+		return false
+	}
+
+	most := 0
+	for _, other := range forms {
+		if count := len(splitOperands(other.Operands)); count > most {
+			most = count
+		}
+	}
+
+	return len(splitOperands(strings.Join(operands, " "))) > most
+}
+
+var registerLengths = map[string]int{"M": 64, "X": 128, "Y": 256, "Z": 512}
+
+// operandLength finds the widest vector register in the operands, and whether
+// only EVEX can encode them: ZMM, mask registers and vector registers 16 to 31.
+func operandLength(operands []string) (int, bool) {
+	length := 0
+	evex := false
+	for _, operand := range operands {
+		for _, match := range vectorRegister.FindAllStringSubmatch(operand, -1) {
+			number, _ := strconv.Atoi(match[2])
+			if registerLengths[match[1]] > length {
+				length = registerLengths[match[1]]
+			}
+			evex = evex || match[1] == "Z" || match[1] != "M" && number >= 16
+		}
+		evex = evex || maskRegister.MatchString(operand)
+	}
+
+	return length, evex
+}
+
+// pickForm chooses the lowest level form among forms that fits the operands.
+// Forms that are not EVEX are preferred unless the operands need EVEX,
+// and forms of the same vector length as the operands over the others.
+func pickForm(forms []*form, length int, evex bool) *form {
+	var candidates []*form
+	for _, f := range forms {
+		if f.EVEX == evex {
+			candidates = append(candidates, f)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = forms
+	}
+
+	if length > 0 {
+		var sameLength []*form
+		for _, f := range candidates {
+			if f.Length == length {
+				sameLength = append(sameLength, f)
+			}
+		}
+		if len(sameLength) > 0 {
+			candidates = sameLength
+		}
+	}
+
+	best := candidates[0]
+	for _, f := range candidates[1:] {
+		if f.Level < best.Level {
+			best = f
+		}
+	}
+
+	return best
+}
+
+// evexLength is the vector length an EVEX form works on, given the length of its operands
+// or of its encoding. Scalar forms ignore the length bits and count as 128 bits, as do
+// forms without vector operands. Forms that are not EVEX have no EVEX length.
+func evexLength(f *form, length int) int {
+	switch {
+	case !f.EVEX || f.Map == 4:
+		return 0
+	case f.Length == 0 || length < 128:
+		return 128
+	case f.Length > length:
+		return f.Length
+	}
+
+	return length
+}
+
+// classifyMnemonic finds the form of mnemonic that the operands use, or nil when the mnemonic
+// is unknown. For EVEX forms it also returns the vector length of the operands. The
+// encoding {evex} forces an EVEX form, {vex} and {vex3} one that is not EVEX.
+func classifyMnemonic(mnemonic string, operands []string, encoding string) (*form, int) {
+	forms, ok := mnemonicForms[mnemonic]
+	if !ok {
+		return nil, 0
+	}
+
+	length, evex := operandLength(operands)
+	switch encoding {
+	case "{evex}":
+		evex = true
+	case "{vex}", "{vex3}":
+		evex = false
+	}
+	f := pickForm(forms, length, evex)
+	if f.Level < apx && usesAPX(forms, f, operands) {
+		promoted := *f
+		promoted.Features = append(append([]string(nil), f.Features...), "APXF")
+		promoted.Level = apx
+		f = &promoted
+	}
+
+	return f, evexLength(f, length)
+}
+
+// tileInstruction finds an instruction the tables don't know by its AMX tile register
+// operands, and returns its mnemonic: the token in front of the operand list.
+func tileInstruction(tokens []string) (string, bool) {
+	for i, token := range tokens {
+		if !tileRegister.MatchString(token) {
+			continue
+		}
+
+		for i > 0 && strings.HasSuffix(tokens[i-1], ",") {
+			i--
+		}
+		if i > 0 {
+			return tokens[i-1], true
+		}
+	}
+
+	return "", false
+}
+
+// vexFields takes apart the VEX or EVEX prefix of an instruction that vexLength measured.
+// EVEX with embedded rounding on registers reuses the vector length bits, it is 512 bits then.
+func vexFields(code []byte) (encodingKey, int, int, int, int) {
+	prefixes := [4]byte{0, 0x66, 0xF3, 0xF2}
+	var key encodingKey
+	var length, w, prefix int
+	switch code[0] {
+	case 0xC5:
+		key.Map = 1
+		length, w = int(code[1]>>2&1), 0
+		key.Prefix = prefixes[code[1]&3]
+		prefix = 2
+	case 0xC4:
+		key.Map = code[1] & 0x1F
+		length, w = int(code[2]>>2&1), int(code[2]>>7)
+		key.Prefix = prefixes[code[2]&3]
+		prefix = 3
+	case 0x62:
+		key.EVEX = true
+		key.Map = code[1] & 0x07
+		length, w = int(code[3]>>5&3), int(code[2]>>7)
+		key.Prefix = prefixes[code[2]&3]
+		if code[3]&0x10 != 0 && len(code) > 5 && code[5]>>6 == 3 {
+			length = 2
+		}
+		prefix = 4
+	}
+
+	key.Opcode = code[prefix]
+	modrm := -1
+	if len(code) > prefix+1 {
+		modrm = int(code[prefix+1])
+	}
+
+	return key, 128 << length, w, modrm >> 3 & 7, modrm
+}
+
+// classifyEncoding finds the form of a VEX or EVEX instruction by its opcode, or nil when there
+// is none, and for EVEX the vector length it encodes. Forms that fix the whole ModRM byte,
+// such as TILERELEASE, win over those that share its reg field.
+func classifyEncoding(code []byte) (*form, int) {
+	key, length, w, digit, modrm := vexFields(code)
+	var candidates []*form
+	for _, f := range encodingForms[key] {
+		if (f.W < 0 || f.W == w) && (f.Digit < 0 || f.Digit == digit) && (f.ModRM < 0 || f.ModRM == modrm) {
+			if f.ModRM >= 0 {
+				return f, evexLength(f, length)
+			}
+			candidates = append(candidates, f)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, 0
+	}
+
+	f := pickForm(candidates, length, key.EVEX)
+	if f.Length == 0 {
+		return f, evexLength(f, 0)
+	}
+	return f, evexLength(f, length)
+}
+
+// classifyLegacy finds the form of a legacy instruction in map 0F38 or 0F3A that legacyLength
+// measured, or nil when there is none.
+func classifyLegacy(key encodingKey, w, digit int) *form {
+	var candidates []*form
+	for _, f := range encodingForms[key] {
+		if (f.W < 0 || f.W == w) && (f.Digit < 0 || f.Digit == digit) {
+			candidates = append(candidates, f)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	return pickForm(candidates, 0, false)
+}
+
+// apxEVEX tells whether an EVEX prefix is one of APX: map 4 holds the legacy instructions
+// that APX promotes to EVEX, and in the other maps B4 and X4 select R16 to R31. B4 is
+// bit 3 of the first payload byte, which was reserved as zero, and X4 the inverted bit 2
+// of the second one, which was reserved as one.
+func apxEVEX(code []byte) (bool, bool) {
+	if code[1]&0x07 == 4 {
+		return true, true
+	}
+
+	return code[1]&0x08 != 0 || code[2]&0x04 == 0, false
+}
+
+// Xeon Phi features that AVX10 doesn't include, at any vector length.
+var xeonPhiFeatures = []string{
+	"AVX5124FMAPS",
+	"AVX5124VNNIW",
+	"AVX512ER",
+	"AVX512PF",
+	"PREFETCHWT1",
+}
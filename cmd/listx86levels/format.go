@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+)
+
+// Bytes read from the start of the input to guess its format.
+const sniffSize = 64 * 1024
+
+// Options are the command line settings that control how one input is read.
+type Options struct {
+	Format     string
+	Base       uint64
+	Bits       int
+	Arch       string
+	BuildFlags []string
+}
+
+var (
+	sniffCompileHeader = regexp.MustCompile(`(?m)^\S* STEXT\b`)
+	sniffAsmSource     = regexp.MustCompile(`(?m)^\s*(#include|#define|TEXT\s+\S*\(SB\)\s*,)`)
+	sniffGoObjdump     = regexp.MustCompile(`(?m)^TEXT \S+\(SB\)`)
+	sniffGNUHeader     = regexp.MustCompile(`(?m)^(?:[0-9a-fA-F]+ )?<[^>]+>:\s*$`)
+	sniffATTOperand    = regexp.MustCompile(`(?m)^\s*[0-9a-fA-F]+:.*%[a-z]`)
+	sniffHex           = regexp.MustCompile(`^(?:\s|,|0[xX]|\\x|[0-9a-fA-F])+$`)
+)
+
+// regularFile returns the input as a file when it can be read at random.
+func regularFile(input io.Reader) (*os.File, bool) {
+	if file, ok := input.(*os.File); ok {
+		if info, err := file.Stat(); err == nil && info.Mode().IsRegular() {
+			return file, true
+		}
+	}
+
+	return nil, false
+}
+
+// detectFormat guesses the format from the start of the input: the magic number of
+// executables and archives, or the headers and operands of the disassembly dialects.
+func detectFormat(head []byte) string {
+	magic := head
+	if len(magic) > len(archiveMagic) {
+		magic = magic[:len(archiveMagic)]
+	}
+
+	switch {
+	case len(magic) >= 4 && (bytes.HasPrefix(magic, []byte("\x7fELF")) || bytes.HasPrefix(magic, []byte("MZ")) || isMachO(magic)):
+		return "binary"
+	case string(magic) == archiveMagic || bytes.HasPrefix(head, []byte(goObjectHeader)):
+		return "binary"
+	case bytes.IndexByte(head, 0) >= 0:
+		return "raw"
+	case sniffCompileHeader.Match(head):
+		return "compile"
+	case sniffAsmSource.Match(head):
+		return "asm"
+	case sniffGoObjdump.Match(head):
+		return "go"
+	case sniffATTOperand.Match(head):
+		return "att"
+	case sniffGNUHeader.Match(head):
+		return "intel"
+	case len(bytes.TrimSpace(head)) > 0 && sniffHex.Match(head):
+		return "hex"
+	}
+
+	return "go"
+}
+
+// analyzeInput reads one input in the format given by options, or in the format
+// detected from its first bytes when that is auto.
+func analyzeInput(input io.Reader, options Options, analysis *Analysis) error {
+	input, compression, err := decompress(input)
+	if err != nil {
+		return err
+	}
+
+	if compression != "" && analysis.Verbose {
+		fmt.Println("Decompressing", compression)
+	}
+
+	buffered := bufio.NewReaderSize(input, sniffSize)
+	head, _ := buffered.Peek(sniffSize)
+	format := options.Format
+	if format == "auto" {
+		format = detectFormat(head)
+		if analysis.Verbose {
+			fmt.Println("Detected input format", format)
+		}
+	}
+
+	if format == "binary" {
+		// files are read at random, the peeked bytes only matter for streams
+		if file, ok := regularFile(input); ok {
+			return analyzeBinary(file, analysis)
+		}
+		return analyzeBinary(buffered, analysis)
+	}
+
+	name := options.Arch
+	if name == "auto" {
+		name = "amd64"
+		if format == "go" && isARM64Objdump(head) {
+			name = "arm64"
+		}
+		if analysis.Verbose && name != "amd64" {
+			fmt.Println("Detected architecture", name)
+		}
+	}
+
+	arch, ok := findArch(name)
+	if !ok {
+		return fmt.Errorf("unknown architecture %s", name)
+	}
+	analysis.SetArch(arch)
+
+	switch format {
+	case "hex", "raw":
+		analysis.Listing = true
+		return analyzeBytes(buffered, format == "hex", options.Base, options.Bits, analysis)
+	}
+
+	newParser, ok := lineParsers[format]
+	if !ok {
+		return fmt.Errorf("unknown input format %s", format)
+	}
+
+	if options.Bits == 32 {
+		analysis.Bits = 32
+	} else if format == "go" && options.Arch == "auto" && arch == amd64Arch {
+		if isGo386Objdump(head) {
+			analysis.Bits = 32
+			if analysis.Verbose {
+				fmt.Println("Detected 32-bit code, GOARCH=386")
+			}
+		} else if sniffGoObjdump.Match(head) && !goObjdump64.Match(head) {
+			fmt.Fprintln(os.Stderr, "No 64-bit registers or operations found, GOARCH=386 code needs -bits 32")
+		}
+	}
+
+	return scanLines(bufio.NewScanner(buffered), newParser(), analysis)
+}
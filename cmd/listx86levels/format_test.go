@@ -0,0 +1,75 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const objdump386 = `TEXT internal/abi.BoundsDecode(SB) /usr/local/go/src/internal/abi/bounds.go
+  bounds.go:86		0x8049000		658b0d00000000		MOVL GS:0, CX
+  bounds.go:86		0x8049007		8b89fcffffff		MOVL 0xfffffffc(CX), CX
+  bounds.go:86		0x804900d		3b6108			CMPL SP, 0x8(CX)
+  bounds.go:86		0x8049010		0f86bd000000		JBE 0x80490d3
+`
+
+const objdumpAMD64 = `TEXT internal/abi.BoundsDecode(SB) /usr/local/go/src/internal/abi/bounds.go
+  bounds.go:86		0x401000		493b6610		CMPQ SP, 0x10(R14)
+  bounds.go:86		0x401004		7614			JBE 0x40101a
+  bounds.go:86		0x401006		4883ec18		SUBQ $0x18, SP
+`
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		head   string
+		format string
+	}{
+		{"\x7fELF\x02\x01\x01\x00", "binary"},
+		{"MZ\x90\x00\x03\x00\x00\x00", "binary"},
+		{"\xcf\xfa\xed\xfe\x07\x00\x00\x01", "binary"},
+		{"\xca\xfe\xba\xbe\x00\x00\x00\x02\x01\x00\x00\x07", "binary"},
+		// a Java class file is no universal file
+		{"\xca\xfe\xba\xbe\x00\x00\x00\x34\x00\x1d", "raw"},
+		{"!<arch>\n__.PKGDEF", "binary"},
+		{"go object linux amd64 go1.22\n", "binary"},
+		{"\xf3\x48\x0f\xb8\xc0\x00\xc3", "raw"},
+		{"main.main STEXT size=10 args=0x0 locals=0x0 funcid=0x0 align=0x0\n", "compile"},
+		{"#include \"textflag.h\"\n\nTEXT ·add(SB), NOSPLIT, $0-24\n", "asm"},
+		{objdumpAMD64, "go"},
+		{objdump386, "go"},
+		{"\n/bin/ls:     file format elf64-x86-64\n\n0000000000004000 <_init>:\n    4000:\tf3 0f 1e fa          \tendbr64\n    4004:\t48 83 ec 08          \tsub    $0x8,%rsp\n", "att"},
+		{"\n/bin/ls:     file format elf64-x86-64\n\n0000000000004000 <_init>:\n    4000:\tf3 0f 1e fa          \tendbr64\n    4004:\t48 83 ec 08          \tsub    rsp,0x8\n", "intel"},
+		{"f3 48 0f b8 c0 c3\n", "hex"},
+		{"\\xf3\\x48\\x0f\\xb8\\xc0\\xc3", "hex"},
+		{"", "go"},
+	}
+
+	for _, test := range tests {
+		if format := detectFormat([]byte(test.head)); format != test.format {
+			t.Errorf("detectFormat(%q) = %s, want %s", test.head, format, test.format)
+		}
+	}
+}
+
+func TestAnalyzeInputBits(t *testing.T) {
+	tests := []struct {
+		text    string
+		options Options
+		bits    int
+	}{
+		{objdump386, Options{Format: "auto", Arch: "auto", Bits: 64}, 32},
+		{objdumpAMD64, Options{Format: "auto", Arch: "auto", Bits: 64}, 0},
+		// -arch amd64 keeps the text 64-bit
+		{objdump386, Options{Format: "auto", Arch: "amd64", Bits: 64}, 0},
+		{objdumpAMD64, Options{Format: "auto", Arch: "auto", Bits: 32}, 32},
+	}
+
+	for _, test := range tests {
+		analysis := NewAnalysis(false)
+		if err := analyzeInput(strings.NewReader(test.text), test.options, analysis); err != nil {
+			t.Fatal(err)
+		}
+		if analysis.Bits != test.bits {
+			t.Errorf("analyzeInput(%.40q, %+v) read %d-bit code, want %d", test.text, test.options, analysis.Bits, test.bits)
+		}
+	}
+}
@@ -0,0 +1,665 @@
+//go:build ignore
+
+// gentables writes tables.go from the instruction descriptions in golang.org/x/arch.
+//
+// x86.v0.2.csv lists every legacy and VEX instruction form with its Intel and Go syntax,
+// encoding and CPUID feature flags. x86.csv adds the forms that it spells the way x86asm
+// decodes them, such as MOVSD_XMM and LCALL, and the gathers that x86.v0.2.csv lacks.
+// The XED data that x86avxgen reads adds EVEX and the extensions after AVX2, with
+// the ISA set of every form, which tells the AVX-512 subset and vector length.
+//
+//	go run gentables.go [-dir golang.org/x/arch/x86] [-o tables.go]
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/arch/x86/x86csv"
+	"golang.org/x/arch/x86/xeddata"
+)
+
+// x86.csv leaves the CPUID column empty for these, or spells it in prose. CPU generations
+// rather than features, such as Pentium, are part of the baseline. Features are named
+// as in the x86-64 psABI, which joins LAHF and SAHF.
+var cpuidOverrides = map[string]string{
+	"CLAC":                   "SMAP",
+	"CLFLUSH":                "CLFSH",
+	"CLFLUSHOPT":             "CLFLUSHOPT",
+	"CMPXCHG16B":             "CMPXCHG16B",
+	"CMPXCHG8B":              "CX8",
+	"CRC32":                  "SSE4_2",
+	"CVTPD2PI":               "SSE2",
+	"CVTPI2PD":               "SSE2",
+	"CVTPI2PS":               "SSE",
+	"CVTPS2PI":               "SSE",
+	"CVTTPD2PI":              "SSE2",
+	"CVTTPS2PI":              "SSE",
+	"EMMS":                   "MMX",
+	"FISTTP":                 "SSE3",
+	"FXRSTOR":                "FXSR",
+	"FXRSTOR64":              "FXSR",
+	"FXSAVE":                 "FXSR",
+	"FXSAVE64":               "FXSR",
+	"LAHF":                   "LAHF-SAHF",
+	"LFENCE":                 "SSE2",
+	"MASKMOVQ":               "SSE",
+	"MFENCE":                 "SSE2",
+	"MONITOR":                "MONITOR",
+	"MOVBE":                  "MOVBE",
+	"MOVDQ2Q":                "SSE2",
+	"MOVNTI":                 "SSE2",
+	"MOVNTQ":                 "SSE",
+	"MOVQ2DQ":                "SSE2",
+	"MWAIT":                  "MONITOR",
+	"POPCNT":                 "POPCNT",
+	"PREFETCHNTA":            "SSE",
+	"PREFETCHT0":             "SSE",
+	"PREFETCHT1":             "SSE",
+	"PREFETCHT2":             "SSE",
+	"PSHUFW":                 "SSE",
+	"RDTSCP":                 "RDTSCP",
+	"SAHF":                   "LAHF-SAHF",
+	"SFENCE":                 "SSE",
+	"STAC":                   "SMAP",
+	"SYSCALL":                "SCE",
+	"SYSRET":                 "SCE",
+	"XGETBV":                 "XSAVE",
+	"XRSTOR":                 "XSAVE",
+	"XRSTOR64":               "XSAVE",
+	"XRSTORS":                "XSAVES",
+	"XRSTORS64":              "XSAVES",
+	"XSAVE":                  "XSAVE",
+	"XSAVE64":                "XSAVE",
+	"XSAVEC":                 "XSAVEC",
+	"XSAVEC64":               "XSAVEC",
+	"XSAVES":                 "XSAVES",
+	"XSAVES64":               "XSAVES",
+	"XSETBV":                 "XSAVE",
+	"486":                    "",
+	"Pentium":                "",
+	"PentiumII":              "",
+	"Both AES and AVX flags": "AES,AVX",
+	"HLE or RTM":             "HLE,RTM",
+	"PCLMULQDQ+AVX":          "PCLMULQDQ,AVX",
+}
+
+// CPUID flags of the XED ISA sets and extensions, spelled as in x86.csv and internal/cpu.
+var xedFeatures = map[string]string{
+	"ADOX_ADCX":         "ADX",
+	"AVX2GATHER":        "AVX2",
+	"AVX512_4FMAPS":     "AVX5124FMAPS",
+	"AVX512_4VNNIW":     "AVX5124VNNIW",
+	"AVX512_BITALG":     "AVX512BITALG",
+	"AVX512_GFNI":       "GFNI",
+	"AVX512_IFMA":       "AVX512IFMA",
+	"AVX512_VAES":       "VAES",
+	"AVX512_VBMI":       "AVX512VBMI",
+	"AVX512_VBMI2":      "AVX512VBMI2",
+	"AVX512_VNNI":       "AVX512VNNI",
+	"AVX512_VPCLMULQDQ": "VPCLMULQDQ",
+	"AVX512_VPOPCNTDQ":  "AVX512VPOPCNTDQ",
+	"AVXAES":            "AES,AVX",
+	"AVX_GFNI":          "GFNI,AVX",
+	"BASE":              "",
+	"I386":              "",
+	"PKU":               "OSPKE",
+	"PPRO":              "",
+	"RDWRFSGS":          "FSGSBASE",
+	"VAES":              "VAES,AVX",
+	"VPCLMULQDQ":        "VPCLMULQDQ,AVX",
+}
+
+// XED ISA sets end in the vector length of the form.
+var xedLengthSuffixes = []string{"_128N", "_128", "_256", "_512", "_SCALAR", "_KOP"}
+
+// Go splits some instructions by vector length or operand size, as x86avxgen does.
+var goSuffixes = map[string]string{
+	"VCVTPD2DQ":   "XY",
+	"VCVTPD2PS":   "XY",
+	"VCVTTPD2DQ":  "XY",
+	"VCVTQQ2PS":   "XY",
+	"VCVTUQQ2PS":  "XY",
+	"VCVTPD2UDQ":  "XY",
+	"VCVTTPD2UDQ": "XY",
+	"VFPCLASSPD":  "XYZ",
+	"VFPCLASSPS":  "XYZ",
+	"VCVTSD2USI":  "LQ",
+	"VCVTSS2USI":  "LQ",
+	"VCVTTSD2USI": "LQ",
+	"VCVTTSS2USI": "LQ",
+	"VCVTUSI2SD":  "LQ",
+	"VCVTUSI2SS":  "LQ",
+	"VCVTSI2SD":   "LQ",
+	"VCVTSI2SS":   "LQ",
+}
+
+// XED operands by the register class they name, as Intel syntax writes them.
+var xedOperands = map[string]string{
+	"XMM":    "xmm",
+	"YMM":    "ymm",
+	"ZMM":    "zmm",
+	"MASK":   "k",
+	"GPR32":  "r32",
+	"GPR64":  "r64",
+	"GPRv":   "r",
+	"GPRy":   "r",
+	"VGPR32": "r32",
+	"VGPR64": "r64",
+	"BND":    "bnd",
+}
+
+// Instruction forms newer than the x86.csv and XED data in golang.org/x/arch.
+var newerInstructions = []row{
+	{"CLDEMOTE", "", "CLDEMOTE", "NP 0F 1C /0", "m8"},
+	{"MOVDIR64B", "", "MOVDIR64B", "66 0F 38 F8 /r", "r64, m512"},
+	{"MOVDIRI", "", "MOVDIRI", "NP 0F 38 F9 /r", "m32, r32"},
+	{"MOVDIRI", "", "MOVDIRI", "NP REX.W 0F 38 F9 /r", "m64, r64"},
+	{"SERIALIZE", "", "SERIALIZE", "NP 0F 01 E8", ""},
+
+	// AMX works on the tile registers TMM0 to TMM7
+	{"LDTILECFG", "", "AMXTILE", "VEX.128.NP.0F38.W0 49 /0", "m512"},
+	{"STTILECFG", "", "AMXTILE", "VEX.128.66.0F38.W0 49 /0", "m512"},
+	{"TILERELEASE", "", "AMXTILE", "VEX.128.NP.0F38.W0 49 C0", ""},
+	{"TILEZERO", "", "AMXTILE", "VEX.128.F2.0F38.W0 49 /r", "tmm1"},
+	{"TILELOADD", "", "AMXTILE", "VEX.128.F2.0F38.W0 4B /r", "tmm1, sibmem"},
+	{"TILELOADDT1", "", "AMXTILE", "VEX.128.66.0F38.W0 4B /r", "tmm1, sibmem"},
+	{"TILESTORED", "", "AMXTILE", "VEX.128.F3.0F38.W0 4B /r", "sibmem, tmm1"},
+	{"TDPBF16PS", "", "AMXBF16", "VEX.128.F3.0F38.W0 5C /r", "tmm1, tmm2, tmm3"},
+	{"TDPBSSD", "", "AMXINT8", "VEX.128.F2.0F38.W0 5E /r", "tmm1, tmm2, tmm3"},
+	{"TDPBSUD", "", "AMXINT8", "VEX.128.F3.0F38.W0 5E /r", "tmm1, tmm2, tmm3"},
+	{"TDPBUSD", "", "AMXINT8", "VEX.128.66.0F38.W0 5E /r", "tmm1, tmm2, tmm3"},
+	{"TDPBUUD", "", "AMXINT8", "VEX.128.NP.0F38.W0 5E /r", "tmm1, tmm2, tmm3"},
+
+	// APX, with the conditional instructions in apxConditionalInstructions
+	{"PUSH2", "", "APXF", "EVEX.L0.NP.MAP4.W0 FF /6", "r64, r64"},
+	{"PUSH2P", "", "APXF", "EVEX.L0.NP.MAP4.W1 FF /6", "r64, r64"},
+	{"POP2", "", "APXF", "EVEX.L0.NP.MAP4.W0 8F /0", "r64, r64"},
+	{"POP2P", "", "APXF", "EVEX.L0.NP.MAP4.W1 8F /0", "r64, r64"},
+	{"PUSHP", "", "APXF", "REX2.W1 50+rd", "r64"},
+	{"POPP", "", "APXF", "REX2.W1 58+rd", "r64"},
+	{"JMPABS", "", "APXF", "REX2.M0.W0 A1 i64", "imm64"},
+}
+
+// Condition codes of the APX conditional instructions. CCMP and CTEST have T and F
+// for true and false instead of the parity conditions.
+var apxConditions = []string{"O", "NO", "B", "NB", "Z", "NZ", "BE", "NBE", "S", "NS", "P", "NP", "L", "NL", "LE", "NLE"}
+
+var apxConditionalInstructions = []row{
+	{"CCMP", "", "APXF", "EVEX.L0.NP.MAP4.SCC 39 /r", "r/m64, r64"},
+	{"CTEST", "", "APXF", "EVEX.L0.NP.MAP4.SCC 85 /r", "r/m64, r64"},
+	{"CFCMOV", "", "APXF", "EVEX.L0.NP.MAP4 40+cc /r", "r64, r/m64"},
+	{"SETZU", "", "APXF", "EVEX.L0.F2.MAP4 40+cc /r", "r/m8"},
+}
+
+// Vector instructions newer than the data in golang.org/x/arch, one form per vector length.
+// The encoding spells the length as LL and the operands the registers as vec, the EVEX
+// forms also take a 512-bit length and need AVX512F, and AVX512VL below 512 bits.
+var newerVectorInstructions = []struct {
+	Intel, CPUID, Encoding, Operands string
+}{
+	{"VPDPBUSD", "AVXVNNI,AVX", "VEX.LL.66.0F38.W0 50 /r", "vec1, vec2, vec3/mem"},
+	{"VPDPBUSDS", "AVXVNNI,AVX", "VEX.LL.66.0F38.W0 51 /r", "vec1, vec2, vec3/mem"},
+	{"VPDPWSSD", "AVXVNNI,AVX", "VEX.LL.66.0F38.W0 52 /r", "vec1, vec2, vec3/mem"},
+	{"VPDPWSSDS", "AVXVNNI,AVX", "VEX.LL.66.0F38.W0 53 /r", "vec1, vec2, vec3/mem"},
+	{"VPMADD52LUQ", "AVXIFMA,AVX", "VEX.LL.66.0F38.W1 B4 /r", "vec1, vec2, vec3/mem"},
+	{"VPMADD52HUQ", "AVXIFMA,AVX", "VEX.LL.66.0F38.W1 B5 /r", "vec1, vec2, vec3/mem"},
+
+	{"VADDPH", "AVX512FP16", "EVEX.LL.NP.MAP5.W0 58 /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VSUBPH", "AVX512FP16", "EVEX.LL.NP.MAP5.W0 5C /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VMULPH", "AVX512FP16", "EVEX.LL.NP.MAP5.W0 59 /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VDIVPH", "AVX512FP16", "EVEX.LL.NP.MAP5.W0 5E /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VMINPH", "AVX512FP16", "EVEX.LL.NP.MAP5.W0 5D /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VMAXPH", "AVX512FP16", "EVEX.LL.NP.MAP5.W0 5F /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VSQRTPH", "AVX512FP16", "EVEX.LL.NP.MAP5.W0 51 /r", "vec1{k1}{z}, vec2/mem/m16bcst"},
+	{"VCMPPH", "AVX512FP16", "EVEX.LL.NP.0F3A.W0 C2 /r ib", "k1{k2}, vec2, vec3/mem/m16bcst, imm8"},
+	{"VFPCLASSPH", "AVX512FP16", "EVEX.LL.NP.0F3A.W0 66 /r ib", "k1{k2}, vec2/mem/m16bcst, imm8"},
+	{"VGETMANTPH", "AVX512FP16", "EVEX.LL.NP.0F3A.W0 26 /r ib", "vec1{k1}{z}, vec2/mem/m16bcst, imm8"},
+	{"VREDUCEPH", "AVX512FP16", "EVEX.LL.NP.0F3A.W0 56 /r ib", "vec1{k1}{z}, vec2/mem/m16bcst, imm8"},
+	{"VRNDSCALEPH", "AVX512FP16", "EVEX.LL.NP.0F3A.W0 08 /r ib", "vec1{k1}{z}, vec2/mem/m16bcst, imm8"},
+	{"VGETEXPPH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 42 /r", "vec1{k1}{z}, vec2/mem/m16bcst"},
+	{"VRCPPH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 4C /r", "vec1{k1}{z}, vec2/mem/m16bcst"},
+	{"VRSQRTPH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 4E /r", "vec1{k1}{z}, vec2/mem/m16bcst"},
+	{"VSCALEFPH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 2C /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VFMADD132PH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 98 /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VFMADD213PH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 A8 /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VFMADD231PH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 B8 /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VFMSUB132PH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 9A /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VFMSUB213PH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 AA /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VFMSUB231PH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 BA /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VFNMADD132PH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 9C /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VFNMADD213PH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 AC /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VFNMADD231PH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 BC /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VFNMSUB132PH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 9E /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VFNMSUB213PH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 AE /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VFNMSUB231PH", "AVX512FP16", "EVEX.LL.66.MAP6.W0 BE /r", "vec1{k1}{z}, vec2, vec3/mem/m16bcst"},
+	{"VFMADDCPH", "AVX512FP16", "EVEX.LL.F3.MAP6.W0 56 /r", "vec1{k1}{z}, vec2, vec3/mem/m32bcst"},
+	{"VFCMADDCPH", "AVX512FP16", "EVEX.LL.F2.MAP6.W0 56 /r", "vec1{k1}{z}, vec2, vec3/mem/m32bcst"},
+	{"VFMULCPH", "AVX512FP16", "EVEX.LL.F3.MAP6.W0 D6 /r", "vec1{k1}{z}, vec2, vec3/mem/m32bcst"},
+	{"VFCMULCPH", "AVX512FP16", "EVEX.LL.F2.MAP6.W0 D6 /r", "vec1{k1}{z}, vec2, vec3/mem/m32bcst"},
+	{"VCVTDQ2PH", "AVX512FP16", "EVEX.LL.NP.MAP5.W0 5B /r", "vec1{k1}{z}, vec2/mem/m32bcst"},
+	{"VCVTPH2DQ", "AVX512FP16", "EVEX.LL.66.MAP5.W0 5B /r", "vec1{k1}{z}, vec2/mem/m16bcst"},
+	{"VCVTPD2PH", "AVX512FP16", "EVEX.LL.66.MAP5.W1 5A /r", "vec1{k1}{z}, vec2/mem/m64bcst"},
+	{"VCVTPH2PD", "AVX512FP16", "EVEX.LL.NP.MAP5.W0 5A /r", "vec1{k1}{z}, vec2/mem/m16bcst"},
+	{"VCVTPH2PSX", "AVX512FP16", "EVEX.LL.66.MAP6.W0 13 /r", "vec1{k1}{z}, vec2/mem/m16bcst"},
+	{"VCVTPS2PHX", "AVX512FP16", "EVEX.LL.66.MAP5.W0 1D /r", "vec1{k1}{z}, vec2/mem/m32bcst"},
+	{"VCVTPH2W", "AVX512FP16", "EVEX.LL.66.MAP5.W0 7D /r", "vec1{k1}{z}, vec2/mem/m16bcst"},
+	{"VCVTW2PH", "AVX512FP16", "EVEX.LL.F3.MAP5.W0 7D /r", "vec1{k1}{z}, vec2/mem/m16bcst"},
+}
+
+// Scalar AVX512-FP16 instructions, which ignore the vector length.
+var newerScalarInstructions = []row{
+	{"VADDSH", "", "AVX512FP16,AVX512F", "EVEX.LIG.F3.MAP5.W0 58 /r", "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{"VSUBSH", "", "AVX512FP16,AVX512F", "EVEX.LIG.F3.MAP5.W0 5C /r", "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{"VMULSH", "", "AVX512FP16,AVX512F", "EVEX.LIG.F3.MAP5.W0 59 /r", "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{"VDIVSH", "", "AVX512FP16,AVX512F", "EVEX.LIG.F3.MAP5.W0 5E /r", "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{"VMINSH", "", "AVX512FP16,AVX512F", "EVEX.LIG.F3.MAP5.W0 5D /r", "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{"VMAXSH", "", "AVX512FP16,AVX512F", "EVEX.LIG.F3.MAP5.W0 5F /r", "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{"VSQRTSH", "", "AVX512FP16,AVX512F", "EVEX.LIG.F3.MAP5.W0 51 /r", "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{"VCMPSH", "", "AVX512FP16,AVX512F", "EVEX.LIG.F3.0F3A.W0 C2 /r ib", "k1{k2}, xmm2, xmm3/m16, imm8"},
+	{"VCOMISH", "", "AVX512FP16,AVX512F", "EVEX.LIG.NP.MAP5.W0 2F /r", "xmm1, xmm2/m16"},
+	{"VUCOMISH", "", "AVX512FP16,AVX512F", "EVEX.LIG.NP.MAP5.W0 2E /r", "xmm1, xmm2/m16"},
+	{"VMOVSH", "", "AVX512FP16,AVX512F", "EVEX.LIG.F3.MAP5.W0 10 /r", "xmm1{k1}{z}, m16"},
+	{"VMOVSH", "", "AVX512FP16,AVX512F", "EVEX.LIG.F3.MAP5.W0 11 /r", "m16{k1}, xmm1"},
+	{"VMOVW", "", "AVX512FP16,AVX512F", "EVEX.128.66.MAP5.WIG 6E /r", "xmm1, r32/m16"},
+	{"VMOVW", "", "AVX512FP16,AVX512F", "EVEX.128.66.MAP5.WIG 7E /r", "r32/m16, xmm1"},
+	{"VCVTSH2SS", "", "AVX512FP16,AVX512F", "EVEX.LIG.NP.MAP6.W0 13 /r", "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{"VCVTSS2SH", "", "AVX512FP16,AVX512F", "EVEX.LIG.NP.MAP5.W0 1D /r", "xmm1{k1}{z}, xmm2, xmm3/m32"},
+	{"VFMADD132SH", "", "AVX512FP16,AVX512F", "EVEX.LIG.66.MAP6.W0 99 /r", "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{"VFMADD213SH", "", "AVX512FP16,AVX512F", "EVEX.LIG.66.MAP6.W0 A9 /r", "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{"VFMADD231SH", "", "AVX512FP16,AVX512F", "EVEX.LIG.66.MAP6.W0 B9 /r", "xmm1{k1}{z}, xmm2, xmm3/m16"},
+}
+
+// vectorForms expands one of newerVectorInstructions into its forms of each vector length.
+func vectorForms(intel string, cpuid string, encoding string, operands string) []row {
+	registers := map[string]string{"128": "xmm", "256": "ymm", "512": "zmm"}
+	lengths := []string{"128", "256"}
+	if strings.HasPrefix(encoding, "EVEX.") {
+		lengths = append(lengths, "512")
+	}
+
+	var rows []row
+	for _, length := range lengths {
+		flags := cpuid
+		if strings.HasPrefix(encoding, "EVEX.") {
+			flags += ",AVX512F"
+			if length != "512" {
+				flags += ",AVX512VL"
+			}
+		}
+
+		memory := "m" + length
+		form := strings.ReplaceAll(strings.ReplaceAll(operands, "mem", memory), "vec", registers[length])
+		rows = append(rows, row{intel, "", flags, strings.Replace(encoding, "LL", length, 1), form})
+	}
+
+	return rows
+}
+
+// Families of instructions that x86.csv leaves without CPUID flags, by mnemonic prefix.
+// The first match counts: FCMOVcc and FCOMI came with CMOV, the other x87 instructions are FPU.
+var cpuidPrefixes = []struct {
+	Prefix string
+	CPUID  string
+}{
+	{"CMOV", "CMOV"},
+	{"FCMOV", "CMOV,FPU"},
+	{"FCOMI", "CMOV,FPU"},
+	{"FUCOMI", "CMOV,FPU"},
+	{"F", "FPU"},
+}
+
+func cpuid(inst *x86csv.Inst) string {
+	if flags, ok := cpuidOverrides[inst.IntelOpcode()]; ok {
+		return flags
+	}
+
+	if flags, ok := cpuidOverrides[inst.CPUID]; ok {
+		return flags
+	}
+
+	if inst.CPUID == "" {
+		for _, family := range cpuidPrefixes {
+			if strings.HasPrefix(inst.IntelOpcode(), family.Prefix) {
+				return family.CPUID
+			}
+		}
+	}
+
+	return inst.CPUID
+}
+
+// goOpcodes splits the Go column, which lists alternatives as PUSHW/PUSHL/PUSHQ
+// and marks indirect forms with a *.
+func goOpcodes(inst *x86csv.Inst) []string {
+	var opcodes []string
+	for _, opcode := range strings.Split(inst.GoOpcode(), "/") {
+		opcode = strings.TrimSuffix(opcode, "*")
+		if opcode != "" && opcode != "-" {
+			opcodes = append(opcodes, opcode)
+		}
+	}
+
+	return opcodes
+}
+
+func defaultDirectory() string {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "golang.org/x/arch").Output()
+	if err != nil {
+		log.Fatalln("locating golang.org/x/arch:", err)
+	}
+
+	return filepath.Join(strings.TrimSpace(string(out)), "x86")
+}
+
+func readInstructions(path string) []*x86csv.Inst {
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer file.Close()
+
+	insts, err := x86csv.NewReader(file).ReadAll()
+	if err != nil {
+		log.Fatalln(path, err)
+	}
+
+	return insts
+}
+
+// readVersion1 reads the forms of the older x86.csv whose mnemonic x86.v0.2.csv does not know.
+// Its columns are Intel syntax, encoding, 32-bit and 64-bit validity, CPUID flags and tags.
+func readVersion1(path string, known map[string]bool) []*x86csv.Inst {
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comment = '#'
+	records, err := reader.ReadAll()
+	if err != nil {
+		log.Fatalln(path, err)
+	}
+
+	var insts []*x86csv.Inst
+	for _, record := range records {
+		inst := &x86csv.Inst{Intel: record[0], Encoding: record[1], Mode32: record[2], Mode64: record[3], CPUID: record[4], Tags: record[5]}
+		if !known[inst.IntelOpcode()] {
+			insts = append(insts, inst)
+		}
+	}
+
+	return insts
+}
+
+func readXED(path string) []*xeddata.Object {
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer file.Close()
+
+	objects, err := xeddata.NewReader(file).ReadAll()
+	if err != nil {
+		log.Fatalln(path, err)
+	}
+
+	return objects
+}
+
+// xedCPUID gives the CPUID flags of an XED object. Forms with a vector length
+// suffix below 512 need AVX512VL and every EVEX form needs AVX512F.
+func xedCPUID(object *xeddata.Object, evex bool) string {
+	set := object.ISASet
+	if set == "" {
+		set = object.Extension
+	}
+
+	length := ""
+	for _, suffix := range xedLengthSuffixes {
+		if strings.HasSuffix(set, suffix) {
+			set, length = strings.TrimSuffix(set, suffix), suffix
+			break
+		}
+	}
+
+	flags := set
+	if name, ok := xedFeatures[set]; ok {
+		flags = name
+	}
+
+	if evex && !strings.Contains(flags, "AVX512F") {
+		flags += ",AVX512F"
+	}
+	if length == "_128" || length == "_256" {
+		flags += ",AVX512VL"
+	}
+
+	return strings.TrimPrefix(flags, ",")
+}
+
+// xedEncoding writes an XED pattern the way x86.csv writes encodings, such as EVEX.512.66.0F38.W1 C8 /r.
+func xedEncoding(pattern string) (string, bool, string) {
+	var kind, length, prefix, opcodeMap, w, digit, immediate string
+	var opcodes []string
+	var legacy []string
+	suffix := ""
+	length = "LIG"
+	w = "WIG"
+	digit = "/r"
+	for _, token := range strings.Fields(pattern) {
+		switch {
+		case token == "EVV":
+			kind = "EVEX"
+		case token == "VV1":
+			kind = "VEX"
+		case token == "VL128" || token == "VL=0":
+			length, suffix = "128", "X"
+		case token == "VL256" || token == "VL=1":
+			length, suffix = "256", "Y"
+		case token == "VL512" || token == "FIX_ROUND_LEN512()":
+			length, suffix = "512", "Z"
+		case token == "V66" || token == "osz_refining_prefix":
+			prefix = "66"
+		case token == "VF2" || token == "f2_refining_prefix":
+			prefix = "F2"
+		case token == "VF3" || token == "f3_refining_prefix":
+			prefix = "F3"
+		case token == "no_refining_prefix":
+			prefix = "NP"
+		case token == "rexw_prefix":
+			legacy = append(legacy, "REX.W")
+		case token == "V0F" || token == "V0F38" || token == "V0F3A":
+			opcodeMap = token[1:]
+		case token == "W0" || token == "W1":
+			w = token
+		case strings.HasPrefix(token, "0x"):
+			opcodes = append(opcodes, strings.ToUpper(token[2:]))
+		case strings.HasPrefix(token, "REG[0b"):
+			var value int
+			fmt.Sscanf(token, "REG[0b%b]", &value)
+			digit = fmt.Sprintf("/%d", value)
+		case token == "UIMM8()" || token == "SE_IMM8()":
+			immediate = " ib"
+		}
+	}
+
+	if strings.HasSuffix(w, "1") {
+		suffix += "Q"
+	} else if w == "W0" {
+		suffix += "L"
+	}
+
+	opcode := strings.Join(opcodes, " ")
+	if kind == "" {
+		if prefix != "" {
+			legacy = append([]string{prefix}, legacy...)
+		}
+		return strings.Join(append(legacy, opcode), " ") + " " + digit + immediate, false, suffix
+	}
+
+	fields := []string{kind, length}
+	if prefix != "" && prefix != "NP" {
+		fields = append(fields, prefix)
+	}
+	fields = append(fields, opcodeMap, w)
+	return strings.Join(fields, ".") + " " + opcode + " " + digit + immediate, kind == "EVEX", suffix
+}
+
+// xedOperandForms writes the visible operands of an XED form in Intel syntax, such as zmm {k}, zmm, m.
+func xedOperandForms(operands string) string {
+	var forms []string
+	for _, operand := range strings.Fields(operands) {
+		if strings.Contains(operand, "SUPP") {
+			continue
+		}
+
+		name, value, ok := strings.Cut(operand, "=")
+		switch {
+		case strings.HasPrefix(operand, "MEM0"):
+			forms = append(forms, "m")
+		case strings.HasPrefix(operand, "IMM0"):
+			forms = append(forms, "imm8")
+		case ok && strings.HasPrefix(name, "REG") && strings.HasPrefix(value, "MASK1()"):
+			forms = append(forms, "{k}")
+		case ok && strings.HasPrefix(name, "REG"):
+			class := value
+			if i := strings.IndexAny(class, "_("); i > 0 {
+				class = class[:i]
+			}
+			if form, ok := xedOperands[class]; ok {
+				forms = append(forms, form)
+			}
+		}
+	}
+
+	return strings.Join(forms, ", ")
+}
+
+type row struct {
+	Intel, Go, CPUID, Encoding, Operands string
+}
+
+func main() {
+	var directory string
+	flag.StringVar(&directory, "dir", "", "golang.org/x/arch/x86 directory, by default the one of the golang.org/x/arch in go.mod")
+
+	var output string
+	flag.StringVar(&output, "o", "tables.go", "Output file")
+
+	flag.Parse()
+
+	if directory == "" {
+		directory = defaultDirectory()
+	}
+
+	insts := readInstructions(filepath.Join(directory, "x86.v0.2.csv"))
+	known := make(map[string]bool)
+	for _, inst := range insts {
+		known[inst.IntelOpcode()] = true
+	}
+	insts = append(insts, readVersion1(filepath.Join(directory, "x86.csv"), known)...)
+
+	var rows []row
+	seen := make(map[row]bool)
+	features := make(map[string]bool)
+	add := func(r row) {
+		if !seen[r] {
+			seen[r] = true
+			rows = append(rows, r)
+		}
+	}
+
+	for _, inst := range insts {
+		flags := cpuid(inst)
+		features[inst.IntelOpcode()+" "+flags] = true
+		add(row{inst.IntelOpcode(), strings.Join(goOpcodes(inst), "/"), flags, inst.Encoding, strings.Join(inst.IntelArgs(), ", ")})
+	}
+
+	// XED repeats AVX and AVX2 from x86.csv, only the instructions and features new to x86.csv are taken.
+	for _, object := range readXED(filepath.Join(directory, "x86avxgen", "testdata", "xedpath", "all-dec-instructions.txt")) {
+		if object.HasAttribute("AMDONLY") || object.Extension == "XOP" || object.Extension == "FMA4" {
+			continue
+		}
+
+		intel := object.Iclass
+		if object.Disasm != "" {
+			intel = strings.ToUpper(object.Disasm)
+		}
+
+		for _, inst := range object.Insts {
+			encoding, evex, suffix := xedEncoding(inst.Pattern)
+			flags := xedCPUID(object, evex)
+			if features[intel+" "+flags] {
+				continue
+			}
+
+			goName := intel
+			if suffixes, ok := goSuffixes[intel]; ok {
+				for _, c := range suffix {
+					if strings.ContainsRune(suffixes, c) {
+						goName += string(c)
+						break
+					}
+				}
+			}
+
+			add(row{intel, goName, flags, encoding, xedOperandForms(inst.Operands)})
+		}
+	}
+
+	for _, r := range newerInstructions {
+		add(r)
+	}
+	for _, inst := range newerVectorInstructions {
+		for _, r := range vectorForms(inst.Intel, inst.CPUID, inst.Encoding, inst.Operands) {
+			add(r)
+		}
+	}
+	for _, r := range newerScalarInstructions {
+		add(r)
+	}
+	for _, r := range apxConditionalInstructions {
+		for _, condition := range apxConditions {
+			if r.Intel == "CCMP" || r.Intel == "CTEST" {
+				switch condition {
+				case "P":
+					condition = "T"
+				case "NP":
+					condition = "F"
+				}
+			}
+			add(row{r.Intel + condition, r.Go, r.CPUID, r.Encoding, r.Operands})
+		}
+	}
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "// Code generated by gentables.go from x86.v0.2.csv, x86.csv and XED; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buffer, "package main\n\n")
+	fmt.Fprintf(&buffer, "// x86Instructions lists every instruction form in x86.v0.2.csv, x86.csv and the XED data of x86avxgen,\n")
+	fmt.Fprintf(&buffer, "// and the newer forms in gentables.go.\n")
+	fmt.Fprintf(&buffer, "var x86Instructions = []Instruction{\n")
+	for _, r := range rows {
+		fmt.Fprintf(&buffer, "\t{Intel: %q, Go: %q, CPUID: %q, Encoding: %q, Operands: %q},\n", r.Intel, r.Go, r.CPUID, r.Encoding, r.Operands)
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if err := os.WriteFile(output, source, 0o644); err != nil {
+		log.Fatalln(err)
+	}
+}
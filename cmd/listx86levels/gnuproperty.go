@@ -0,0 +1,315 @@
+package main
+
+import (
+	"bytes"
+	"debug/buildinfo"
+	"debug/elf"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// The x86 ISA level properties that linkers write into .note.gnu.property.
+// https://gitlab.com/x86-psABIs/x86-64-ABI
+const (
+	ntGNUPropertyType0       = 5
+	gnuPropertyX86ISA1Needed = 0xc0008002
+	gnuPropertyX86ISA1Used   = 0xc0010002
+	ptGNUProperty            = 0x6474e553
+	gnuPropertySection       = ".note.gnu.property"
+)
+
+// isaLevel is the highest level set in a GNU_PROPERTY_X86_ISA_1 bit mask,
+// where bit 0 is x86-64-baseline and bits 1 to 3 are x86-64-v2 to v4.
+func isaLevel(mask uint32) AssemblyMode {
+	for level := v4; level >= v1; level-- {
+		if mask&(1<<(level-1)) != 0 {
+			return level
+		}
+	}
+
+	return na
+}
+
+// isaProperty is one property of a NT_GNU_PROPERTY_TYPE_0 note, with the offset of its data in the section.
+type isaProperty struct {
+	Type   uint32
+	Value  uint32
+	Offset int
+}
+
+// readProperties walks the notes in a .note.gnu.property section. Properties are
+// aligned to 8 bytes in ELF64 and to 4 bytes in ELF32.
+func readProperties(data []byte, order binary.ByteOrder, align int) []isaProperty {
+	var properties []isaProperty
+	for offset := 0; offset+12 <= len(data); {
+		nameSize := int(order.Uint32(data[offset:]))
+		descSize := int(order.Uint32(data[offset+4:]))
+		noteType := order.Uint32(data[offset+8:])
+		desc := offset + 12 + (nameSize+3)&^3
+		next := desc + (descSize+align-1)&^(align-1)
+		if desc+descSize > len(data) {
+			break
+		}
+
+		if noteType == ntGNUPropertyType0 && string(data[offset+12:offset+12+nameSize]) == "GNU\x00" {
+			for property := desc; property+8 <= desc+descSize; {
+				propertyType := order.Uint32(data[property:])
+				size := int(order.Uint32(data[property+4:]))
+				if propertyType == gnuPropertyX86ISA1Needed || propertyType == gnuPropertyX86ISA1Used {
+					if size >= 4 && property+12 <= len(data) {
+						properties = append(properties, isaProperty{Type: propertyType, Value: order.Uint32(data[property+8:]), Offset: property + 8})
+					}
+				}
+				property += 8 + (size+align-1)&^(align-1)
+			}
+		}
+		offset = next
+	}
+
+	return properties
+}
+
+func noteAlignment(file *elf.File) int {
+	if file.Class == elf.ELFCLASS64 {
+		return 8
+	}
+
+	return 4
+}
+
+// readISANote reads GNU_PROPERTY_X86_ISA_1_NEEDED and GNU_PROPERTY_X86_ISA_1_USED.
+func readISANote(file *elf.File) (uint32, uint32, bool) {
+	section := file.Section(gnuPropertySection)
+	if section == nil {
+		return 0, 0, false
+	}
+
+	data, err := section.Data()
+	if err != nil {
+		return 0, 0, false
+	}
+
+	var needed, used uint32
+	found := false
+	for _, property := range readProperties(data, file.ByteOrder, noteAlignment(file)) {
+		if property.Type == gnuPropertyX86ISA1Needed {
+			needed |= property.Value
+		} else {
+			used |= property.Value
+		}
+		found = true
+	}
+
+	return needed, used, found
+}
+
+// isaNote builds an ELF64 NT_GNU_PROPERTY_TYPE_0 note with one GNU_PROPERTY_X86_ISA_1_NEEDED property.
+func isaNote(mask uint32) []byte {
+	note := make([]byte, 32)
+	binary.LittleEndian.PutUint32(note[0:], 4)
+	binary.LittleEndian.PutUint32(note[4:], 16)
+	binary.LittleEndian.PutUint32(note[8:], ntGNUPropertyType0)
+	copy(note[12:], "GNU\x00")
+	binary.LittleEndian.PutUint32(note[16:], gnuPropertyX86ISA1Needed)
+	binary.LittleEndian.PutUint32(note[20:], 4)
+	binary.LittleEndian.PutUint32(note[24:], mask)
+	return note
+}
+
+func align8(offset uint64) uint64 {
+	return (offset + 7) &^ 7
+}
+
+// addISANote returns a copy of an x86-64 ELF file that carries GNU_PROPERTY_X86_ISA_1_NEEDED.
+// An existing property is overwritten. Otherwise a .note.gnu.property section is added and the
+// section header table moves to the end of the file. When there is room behind the program
+// headers, as in binaries from the Go linker, the note is put there with a PT_GNU_PROPERTY
+// header so that the loader sees it. Otherwise only tools that read sections see it,
+// which the returned bool tells.
+func addISANote(data []byte, mask uint32) ([]byte, bool, error) {
+	file, err := elf.NewFile(bytes.NewReader(data))
+	if err != nil {
+		return nil, false, err
+	}
+	defer file.Close()
+
+	if file.Class != elf.ELFCLASS64 || file.Machine != elf.EM_X86_64 || file.Data != elf.ELFDATA2LSB {
+		return nil, false, errors.New("only x86-64 ELF files can be given an ISA note")
+	}
+
+	out := append([]byte(nil), data...)
+	if section := file.Section(gnuPropertySection); section != nil {
+		properties := readProperties(out[section.Offset:section.Offset+section.Size], file.ByteOrder, 8)
+		for _, property := range properties {
+			if property.Type == gnuPropertyX86ISA1Needed {
+				binary.LittleEndian.PutUint32(out[int(section.Offset)+property.Offset:], mask)
+				loaded := section.Flags&elf.SHF_ALLOC != 0
+				return out, loaded, nil
+			}
+		}
+
+		return nil, false, errors.New(gnuPropertySection + " has no GNU_PROPERTY_X86_ISA_1_NEEDED to update")
+	}
+
+	order := binary.LittleEndian
+	phoff := order.Uint64(out[0x20:])
+	shoff := order.Uint64(out[0x28:])
+	phentsize := uint64(order.Uint16(out[0x36:]))
+	phnum := uint64(order.Uint16(out[0x38:]))
+	shentsize := uint64(order.Uint16(out[0x3a:]))
+	shnum := uint64(order.Uint16(out[0x3c:]))
+	shstrndx := uint64(order.Uint16(out[0x3e:]))
+	if shstrndx == 0 || shstrndx >= shnum {
+		return nil, false, errors.New("no section name table")
+	}
+
+	note := isaNote(mask)
+
+	// Room behind the program headers for one more header and the note, up to the
+	// first section. The old section header table is free as it moves to the end.
+	phEnd := phoff + phnum*phentsize
+	noteOffset := align8(phEnd + phentsize)
+	noteEnd := noteOffset + uint64(len(note))
+	free := uint64(len(data))
+	for _, section := range file.Sections {
+		if section.Type != elf.SHT_NOBITS && section.Size > 0 && section.Offset >= phEnd && section.Offset < free {
+			free = section.Offset
+		}
+	}
+
+	var noteAddr uint64
+	loaded := false
+	for _, program := range file.Progs {
+		if program.Type == elf.PT_LOAD && program.Off <= phoff && noteEnd <= program.Off+program.Filesz && noteEnd <= free {
+			noteAddr = program.Vaddr + noteOffset - program.Off
+			loaded = true
+		}
+	}
+
+	if shoff+shnum*shentsize <= uint64(len(out)) {
+		for i := shoff; i < shoff+shnum*shentsize; i++ {
+			out[i] = 0
+		}
+	}
+
+	if loaded {
+		header := out[phEnd : phEnd+phentsize]
+		order.PutUint32(header[0:], ptGNUProperty)
+		order.PutUint32(header[4:], uint32(elf.PF_R))
+		order.PutUint64(header[8:], noteOffset)
+		order.PutUint64(header[16:], noteAddr)
+		order.PutUint64(header[24:], noteAddr)
+		order.PutUint64(header[32:], uint64(len(note)))
+		order.PutUint64(header[40:], uint64(len(note)))
+		order.PutUint64(header[48:], 8)
+		copy(out[noteOffset:], note)
+		order.PutUint16(out[0x38:], uint16(phnum+1))
+
+		for i := uint64(0); i < phnum; i++ {
+			header := out[phoff+i*phentsize:]
+			if elf.ProgType(order.Uint32(header)) == elf.PT_PHDR {
+				order.PutUint64(header[32:], (phnum+1)*phentsize)
+				order.PutUint64(header[40:], (phnum+1)*phentsize)
+			}
+		}
+	} else {
+		noteOffset = align8(uint64(len(out)))
+		out = append(out, make([]byte, noteOffset-uint64(len(out)))...)
+		out = append(out, note...)
+	}
+
+	// section names, with the new one at the end
+	names := file.Sections[shstrndx]
+	nameData, err := names.Data()
+	if err != nil {
+		return nil, false, err
+	}
+	nameOffset := uint64(len(out))
+	newName := uint64(len(nameData))
+	out = append(out, nameData...)
+	out = append(out, gnuPropertySection+"\x00"...)
+
+	// section headers, with the new one at the end
+	headerOffset := align8(uint64(len(out)))
+	out = append(out, make([]byte, headerOffset-uint64(len(out)))...)
+	out = append(out, data[shoff:shoff+shnum*shentsize]...)
+	header := make([]byte, shentsize)
+	order.PutUint32(header[0:], uint32(newName))
+	order.PutUint32(header[4:], uint32(elf.SHT_NOTE))
+	if loaded {
+		order.PutUint64(header[8:], uint64(elf.SHF_ALLOC))
+	}
+	order.PutUint64(header[16:], noteAddr)
+	order.PutUint64(header[24:], noteOffset)
+	order.PutUint64(header[32:], uint64(len(note)))
+	order.PutUint64(header[48:], 8)
+	out = append(out, header...)
+
+	namesHeader := out[headerOffset+shstrndx*shentsize:]
+	order.PutUint64(namesHeader[24:], nameOffset)
+	order.PutUint64(namesHeader[32:], uint64(len(nameData))+uint64(len(gnuPropertySection))+1)
+
+	order.PutUint64(out[0x28:], headerOffset)
+	order.PutUint16(out[0x3c:], uint16(shnum+1))
+	return out, loaded, nil
+}
+
+// goamd64Level is the GOAMD64 setting a Go executable was built with. Go records it in
+// the build info, the instructions found can be higher when the code checks CPUID first.
+func goamd64Level(input string) (AssemblyMode, error) {
+	info, err := buildinfo.ReadFile(input)
+	if err != nil {
+		return na, fmt.Errorf("no GOAMD64 build setting: %w", err)
+	}
+
+	setting := "v1"
+	for _, s := range info.Settings {
+		if s.Key == "GOAMD64" {
+			setting = s.Value
+		}
+	}
+
+	for i, level := range amd64Arch.Levels[:v4] {
+		if level == setting {
+			return AssemblyMode(i + 1), nil
+		}
+	}
+
+	return na, fmt.Errorf("unknown build setting GOAMD64=%s", setting)
+}
+
+// writeISANote writes a copy of the Go executable input to output with the ISA level set to the
+// GOAMD64 setting it was built with, rather than to detected, the highest level of its instructions.
+func writeISANote(input string, output string, detected AssemblyMode) error {
+	level, err := goamd64Level(input)
+	if err != nil {
+		return err
+	}
+
+	if detected > level {
+		fmt.Fprintf(os.Stderr, "Instructions up to %s are taken to be behind CPUID checks, the note says GOAMD64=%s\n", detected, level)
+	}
+
+	data, err := os.ReadFile(input)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(input)
+	if err != nil {
+		return err
+	}
+
+	out, loaded, err := addISANote(data, 1<<(level-1))
+	if err != nil {
+		return err
+	}
+
+	if !loaded {
+		fmt.Fprintln(os.Stderr, "No room for a PT_GNU_PROPERTY program header, the note is only visible as a section")
+	}
+
+	return os.WriteFile(output, out, info.Mode().Perm())
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"debug/elf"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// buildExecutable builds a small Go program for linux/amd64 with GOAMD64=v2, or as env says otherwise.
+func buildExecutable(t *testing.T, env ...string) string {
+	if testing.Short() {
+		t.Skip("builds a Go program")
+	}
+
+	dir := t.TempDir()
+	source := filepath.Join(dir, "main.go")
+	if err := os.WriteFile(source, []byte("package main\n\nfunc main() {}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	output := filepath.Join(dir, "hello")
+	command := exec.Command("go", "build", "-o", output, source)
+	command.Dir = dir
+	command.Env = append(os.Environ(), "GOOS=linux", "GOARCH=amd64", "GOAMD64=v2", "CGO_ENABLED=0", "GOFLAGS=")
+	command.Env = append(command.Env, env...)
+	if out, err := command.CombinedOutput(); err != nil {
+		t.Fatalf("go build: %v\n%s", err, out)
+	}
+
+	return output
+}
+
+func TestAddISANote(t *testing.T) {
+	data, err := os.ReadFile(buildExecutable(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		mask  uint32
+		level AssemblyMode
+	}{
+		{0x1, v1},
+		{0x2, v2},
+		{0x4, v3},
+		{0x8, v4},
+		{0x3, v2},
+	}
+
+	for _, test := range tests {
+		out, loaded, err := addISANote(data, test.mask)
+		if err != nil {
+			t.Fatalf("addISANote(%#x): %v", test.mask, err)
+		}
+		if !loaded {
+			t.Errorf("addISANote(%#x) found no room for PT_GNU_PROPERTY in a Go binary", test.mask)
+		}
+
+		file, err := elf.NewFile(bytes.NewReader(out))
+		if err != nil {
+			t.Fatalf("addISANote(%#x) wrote no ELF file: %v", test.mask, err)
+		}
+
+		needed, used, ok := readISANote(file)
+		if !ok || needed != test.mask || used != 0 || isaLevel(needed) != test.level {
+			t.Errorf("addISANote(%#x) reads back as %#x, %#x, %v, level %s", test.mask, needed, used, ok, isaLevel(needed))
+		}
+
+		found := false
+		for _, prog := range file.Progs {
+			found = found || prog.Type == ptGNUProperty
+		}
+		if !found {
+			t.Errorf("addISANote(%#x) wrote no PT_GNU_PROPERTY program header", test.mask)
+		}
+
+		// an existing property is overwritten
+		again, _, err := addISANote(out, 0x1)
+		if err != nil {
+			t.Fatalf("addISANote(%#x) twice: %v", test.mask, err)
+		}
+		file, err = elf.NewFile(bytes.NewReader(again))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if needed, _, _ := readISANote(file); needed != 0x1 {
+			t.Errorf("addISANote(%#x) overwritten with 0x1 reads back as %#x", test.mask, needed)
+		}
+	}
+
+	if _, _, err := addISANote([]byte("not an ELF file"), 0x1); err == nil {
+		t.Error("addISANote accepted a file that is not ELF")
+	}
+}
+
+func TestWriteISANote(t *testing.T) {
+	input := buildExecutable(t)
+	output := filepath.Join(t.TempDir(), "noted")
+
+	// instructions above the GOAMD64 setting don't go into the note
+	if err := writeISANote(input, output, v4); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := elf.Open(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	needed, _, ok := readISANote(file)
+	if !ok || isaLevel(needed) != v2 {
+		t.Errorf("writeISANote wrote %#x, %v, want the GOAMD64=v2 setting", needed, ok)
+	}
+
+	if err := writeISANote("gnuproperty.go", filepath.Join(t.TempDir(), "noted"), v1); err == nil {
+		t.Error("writeISANote wrote a note for a file that is not a Go executable")
+	}
+}
+
+func TestISAMismatch(t *testing.T) {
+	tests := []struct {
+		needed uint32
+		used   uint32
+		mode   AssemblyMode
+		want   string
+	}{
+		{0x4, 0, v3, ""},
+		{0x2, 0x4, v3, "Mismatch: the ISA note needs v2 but the instructions need v3"},
+		{0x8, 0, v3, "Mismatch: the ISA note needs v4 but the instructions only need v3"},
+		// a note with only GNU_PROPERTY_X86_ISA_1_USED needs no level
+		{0, 0x4, v3, ""},
+		{0, 0, v1, ""},
+	}
+
+	for _, test := range tests {
+		analysis := NewAnalysis(false)
+		analysis.Mode = test.mode
+		analysis.ISANote = true
+		analysis.ISANeeded, analysis.ISAUsed = test.needed, test.used
+		if got := analysis.isaMismatch(); got != test.want {
+			t.Errorf("isaMismatch with needed %#x, used %#x and %s = %q, want %q", test.needed, test.used, test.mode, got, test.want)
+		}
+	}
+}
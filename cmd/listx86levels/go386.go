@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// The GO386 settings for GOARCH=386. softfloat runs on a Pentium MMX, sse2 needs a Pentium 4.
+const (
+	softfloat = 1
+	sse2      = 2
+)
+
+var go386Names = map[int]string{
+	softfloat: "softfloat",
+	sse2:      "sse2",
+}
+
+// The GO386 setting that brings each CPUID feature. Features missing here are more than
+// any GO386 setting gives, Go code only uses them behind CPUID checks. SSE and SSE2 need
+// sse2 only for floating point, as the runtime moves memory with them on any setting.
+var go386Features = map[string]int{
+	"":     softfloat,
+	"CX8":  softfloat,
+	"FPU":  softfloat,
+	"MMX":  softfloat,
+	"CMOV": sse2,
+	"FXSR": sse2,
+	"SSE":  sse2,
+	"SSE2": sse2,
+}
+
+// GNU objdump names 32-bit x86 input in its header, as in "file format elf32-i386".
+var gnuFileFormat32 = regexp.MustCompile(`file format (?:elf32-i386|pei-i386|mach-o-i386)`)
+
+// go tool objdump names no file format. GOARCH=386 code is told by its assembly files, such as
+// asm_386.s, and by g loaded through GS, where amd64 code has R14 and 64-bit operations.
+var (
+	goObjdump386 = regexp.MustCompile(`(?m)^TEXT \S+\(SB\) \S+_386\.s$|\bGS:`)
+	goObjdump64  = regexp.MustCompile(`\bR(?:8|9|1[0-5])[BWL]?\b|\b(?:ADD|SUB|CMP|LEA)Q\b`)
+)
+
+// isGo386Objdump tells go tool objdump output of GOARCH=386 code from amd64 code.
+func isGo386Objdump(head []byte) bool {
+	return goObjdump386.Match(head) && !goObjdump64.Match(head)
+}
+
+// sseFloat tells the SSE and SSE2 instructions that do floating point, such as ADDSD,
+// CVTSI2SD or XORPS, from those that move memory or integers, such as MOVDQU or PXOR.
+func sseFloat(instruction string, features []string) bool {
+	sse := false
+	for _, feature := range features {
+		sse = sse || feature == "SSE" || feature == "SSE2"
+	}
+	if !sse {
+		return false
+	}
+
+	instruction = strings.TrimSuffix(instruction, "_XMM")
+	if strings.HasPrefix(instruction, "CVT") {
+		return true
+	}
+
+	for _, suffix := range []string{"SS", "SD", "PS", "PD"} {
+		if strings.HasSuffix(instruction, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// go386Setting is the lowest GO386 setting that has every feature counted.
+func (analysis *Analysis) go386Setting() int {
+	setting := softfloat
+	if analysis.SSEFloat > 0 {
+		setting = sse2
+	}
+
+	for feature := range analysis.Features {
+		if feature != "SSE" && feature != "SSE2" && go386Features[feature] > setting {
+			setting = go386Features[feature]
+		}
+	}
+
+	return setting
+}
+
+// beyondGO386 lists the features counted that no GO386 setting gives.
+func (analysis *Analysis) beyondGO386() []string {
+	var features []string
+	for feature := range analysis.Features {
+		if _, ok := go386Features[feature]; !ok {
+			features = append(features, feature)
+		}
+	}
+
+	sort.Strings(features)
+	return features
+}
+
+// PrintGO386 reports the GO386 setting a 32-bit input needs, how much floating point
+// it does on the x87 and how much with SSE, and the features beyond GO386=sse2.
+func (analysis *Analysis) PrintGO386(printStatistics bool) {
+	x87 := analysis.Features["FPU"]
+	sse := analysis.Features["SSE"] + analysis.Features["SSE2"]
+	beyond := analysis.beyondGO386()
+
+	if printStatistics {
+		fmt.Println("GO386")
+		fmt.Println("     x87", x87)
+		fmt.Println("     SSE floating point", analysis.SSEFloat)
+		fmt.Println("     SSE other", sse-analysis.SSEFloat)
+		for _, feature := range beyond {
+			fmt.Println("    ", feature, analysis.Features[feature])
+		}
+		fmt.Println()
+	}
+
+	if analysis.Verbose {
+		fmt.Printf("Floating point: %d x87 instructions, %d SSE and SSE2 instructions\n", x87, analysis.SSEFloat)
+		fmt.Printf("Other SSE and SSE2 instructions, which the runtime uses on any GO386 setting: %d\n", sse-analysis.SSEFloat)
+	}
+
+	if len(beyond) > 0 {
+		if analysis.Verbose {
+			fmt.Println("More than GO386=sse2, the code must check CPUID for them:", strings.Join(beyond, " "))
+		} else {
+			fmt.Println("Beyond GO386:", strings.Join(beyond, " "))
+		}
+	}
+
+	if analysis.Verbose {
+		fmt.Printf("Minimum required GO386=%s\n", go386Names[analysis.go386Setting()])
+	} else {
+		fmt.Printf("sGO386=%s\n", go386Names[analysis.go386Setting()])
+	}
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Go object files and archives, as written by the compiler and assembler into the build cache.
+// The object layout follows cmd/internal/goobj, which is not importable.
+
+const archiveMagic = "!<arch>\n"
+const goObjectHeader = "go object "
+const goObjectMagic = "\x00go120ld"
+
+const (
+	goObjectBlockSymdef    = 3
+	goObjectBlockDataIdx   = 13
+	goObjectBlockData      = 16
+	goObjectBlockCount     = 19
+	goObjectSymSize        = 8 + 2 + 1 + 1 + 1 + 4 + 4
+	goObjectSymbolText     = 1 // objabi.STEXT
+	goObjectSymbolFIPS     = 2 // objabi.STEXTFIPS since go1.24
+	goObjectFirstFIPSMinor = 24
+	goObjectStaticABI      = 0xFFFF // obj.SymABIstatic, file-local symbols such as cmpbody<>
+)
+
+// The compiler names the package of its object in a symbol, go:cuinfo.packagename.internal/bytealg.
+const goObjectPackageSymbol = "go:cuinfo.packagename."
+
+var goObjectVersion = regexp.MustCompile(`\bgo1\.(\d+)`)
+
+// analyzeArchive decodes every object in a Unix ar archive, which is how
+// Go packages are stored: __.PKGDEF, _go_.o and one object per assembly file.
+func analyzeArchive(r io.ReaderAt, size int64, analysis *Analysis) error {
+	const headerSize = 60
+	offset := int64(len(archiveMagic))
+	header := make([]byte, headerSize)
+	// _go_.o from the compiler comes before the objects of the assembler and names the package
+	pkg := ""
+	for offset+headerSize <= size {
+		if _, err := r.ReadAt(header, offset); err != nil {
+			return err
+		}
+
+		if string(header[58:60]) != "`\n" {
+			return fmt.Errorf("bad archive member header at offset %d", offset)
+		}
+
+		name := strings.TrimSpace(string(header[0:16]))
+		memberSize, err := strconv.ParseInt(strings.TrimSpace(string(header[48:58])), 10, 64)
+		if err != nil {
+			return fmt.Errorf("bad archive member size for %s: %w", name, err)
+		}
+
+		offset += headerSize
+		if name != "__.PKGDEF" {
+			member := io.NewSectionReader(r, offset, memberSize)
+			if err := analyzeArchiveMember(member, &pkg, analysis); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+
+		// members are padded to an even offset
+		offset += memberSize + memberSize%2
+	}
+
+	return nil
+}
+
+func analyzeArchiveMember(member *io.SectionReader, pkg *string, analysis *Analysis) error {
+	magic := make([]byte, len(goObjectHeader))
+	if _, err := member.ReadAt(magic, 0); err != nil {
+		return nil
+	}
+
+	switch {
+	case string(magic) == goObjectHeader:
+		return analyzeGoObject(member, pkg, analysis)
+	case bytes.HasPrefix(magic, []byte("\x7fELF")):
+		// cgo objects
+		return analyzeELF(member, analysis)
+	}
+
+	return nil
+}
+
+// analyzeGoObject decodes the text symbols of one Go object file. The function context
+// is the symbol name, which starts with the package path. File-local symbols of assembly,
+// such as cmpbody<>, have no package in their name and get *pkg, the package of the
+// archive, which is set from the compiler's object.
+func analyzeGoObject(r io.Reader, pkg *string, analysis *Analysis) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	start := bytes.Index(data, []byte(goObjectMagic))
+	if start < 0 {
+		return errors.New("unsupported Go object format, expected " + strings.TrimPrefix(goObjectMagic, "\x00"))
+	}
+
+	fields := strings.Fields(string(data[:bytes.IndexByte(data, '\n')+1]))
+	bits := 64
+	if len(fields) > 3 {
+		switch fields[3] {
+		case "amd64":
+			bits = 64
+		case "386":
+			bits = 32
+		case "arm64":
+			analysis.SetArch(arm64Arch)
+		default:
+			return fmt.Errorf("unsupported Go object architecture %s", fields[3])
+		}
+	}
+
+	fips := false
+	if match := goObjectVersion.FindSubmatch(data[:start]); match != nil {
+		if minor, err := strconv.Atoi(string(match[1])); err == nil {
+			fips = minor >= goObjectFirstFIPSMinor
+		}
+	}
+
+	object := data[start:]
+	headerSize := len(goObjectMagic) + 8 + 4 + 4*goObjectBlockCount
+	if len(object) < headerSize {
+		return errors.New("truncated Go object header")
+	}
+
+	offsets := make([]uint32, goObjectBlockCount)
+	for i := range offsets {
+		offsets[i] = binary.LittleEndian.Uint32(object[len(goObjectMagic)+8+4+4*i:])
+		if int(offsets[i]) > len(object) {
+			return errors.New("truncated Go object")
+		}
+	}
+
+	// Package, hashed and non-package definitions follow each other and share the data index.
+	symbols := int(offsets[goObjectBlockSymdef+4]-offsets[goObjectBlockSymdef]) / goObjectSymSize
+	names := make([]string, symbols)
+	for i := range names {
+		sym := object[offsets[goObjectBlockSymdef]+uint32(i*goObjectSymSize):]
+		nameLength := binary.LittleEndian.Uint32(sym[0:])
+		nameOffset := binary.LittleEndian.Uint32(sym[4:])
+		if int(nameOffset+nameLength) > len(object) {
+			return errors.New("truncated Go object symbol name")
+		}
+		names[i] = string(object[nameOffset : nameOffset+nameLength])
+
+		if path := strings.TrimPrefix(names[i], goObjectPackageSymbol); path != names[i] {
+			*pkg = path
+		}
+	}
+
+	for i, name := range names {
+		sym := object[offsets[goObjectBlockSymdef]+uint32(i*goObjectSymSize):]
+		kind := sym[10]
+		if kind != goObjectSymbolText && !(fips && kind == goObjectSymbolFIPS) {
+			continue
+		}
+
+		if binary.LittleEndian.Uint16(sym[8:]) == goObjectStaticABI && *pkg != "" {
+			name = *pkg + "." + name + "<>"
+		}
+
+		index := offsets[goObjectBlockDataIdx] + uint32(i*4)
+		dataStart := offsets[goObjectBlockData] + binary.LittleEndian.Uint32(object[index:])
+		dataEnd := offsets[goObjectBlockData] + binary.LittleEndian.Uint32(object[index+4:])
+		if dataEnd < dataStart || int(dataEnd) > len(object) {
+			return errors.New("truncated Go object symbol data")
+		}
+
+		analysis.Arch.Decode(object[dataStart:dataEnd], 0, []symbol{{Name: name, Addr: 0}}, bits, analysis)
+	}
+
+	return nil
+}
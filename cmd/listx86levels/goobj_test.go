@@ -0,0 +1,42 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestAnalyzeArchive(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a Go package")
+	}
+
+	command := exec.Command("go", "list", "-export", "-f", "{{.Export}}", "internal/bytealg")
+	command.Env = append(os.Environ(), "GOOS=linux", "GOARCH=amd64", "GOAMD64=v1", "GOFLAGS=")
+	out, err := command.Output()
+	if err != nil {
+		t.Skipf("go list -export: %v", err)
+	}
+
+	file, err := os.Open(strings.TrimSpace(string(out)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	analysis := NewAnalysis(false)
+	if err := analyzeBinary(file, analysis); err != nil {
+		t.Fatal(err)
+	}
+
+	// the compiler's object names the package of the file-local functions of the assembler
+	if _, ok := analysis.Functions["internal/bytealg.cmpbody<>"]; !ok {
+		t.Errorf("no function internal/bytealg.cmpbody<> in %v", analysis.Functions)
+	}
+	for function := range analysis.Functions {
+		if !strings.Contains(function, ".") {
+			t.Errorf("function %s is not named after its package", function)
+		}
+	}
+}
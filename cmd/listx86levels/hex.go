@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// 0x and \x in front of a byte, as in C arrays and escaped strings
+var hexBytePrefix = regexp.MustCompile(`0[xX]|\\x`)
+
+var hexDigits = regexp.MustCompile(`[0-9a-fA-F]+`)
+
+// parseHex reads hex text such as "48 89 e5", "4889e5" or "0x48, 0x89, 0xe5".
+func parseHex(text string) ([]byte, error) {
+	text = hexBytePrefix.ReplaceAllString(text, " ")
+	var code []byte
+	for _, digits := range hexDigits.FindAllString(text, -1) {
+		if len(digits)%2 != 0 {
+			return nil, fmt.Errorf("odd number of hex digits in %s", digits)
+		}
+
+		b, err := hex.DecodeString(digits)
+		if err != nil {
+			return nil, err
+		}
+		code = append(code, b...)
+	}
+
+	return code, nil
+}
+
+// analyzeBytes decodes a code region with no file format around it, for example
+// from a core file or a crash log, as if it was loaded at base.
+func analyzeBytes(input io.Reader, isHex bool, base uint64, bits int, analysis *Analysis) error {
+	if analysis.Arch == amd64Arch && bits != 64 && bits != 32 {
+		return fmt.Errorf("unsupported bits %d, use 64 or 32", bits)
+	}
+
+	code, err := io.ReadAll(input)
+	if err != nil {
+		return err
+	}
+
+	if isHex {
+		if code, err = parseHex(strings.TrimSpace(string(code))); err != nil {
+			return err
+		}
+	}
+
+	analysis.Arch.Decode(code, base, nil, bits, analysis)
+	return nil
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"debug/elf"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// The subdirectories glibc 2.33 and later search for optimized libraries, before the library directory itself.
+var hwcapsDirectories = map[AssemblyMode]string{
+	v2: "glibc-hwcaps/x86-64-v2",
+	v3: "glibc-hwcaps/x86-64-v3",
+	v4: "glibc-hwcaps/x86-64-v4",
+}
+
+// hwcapsBuild is one build of a shared object and the level it was built for.
+type hwcapsBuild struct {
+	Level AssemblyMode
+	Path  string
+}
+
+// parseHwcapsBuild reads v3=path or x86-64-v3=path. Baseline builds are v1, x86-64 or x86-64-baseline.
+func parseHwcapsBuild(argument string) (hwcapsBuild, error) {
+	level, path, ok := strings.Cut(argument, "=")
+	if !ok || path == "" {
+		return hwcapsBuild{}, fmt.Errorf("expected level=path, got %s", argument)
+	}
+
+	switch strings.TrimPrefix(level, "x86-64-") {
+	case "v1", "x86-64", "baseline":
+		return hwcapsBuild{Level: v1, Path: path}, nil
+	case "v2":
+		return hwcapsBuild{Level: v2, Path: path}, nil
+	case "v3":
+		return hwcapsBuild{Level: v3, Path: path}, nil
+	case "v4":
+		return hwcapsBuild{Level: v4, Path: path}, nil
+	}
+
+	return hwcapsBuild{}, fmt.Errorf("unknown level %s, expected v1 to v4", level)
+}
+
+// sharedObjectName is the DT_SONAME of a shared object, or its file name when it has none.
+func sharedObjectName(path string) (string, error) {
+	object, err := elf.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer object.Close()
+
+	if object.Type != elf.ET_DYN || object.Machine != elf.EM_X86_64 {
+		return "", fmt.Errorf("%s is not an x86-64 shared object", path)
+	}
+
+	if soname, _ := object.DynString(elf.DT_SONAME); len(soname) > 0 {
+		return soname[0], nil
+	}
+
+	return filepath.Base(path), nil
+}
+
+func copyFile(source string, destination string) error {
+	data, err := os.ReadFile(source)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(source)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(destination), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(destination, data, info.Mode().Perm())
+}
+
+// buildLevel is the level a build needs: the GOAMD64 setting of a Go build, whose runtime has
+// code up to v4 behind CPUID checks, and the level of the code outside IFUNC variants otherwise.
+func buildLevel(path string, analysis *Analysis) AssemblyMode {
+	if level, err := goamd64Level(path); err == nil {
+		return level
+	}
+
+	return requiredLevel(analysis)
+}
+
+// layoutHwcaps checks that no build needs a higher level than the one it is built for and
+// copies the builds into libraryDirectory and its glibc-hwcaps subdirectories. Nothing
+// is copied when any build fails the check.
+func layoutHwcaps(libraryDirectory string, arguments []string, options Options, verbose bool) error {
+	var builds []hwcapsBuild
+	for _, argument := range arguments {
+		build, err := parseHwcapsBuild(argument)
+		if err != nil {
+			return err
+		}
+		builds = append(builds, build)
+	}
+
+	if len(builds) == 0 {
+		return fmt.Errorf("no builds given, expected level=path arguments")
+	}
+
+	sort.SliceStable(builds, func(i, j int) bool { return builds[i].Level < builds[j].Level })
+
+	var name string
+	var results []Result
+	failed := false
+	for i, build := range builds {
+		if i > 0 && build.Level == builds[i-1].Level {
+			return fmt.Errorf("more than one build for %s", build.Level)
+		}
+
+		buildName, err := sharedObjectName(build.Path)
+		if err != nil {
+			return err
+		}
+
+		if name == "" {
+			name = buildName
+		} else if buildName != name {
+			return fmt.Errorf("%s is %s, not %s like the other builds", build.Path, buildName, name)
+		}
+
+		result := analyzeFile(build.Path, options, verbose)
+		results = append(results, result)
+		if result.Err == nil && result.Analysis.Arch != amd64Arch {
+			result.Err = fmt.Errorf("is %s, glibc-hwcaps levels are x86-64", result.Analysis.Arch.Name)
+			results[len(results)-1] = result
+		} else if result.Err == nil {
+			if level := buildLevel(build.Path, result.Analysis); level > build.Level {
+				result.Err = fmt.Errorf("needs %s, too high for %s", level, build.Level)
+				results[len(results)-1] = result
+			}
+		}
+		if result.Err != nil {
+			failed = true
+		}
+	}
+
+	printSummary(results)
+	if failed {
+		return fmt.Errorf("not laying out %s", libraryDirectory)
+	}
+
+	fmt.Println()
+	if builds[0].Level != v1 {
+		fmt.Fprintln(os.Stderr, "No baseline build, loading fails on CPUs below", hwcapsDirectories[builds[0].Level])
+	}
+
+	for _, build := range builds {
+		destination := filepath.Join(libraryDirectory, name)
+		if build.Level != v1 {
+			destination = filepath.Join(libraryDirectory, hwcapsDirectories[build.Level], name)
+		}
+
+		if err := copyFile(build.Path, destination); err != nil {
+			return err
+		}
+		fmt.Println(destination)
+	}
+
+	return nil
+}
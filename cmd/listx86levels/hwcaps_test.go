@@ -0,0 +1,74 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// buildSharedObject builds a Go library with -buildmode=c-shared at a GOAMD64 level into dir/libhello.so.
+func buildSharedObject(t *testing.T, source string, level string) string {
+	output := filepath.Join(t.TempDir(), "libhello.so")
+	command := exec.Command("go", "build", "-buildmode=c-shared", "-o", output, source)
+	command.Env = append(os.Environ(), "GOOS=linux", "GOARCH=amd64", "GOAMD64="+level, "CGO_ENABLED=1", "GOFLAGS=")
+	if out, err := command.CombinedOutput(); err != nil {
+		t.Skipf("go build -buildmode=c-shared: %v\n%s", err, out)
+	}
+
+	return output
+}
+
+func TestLayoutHwcaps(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds Go libraries")
+	}
+	if _, err := exec.LookPath("gcc"); err != nil {
+		t.Skip("c-shared builds need a C compiler")
+	}
+
+	source := filepath.Join(t.TempDir(), "hello.go")
+	code := "package main\n\nimport \"C\"\n\n//export Hello\nfunc Hello() C.int { return 1 }\n\nfunc main() {}\n"
+	if err := os.WriteFile(source, []byte(code), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	v1Build := buildSharedObject(t, source, "v1")
+	v3Build := buildSharedObject(t, source, "v3")
+	options := Options{Format: "auto", Arch: "auto"}
+
+	tests := []struct {
+		arguments []string
+		files     []string
+		ok        bool
+	}{
+		{[]string{"v1=" + v1Build, "v3=" + v3Build}, []string{"libhello.so", "glibc-hwcaps/x86-64-v3/libhello.so"}, true},
+		{[]string{"x86-64-v2=" + v1Build}, []string{"glibc-hwcaps/x86-64-v2/libhello.so"}, true},
+		// the v3 build is refused for v1 and v2 by its GOAMD64 setting
+		{[]string{"v1=" + v3Build}, nil, false},
+		{[]string{"v1=" + v1Build, "v2=" + v3Build}, nil, false},
+		{[]string{"v1=" + v1Build, "v1=" + v3Build}, nil, false},
+		{[]string{"v5=" + v1Build}, nil, false},
+		{nil, nil, false},
+	}
+
+	for _, test := range tests {
+		directory := t.TempDir()
+		err := layoutHwcaps(directory, test.arguments, options, false)
+		if (err == nil) != test.ok {
+			t.Errorf("layoutHwcaps(%v) = %v, want ok %v", test.arguments, err, test.ok)
+			continue
+		}
+
+		for _, file := range test.files {
+			if _, err := os.Stat(filepath.Join(directory, file)); err != nil {
+				t.Errorf("layoutHwcaps(%v) didn't write %s: %v", test.arguments, file, err)
+			}
+		}
+		if !test.ok {
+			if entries, _ := os.ReadDir(directory); len(entries) > 0 {
+				t.Errorf("layoutHwcaps(%v) wrote files although it failed", test.arguments)
+			}
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+
+	"golang.org/x/arch/x86/x86asm"
+)
+
+// The most instructions decoded from one IFUNC resolver.
+const maxResolverLength = 256
+
+// ifuncResolvers maps the functions that pick IFUNC variants to their names: the values of
+// STT_GNU_IFUNC symbols and the addends of IRELATIVE relocations, which static executables
+// and local IFUNC symbols have. Resolvers without a symbol are named after their address.
+func ifuncResolvers(file *elf.File, symbols []elf.Symbol) map[uint64]string {
+	resolvers := make(map[uint64]string)
+	for _, s := range symbols {
+		if elf.ST_TYPE(s.Info) == elf.STT_GNU_IFUNC && s.Value != 0 {
+			resolvers[s.Value] = s.Name
+		}
+	}
+
+	var irelative uint32
+	switch file.Machine {
+	case elf.EM_X86_64:
+		irelative = uint32(elf.R_X86_64_IRELATIVE)
+	case elf.EM_AARCH64:
+		irelative = uint32(elf.R_AARCH64_IRELATIVE)
+	default:
+		return resolvers
+	}
+
+	for _, section := range file.Sections {
+		if section.Type != elf.SHT_RELA || file.Class != elf.ELFCLASS64 {
+			continue
+		}
+
+		data, err := section.Data()
+		if err != nil {
+			continue
+		}
+
+		// Elf64_Rela is the offset, the info and the addend
+		for i := 0; i+24 <= len(data); i += 24 {
+			addend := file.ByteOrder.Uint64(data[i+16:])
+			if elf.R_TYPE64(file.ByteOrder.Uint64(data[i+8:])) != irelative {
+				continue
+			}
+			if _, ok := resolvers[addend]; !ok {
+				resolvers[addend] = fmt.Sprintf("IFUNC resolver %#x", addend)
+			}
+		}
+	}
+
+	return resolvers
+}
+
+// ifuncVariants decodes the resolver at addr and returns the addresses of code it loads,
+// which is how glibc's resolvers return the variant for the CPU: with LEA on x86-64 and
+// with ADRP and ADD on arm64. Decoding ends at the return behind the last forward branch.
+func ifuncVariants(machine elf.Machine, code []byte, base uint64, addr uint64) []uint64 {
+	if addr < base || addr >= base+uint64(len(code)) {
+		return nil
+	}
+
+	switch machine {
+	case elf.EM_X86_64:
+		return x86Variants(code, base, addr)
+	case elf.EM_AARCH64:
+		return arm64Variants(code, base, addr)
+	}
+
+	return nil
+}
+
+func x86Variants(code []byte, base uint64, addr uint64) []uint64 {
+	var variants []uint64
+	end := addr
+	pc := int(addr - base)
+	for n := 0; n < maxResolverLength && pc < len(code); n++ {
+		inst, err := x86asm.Decode(code[pc:], 64)
+		if err != nil {
+			break
+		}
+		pc += inst.Len
+		next := base + uint64(pc)
+
+		if mem, ok := inst.Args[1].(x86asm.Mem); ok && inst.Op == x86asm.LEA && mem.Base == x86asm.RIP {
+			// x86asm leaves the 32-bit displacement without sign extension
+			variants = append(variants, uint64(int64(next)+int64(int32(mem.Disp))))
+		}
+		if rel, ok := inst.Args[0].(x86asm.Rel); ok && inst.Op != x86asm.CALL {
+			if target := uint64(int64(next) + int64(rel)); target > end {
+				end = target
+			}
+		}
+		if (inst.Op == x86asm.RET || inst.Op == x86asm.JMP) && next > end {
+			break
+		}
+	}
+
+	return variants
+}
+
+// signExtend reads the bits-wide two's complement number in the low bits of value.
+func signExtend(value uint32, bits int) int64 {
+	return int64(value<<(32-bits)) << 32 >> (64 - bits)
+}
+
+func arm64Variants(code []byte, base uint64, addr uint64) []uint64 {
+	var variants []uint64
+	pages := make(map[uint32]uint64)
+	end := addr
+	for n := 0; n < maxResolverLength && addr+4 <= base+uint64(len(code)); n++ {
+		word := binary.LittleEndian.Uint32(code[addr-base:])
+		branch := int64(0)
+		switch {
+		// ADRP Xd, page
+		case word&0x9F000000 == 0x90000000:
+			offset := signExtend(word>>5&0x7FFFF<<2|word>>29&3, 21) << 12
+			pages[word&31] = uint64(int64(addr&^0xFFF) + offset)
+		// ADD Xd, Xn, #imm
+		case word&0xFF800000 == 0x91000000:
+			if page, ok := pages[word>>5&31]; ok {
+				imm := uint64(word >> 10 & 0xFFF)
+				if word>>22&1 == 1 {
+					imm <<= 12
+				}
+				variants = append(variants, page+imm)
+			}
+		// B, B.cond, CBZ and CBNZ, TBZ and TBNZ
+		case word&0xFC000000 == 0x14000000:
+			branch = signExtend(word&0x3FFFFFF, 26) * 4
+		case word&0xFF000010 == 0x54000000, word&0x7E000000 == 0x34000000:
+			branch = signExtend(word>>5&0x7FFFF, 19) * 4
+		case word&0x7E000000 == 0x36000000:
+			branch = signExtend(word>>5&0x3FFF, 14) * 4
+		}
+
+		if target := uint64(int64(addr) + branch); branch > 0 && target > end {
+			end = target
+		}
+		addr += 4
+		if (word == 0xD65F03C0 || word&0xFC000000 == 0x14000000) && addr > end {
+			break
+		}
+	}
+
+	return variants
+}
+
+// ifuncSymbols adds the IFUNC resolvers and variants in the section at base to symbols, named
+// after their address where they have no symbol, and records the variants in analysis.Dispatched.
+func ifuncSymbols(symbols []symbol, resolvers map[uint64]string, variants map[uint64]bool, base uint64, size uint64, analysis *Analysis) []symbol {
+	named := make(map[uint64]bool)
+	for _, s := range symbols {
+		named[s.Addr] = true
+		if variants[s.Addr] {
+			analysis.Dispatched[s.Name] = true
+		}
+	}
+
+	for addr, name := range resolvers {
+		if addr >= base && addr < base+size && !named[addr] {
+			symbols = append(symbols, symbol{Name: name, Addr: addr})
+		}
+	}
+	for addr := range variants {
+		if addr >= base && addr < base+size && !named[addr] {
+			name := fmt.Sprintf("IFUNC variant %#x", addr)
+			symbols = append(symbols, symbol{Name: name, Addr: addr})
+			analysis.Dispatched[name] = true
+		}
+	}
+
+	sortSymbols(symbols)
+	return symbols
+}
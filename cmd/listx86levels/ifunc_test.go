@@ -0,0 +1,105 @@
+package main
+
+import (
+	"debug/elf"
+	"encoding/binary"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestIFUNCVariants(t *testing.T) {
+	x86 := []byte{
+		0x85, 0xFF, // TEST EDI, EDI
+		0x74, 0x08, // JE 0x100c
+		0x48, 0x8D, 0x05, 0x00, 0x01, 0x00, 0x00, // LEA RAX, [RIP+0x100]
+		0xC3,                                     // RET, with the branch target behind it
+		0x48, 0x8D, 0x05, 0x00, 0x02, 0x00, 0x00, // LEA RAX, [RIP+0x200]
+		0xC3,                                     // RET
+		0x48, 0x8D, 0x05, 0x00, 0x03, 0x00, 0x00, // LEA RAX, [RIP+0x300] of the next function
+	}
+	backward := []byte{0x48, 0x8D, 0x05, 0xF9, 0xFF, 0xFF, 0xFF, 0xC3} // LEA RAX, [RIP-7], RET
+
+	var arm64 []byte
+	for _, word := range []uint32{
+		0xB0000000, // ADRP X0, 0x2000
+		0xB4000061, // CBZ X1, 0x1010
+		0x91048000, // ADD X0, X0, #0x120
+		0xD65F03C0, // RET, with the branch target behind it
+		0x91004000, // ADD X0, X0, #0x10
+		0xD65F03C0, // RET
+		0x9100C000, // ADD X0, X0, #0x30 of the next function
+	} {
+		arm64 = binary.LittleEndian.AppendUint32(arm64, word)
+	}
+
+	tests := []struct {
+		machine elf.Machine
+		code    []byte
+		addr    uint64
+		want    []uint64
+	}{
+		{elf.EM_X86_64, x86, 0x1000, []uint64{0x110b, 0x1213}},
+		{elf.EM_AARCH64, arm64, 0x1000, []uint64{0x2120, 0x2010}},
+		{elf.EM_X86_64, backward, 0x1000, []uint64{0x1000}},
+		{elf.EM_X86_64, x86, 0x2000, nil},
+		{elf.EM_386, x86, 0x1000, nil},
+	}
+
+	for _, test := range tests {
+		if got := ifuncVariants(test.machine, test.code, 0x1000, test.addr); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ifuncVariants(%s, %#x) = %#x, want %#x", test.machine, test.addr, got, test.want)
+		}
+	}
+}
+
+func TestRequiredLevel(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds C libraries")
+	}
+	if _, err := exec.LookPath("gcc"); err != nil {
+		t.Skip("needs a C compiler")
+	}
+
+	source := `#include <stddef.h>
+__attribute__((target("avx512f"))) static void add_avx512(int *a, const int *b, size_t n) { for (size_t i = 0; i < n; i++) a[i] += b[i]; }
+static void add_baseline(int *a, const int *b, size_t n) { for (size_t i = 0; i < n; i++) a[i] += b[i]; }
+static void *resolve_add(void) { __builtin_cpu_init(); return __builtin_cpu_supports("avx512f") ? (void *)add_avx512 : (void *)add_baseline; }
+void add(int *a, const int *b, size_t n) __attribute__((ifunc("resolve_add")));
+`
+	exported := `__attribute__((target("avx512f"))) void sub(int *a, const int *b, size_t n) { for (size_t i = 0; i < n; i++) a[i] -= b[i]; }
+`
+
+	tests := []struct {
+		source string
+		flags  []string
+		want   AssemblyMode
+	}{
+		{source, []string{"-shared", "-fPIC", "-O3"}, v1},
+		// without .symtab the variants are named after their address
+		{source, []string{"-shared", "-fPIC", "-O3", "-s"}, v1},
+		// AVX-512 outside the variants still counts
+		{source + exported, []string{"-shared", "-fPIC", "-O3"}, v4},
+	}
+
+	for _, test := range tests {
+		library := compileC(t, test.source, filepath.Join(t.TempDir(), "libadd.so"), test.flags...)
+		file, err := os.Open(library)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		analysis := NewAnalysis(false)
+		err = analyzeELF(file, analysis)
+		file.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if analysis.Mode != v4 || requiredLevel(analysis) != test.want || len(analysis.Dispatched) == 0 {
+			t.Errorf("library built with %v is %s and needs %s with variants %v, want v4 and %s", test.flags, analysis.Mode, requiredLevel(analysis), analysis.Dispatched, test.want)
+		}
+	}
+}
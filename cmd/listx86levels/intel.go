@@ -0,0 +1,50 @@
+package main
+
+import "regexp"
+
+// QWORD PTR, ymmword ptr and the like in front of a memory operand
+var intelSizePrefix = regexp.MustCompile(`(?i)^[a-z]+ ptr\s+`)
+
+var intelWord = regexp.MustCompile(`\b[a-zA-Z][a-zA-Z0-9]*\b`)
+
+// normalizeIntelOperand rewrites the registers in one Intel operand and drops the size
+// keyword and EVEX decorations such as {k1}{z}, returning their opmask register as splitDecoration does.
+func normalizeIntelOperand(operand string) (string, string) {
+	operand, mask := splitDecoration(operand)
+	operand = intelSizePrefix.ReplaceAllString(operand, "")
+	return intelWord.ReplaceAllStringFunc(operand, normalizeRegister), mask
+}
+
+// parseIntel reads the Intel syntax printed by objdump -M intel and
+// llvm-objdump --x86-asm-syntax=intel. Operands are reversed into Go order,
+// with the destination last.
+func parseIntel(text string, context *string) [][]string {
+	if match := gnuFunctionHeader.FindStringSubmatch(text); match != nil {
+		*context = match[1]
+		return nil
+	}
+
+	address, instruction, ok := splitGNULine(text)
+	if !ok {
+		return nil
+	}
+
+	encoding, mnemonic, operands := splitMnemonic(instruction)
+	tokens := []string{address, normalizeMnemonic(mnemonic)}
+	if encoding != "" {
+		tokens = []string{address, encoding, normalizeMnemonic(mnemonic)}
+	}
+	parts := splitOperands(operands)
+	for i := len(parts) - 1; i >= 0; i-- {
+		operand, mask := normalizeIntelOperand(parts[i])
+		if mask != "" {
+			tokens = append(tokens, mask+",")
+		}
+		if i > 0 {
+			operand += ","
+		}
+		tokens = append(tokens, operand)
+	}
+
+	return [][]string{tokens}
+}
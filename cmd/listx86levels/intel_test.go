@@ -0,0 +1,23 @@
+package main
+
+import "testing"
+
+func TestParseIntel(t *testing.T) {
+	testParser(t, "parseIntel", parseIntel, []parserTest{
+		{"  401000:\tc5 f1 fe c2          \tvpaddd xmm0,xmm1,xmm2", v3, "VPADDD", "AVX"},
+		// only EVEX has opmask registers
+		{"  401000:\t62 f1 75 09 fe c2    \tvpaddd xmm0{k1},xmm1,xmm2", v4, "VPADDD", "AVX512F,AVX512VL"},
+		{"  401000:\t62 f1 75 89 fe c2    \tvpaddd xmm0{k1}{z},xmm1,xmm2", v4, "VPADDD", "AVX512F,AVX512VL"},
+		{"  401000:\t62 f1 7e 29 7f 00    \tvmovdqu32 YMMWORD PTR [rax]{k1},ymm0", v4, "VMOVDQU32", "AVX512F,AVX512VL"},
+
+		// the pseudo-prefix picks the encoding
+		{"  401000:\t62 f2 75 08 50 c2    \t{evex} vpdpbusd xmm0,xmm1,xmm2", v4, "VPDPBUSD", "AVX512VNNI,AVX512F,AVX512VL"},
+
+		// objdump spells the predicate or the immediate into the mnemonic
+		{"  401000:\t0f c2 c1 01          \tcmpltps xmm0,xmm1", v1, "CMPPS", "SSE"},
+		{"  401000:\tf2 0f c2 06 02       \tcmplesd xmm0,QWORD PTR [rsi]", v1, "CMPSD", "SSE2"},
+		{"  401000:\tc5 f4 c2 c2 11       \tvcmplt_oqps ymm0,ymm1,ymm2", v3, "VCMPPS", "AVX"},
+		{"  401000:\t62 f3 75 48 3e c2 04 \tvpcmpnequb k0,zmm1,zmm2", v4, "VPCMPUB", "AVX512BW,AVX512F"},
+		{"  401000:\t66 0f 3a 44 c1 11    \tpclmulhqhqdq xmm0,xmm1", v1, "PCLMULQDQ", "PCLMULQDQ"},
+	})
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"debug/macho"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// The most architectures a universal file is taken to have. Java class files share
+// its magic number and have their version, 45 and up, where nfat_arch is.
+const maxFatArches = 20
+
+// isMachO tells whether magic, the first 8 bytes of a file, starts a Mach-O file.
+func isMachO(magic []byte) bool {
+	switch binary.BigEndian.Uint32(magic) {
+	case macho.Magic32, macho.Magic64:
+		return true
+	case macho.MagicFat:
+		return len(magic) >= 8 && binary.BigEndian.Uint32(magic[4:]) > 0 && binary.BigEndian.Uint32(magic[4:]) < maxFatArches
+	}
+
+	switch binary.LittleEndian.Uint32(magic) {
+	case macho.Magic32, macho.Magic64:
+		return true
+	}
+
+	return false
+}
+
+// analyzeMachO decodes a Mach-O executable. From a universal (fat) file only the
+// x86_64 slice is decoded, falling back to i386 when there is none.
+func analyzeMachO(r io.ReaderAt, analysis *Analysis) error {
+	fat, err := macho.NewFatFile(r)
+	if err == nil {
+		defer fat.Close()
+		var slice *macho.File
+		for _, arch := range fat.Arches {
+			if arch.Cpu == macho.CpuAmd64 {
+				slice = arch.File
+				break
+			}
+			if arch.Cpu == macho.Cpu386 {
+				slice = arch.File
+			}
+		}
+
+		if slice == nil {
+			return errors.New("universal file has no x86 slice")
+		}
+
+		return analyzeMachOFile(slice, analysis)
+	}
+
+	if err != macho.ErrNotFat {
+		return err
+	}
+
+	file, err := macho.NewFile(r)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return analyzeMachOFile(file, analysis)
+}
+
+func analyzeMachOFile(file *macho.File, analysis *Analysis) error {
+	var bits int
+	switch file.Cpu {
+	case macho.CpuAmd64:
+		bits = 64
+	case macho.Cpu386:
+		bits = 32
+	default:
+		return fmt.Errorf("unsupported Mach-O cpu %s", file.Cpu)
+	}
+
+	const pureInstructions = 0x80000000
+	const someInstructions = 0x400
+	const nStab = 0xe0
+	const nType = 0x0e
+	const nSect = 0x0e
+
+	for i, section := range file.Sections {
+		if section.Flags&(pureInstructions|someInstructions) == 0 {
+			continue
+		}
+
+		code, err := section.Data()
+		if err != nil {
+			return fmt.Errorf("reading section %s: %w", section.Name, err)
+		}
+
+		var symbols []symbol
+		if file.Symtab != nil {
+			for _, s := range file.Symtab.Syms {
+				// Mach-O section numbers start at 1
+				if s.Type&nStab == 0 && s.Type&nType == nSect && int(s.Sect) == i+1 {
+					symbols = append(symbols, symbol{Name: s.Name, Addr: s.Value})
+				}
+			}
+		}
+		sortSymbols(symbols)
+
+		skip := goBuildIDLength(code)
+		decodeX86(code[skip:], section.Addr+uint64(skip), symbols, bits, analysis)
+	}
+
+	return nil
+}
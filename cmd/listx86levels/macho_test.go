@@ -0,0 +1,35 @@
+package main
+
+import "testing"
+
+func TestGoBuildIDLength(t *testing.T) {
+	tests := []struct {
+		code   string
+		length int
+	}{
+		{"\xff Go build ID: \"abc/def\"\n \xff\xcc\xcc", 27},
+		{"\xff Go build ID: \"abc/def", 0},
+		{"\x55\x48\x89\xe5", 0},
+		{"", 0},
+	}
+
+	for _, test := range tests {
+		if length := goBuildIDLength([]byte(test.code)); length != test.length {
+			t.Errorf("goBuildIDLength(%q) = %d, want %d", test.code, length, test.length)
+		}
+	}
+}
+
+func TestAnalyzeMachO(t *testing.T) {
+	// b is 0x62, which starts EVEX instructions
+	path := buildExecutable(t, "GOOS=darwin", "GOAMD64=v1", "GOFLAGS=-ldflags=-buildid=bbbbbbbbbbbbbbbb")
+	result := analyzeFile(path, Options{Format: "auto", Arch: "auto"}, false)
+	if result.Err != nil {
+		t.Fatal(result.Err)
+	}
+
+	// runtime.text starts with the build ID and padding
+	if mode := result.Analysis.Functions["runtime.text"]; mode > v1 {
+		t.Errorf("runtime.text needs %s, the build ID was decoded", mode)
+	}
+}
@@ -4,1812 +4,423 @@ import (
 	"bufio"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"math"
 	"os"
-	"regexp"
+	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 )
 
-var x86Assembly = []string{
-	"AAA",
-	"AAD",
-	"AAM",
-	"AAS",
-	"ADC",
-	"ADD",
-	"AND",
-	"ARPL",
-	"BOUND",
-	"BSF",
-	"BSR",
-	"BSWAP",
-	"BT",
-	"BTC",
-	"BTR",
-	"BTS",
-	"CALL",
-	"CBW",
-	"CDQ",
-	"CDQE",
-	"CLC",
-	"CLD",
-	"CLFLUSH",
-	"CLI",
-	"CLTS",
-	"CMC",
-	"CMOVA",
-	"CMOVAE",
-	"CMOVB",
-	"CMOVBE",
-	"CMOVC",
-	"CMOVE",
-	"CMOVG",
-	"CMOVGE",
-	"CMOVL",
-	"CMOVLE",
-	"CMOVNA",
-	"CMOVNAE",
-	"CMOVNB",
-	"CMOVNBE",
-	"CMOVNC",
-	"CMOVNE",
-	"CMOVNG",
-	"CMOVNGE",
-	"CMOVNL",
-	"CMOVNLE",
-	"CMOVNO",
-	"CMOVNP",
-	"CMOVNS",
-	"CMOVNZ",
-	"CMOVO",
-	"CMOVP",
-	"CMOVPE",
-	"CMOVPO",
-	"CMOVS",
-	"CMOVZ",
-	"CMP",
-	"CMPS",
-	"CMPSB",
-	"CMPSD",
-	"CMPSQ",
-	"CMPSW",
-	"CMPXCHG",
-	"CMPXCHG16B",
-	"CMPXCHG8B",
-	"CPUID",
-	"CQO",
-	"CRC32",
-	"CVTPD2PI",
-	"CVTPI2PD",
-	"CVTPI2PS",
-	"CVTPS2PI",
-	"CVTTPD2PI",
-	"CVTTPS2PI",
-	"CWD",
-	"CWDE",
-	"DAA",
-	"DAS",
-	"DEC",
-	"DIV",
-	"EMMS",
-	"ENTER",
-	"F2XM1",
-	"FABS",
-	"FADD",
-	"FADDP",
-	"FBLD",
-	"FBSTP",
-	"FCHS",
-	"FCLEX",
-	"FCMOVB",
-	"FCMOVBE",
-	"FCMOVE",
-	"FCMOVNB",
-	"FCMOVNBE",
-	"FCMOVNE",
-	"FCMOVNU",
-	"FCMOVU",
-	"FCOM",
-	"FCOMI",
-	"FCOMIP",
-	"FCOMP",
-	"FCOMPP",
-	"FCOS",
-	"FDECSTP",
-	"FDIV",
-	"FDIVP",
-	"FDIVR",
-	"FDIVRP",
-	"FFREE",
-	"FFREEP",
-	"FIADD",
-	"FICOM",
-	"FICOMP",
-	"FIDIV",
-	"FIDIVR",
-	"FILD",
-	"FIMUL",
-	"FINCSTP",
-	"FINIT",
-	"FIST",
-	"FISTP",
-	"FISTTP",
-	"FISUB",
-	"FISUBR",
-	"FLD",
-	"FLD1",
-	"FLDCW",
-	"FLDENV",
-	"FLDL2E",
-	"FLDL2T",
-	"FLDLG2",
-	"FLDLN2",
-	"FLDPI",
-	"FLDZ",
-	"FMUL",
-	"FMULP",
-	"FNCLEX",
-	"FNINIT",
-	"FNOP",
-	"FNSAVE",
-	"FNSTCW",
-	"FNSTENV",
-	"FNSTSW",
-	"FPATAN",
-	"FPREM",
-	"FPREM1",
-	"FPTAN",
-	"FRNDINT",
-	"FRSTOR",
-	"FSAVE",
-	"FSCALE",
-	"FSIN",
-	"FSINCOS",
-	"FSQRT",
-	"FST",
-	"FSTCW",
-	"FSTENV",
-	"FSTP",
-	"FSTSW",
-	"FSUB",
-	"FSUBP",
-	"FSUBR",
-	"FSUBRP",
-	"FTST",
-	"FUCOM",
-	"FUCOMI",
-	"FUCOMIP",
-	"FUCOMP",
-	"FUCOMPP",
-	"FWAIT",
-	"FXAM",
-	"FXCH",
-	"FXRSTOR",
-	"FXRSTOR64",
-	"FXSAVE",
-	"FXSAVE64",
-	"FXTRACT",
-	"FYL2X",
-	"FYL2XP1",
-	"HLT",
-	"ICEBP",
-	"IDIV",
-	"IMUL",
-	"IN",
-	"INC",
-	"INS",
-	"INSB",
-	"INSD",
-	"INSW",
-	"INT",
-	"INTO",
-	"INVD",
-	"INVLPG",
-	"IRET",
-	"IRETD",
-	"IRETQ",
-	"JA",
-	"JAE",
-	"JB",
-	"JBE",
-	"JC",
-	"JCXZ",
-	"JE",
-	"JECXZ",
-	"JG",
-	"JGE",
-	"JL",
-	"JLE",
-	"JMP",
-	"JNA",
-	"JNAE",
-	"JNB",
-	"JNBE",
-	"JNC",
-	"JNE",
-	"JNG",
-	"JNGE",
-	"JNL",
-	"JNLE",
-	"JNO",
-	"JNP",
-	"JNS",
-	"JNZ",
-	"JO",
-	"JP",
-	"JPE",
-	"JPO",
-	"JRCXZ",
-	"JS",
-	"JZ",
-	"LAHF",
-	"LAR",
-	"LCALL",
-	"LDS",
-	"LEA",
-	"LEAVE",
-	"LES",
-	"LFENCE",
-	"LFS",
-	"LGDT",
-	"LGS",
-	"LIDT",
-	"LJMP",
-	"LLDT",
-	"LMSW",
-	"LOCK",
-	"LODS",
-	"LODSB",
-	"LODSD",
-	"LODSQ",
-	"LODSW",
-	"LOOP",
-	"LOOPE",
-	"LOOPNE",
-	"LRET",
-	"LSL",
-	"LSS",
-	"LTR",
-	"MASKMOVQ",
-	"MFENCE",
-	"MONITOR",
-	"MOV",
-	"MOVBE",
-	"MOVDQ2Q",
-	"MOVNTI",
-	"MOVNTQ",
-	"MOVQ2DQ",
-	"MOVS",
-	"MOVSB",
-	"MOVSD",
-	"MOVSQ",
-	"MOVSW",
-	"MOVSX",
-	"MOVSXD",
-	"MOVZX",
-	"MUL",
-	"MWAIT",
-	"NEG",
-	"NOP",
-	"NOT",
-	"OR",
-	"OUT",
-	"OUTS",
-	"OUTSB",
-	"OUTSD",
-	"OUTSW",
-	"PAUSE",
-	"POP",
-	"POPA",
-	"POPAD",
-	"POPF",
-	"POPFD",
-	"POPFQ",
-	"PREFETCHNTA",
-	"PREFETCHT0",
-	"PREFETCHT1",
-	"PREFETCHT2",
-	"PSHUFW",
-	"PUSH",
-	"PUSHA",
-	"PUSHAD",
-	"PUSHF",
-	"PUSHFD",
-	"PUSHFQ",
-	"RCL",
-	"RCR",
-	"RDMSR",
-	"RDPMC",
-	"RDTSC",
-	"RDTSCP",
-	"REP",
-	"REPE",
-	"RET",
-	"ROL",
-	"ROR",
-	"RSM",
-	"SAHF",
-	"SAL",
-	"SAR",
-	"SBB",
-	"SCAS",
-	"SCASB",
-	"SCASD",
-	"SCASQ",
-	"SCASW",
-	"SETA",
-	"SETAE",
-	"SETB",
-	"SETBE",
-	"SETC",
-	"SETE",
-	"SETG",
-	"SETGE",
-	"SETL",
-	"SETLE",
-	"SETNA",
-	"SETNAE",
-	"SETNB",
-	"SETNBE",
-	"SETNC",
-	"SETNE",
-	"SETNG",
-	"SETNGE",
-	"SETNL",
-	"SETNLE",
-	"SETNO",
-	"SETNP",
-	"SETNS",
-	"SETNZ",
-	"SETO",
-	"SETP",
-	"SETPE",
-	"SETPO",
-	"SETS",
-	"SETZ",
-	"SFENCE",
-	"SGDT",
-	"SHL",
-	"SHLD",
-	"SHR",
-	"SHRD",
-	"SIDT",
-	"SLDT",
-	"SMSW",
-	"STC",
-	"STD",
-	"STI",
-	"STOS",
-	"STOSB",
-	"STOSD",
-	"STOSQ",
-	"STOSW",
-	"STR",
-	"SUB",
-	"SWAPGS",
-	"SYSCALL",
-	"SYSENTER",
-	"SYSEXIT",
-	"SYSRET",
-	"TEST",
-	"UD0",
-	"UD1",
-	"UD2",
-	"VERR",
-	"VERW",
-	"WAIT",
-	"WBINVD",
-	"WRMSR",
-	"XADD",
-	"XCHG",
-	"XGETBV",
-	"XLAT",
-	"XLATB",
-	"XOR",
-	"XRSTOR",
-	"XRSTOR64",
-	"XRSTORS",
-	"XRSTORS64",
-	"XSAVE",
-	"XSAVE64",
-	"XSAVEC",
-	"XSAVEC64",
-	"XSAVES",
-	"XSAVES64",
-	"XSETBV",
-}
+//go:generate go run gentables.go
 
-var sse = []string{
-	"ADDPS",
-	"ADDSS",
-	"ANDNPS",
-	"ANDPS",
-	"CMPPS",
-	"CMPSS",
-	"COMISS",
-	"CVTSI2SS",
-	"CVTSS2SI",
-	"CVTTSS2SI",
-	"DIVPS",
-	"DIVSS",
-	"LDMXCSR",
-	"MAXPS",
-	"MAXSS",
-	"MINPS",
-	"MINSS",
-	"MOVAPS",
-	"MOVHLPS",
-	"MOVHPS",
-	"MOVLHPS",
-	"MOVLPS",
-	"MOVMSKPS",
-	"MOVNTPS",
-	"MOVNTSD",
-	"MOVNTSS",
-	"MOVSS",
-	"MOVUPS",
-	"MULPS",
-	"MULSS",
-	"ORPS",
-	"PAVGB",
-	"PAVGW",
-	"PEXTRW",
-	"PINSRW",
-	"PMAXSW",
-	"PMAXUB",
-	"PMINSW",
-	"PMINUB",
-	"PMOVMSKB",
-	"PMULHUW",
-	"PSADBW",
-	"RCPPS",
-	"RCPSS",
-	"RSQRTPS",
-	"RSQRTSS",
-	"SHUFPS",
-	"SQRTPS",
-	"SQRTSS",
-	"STMXCSR",
-	"SUBPS",
-	"SUBSS",
-	"UCOMISS",
-	"UNPCKHPS",
-	"UNPCKLPS",
-	"XORPS",
-}
-var sse2 = []string{
-	"ADDPD",
-	"ADDSD",
-	"ANDNPD",
-	"ANDPD",
-	"CMPPD",
-	"CMPSD_XMM",
-	"COMISD",
-	"CVTDQ2PD",
-	"CVTDQ2PS",
-	"CVTPD2DQ",
-	"CVTPD2PS",
-	"CVTPS2DQ",
-	"CVTPS2PD",
-	"CVTSD2SI",
-	"CVTSD2SS",
-	"CVTSI2SD",
-	"CVTSS2SD",
-	"CVTTPD2DQ",
-	"CVTTPS2DQ",
-	"CVTTSD2SI",
-	"DIVPD",
-	"DIVSD",
-	"MASKMOVDQU",
-	"MAXPD",
-	"MAXSD",
-	"MINPD",
-	"MINSD",
-	"MOVAPD",
-	"MOVD",
-	"MOVDQA",
-	"MOVDQU",
-	"MOVHPD",
-	"MOVLPD",
-	"MOVMSKPD",
-	"MOVNTDQ",
-	"MOVNTPD",
-	"MOVQ",
-	"MOVSD_XMM",
-	"MOVUPD",
-	"MULPD",
-	"MULSD",
-	"ORPD",
-	"PACKSSDW",
-	"PACKSSWB",
-	"PACKUSWB",
-	"PADDB",
-	"PADDD",
-	"PADDQ",
-	"PADDSB",
-	"PADDSW",
-	"PADDUSB",
-	"PADDUSW",
-	"PADDW",
-	"PAND",
-	"PANDN",
-	"PAVGB",
-	"PAVGW",
-	"PCMPEQB",
-	"PCMPEQD",
-	"PCMPEQW",
-	"PCMPGTB",
-	"PCMPGTD",
-	"PCMPGTW",
-	"PEXTRW",
-	"PINSRW",
-	"PMADDWD",
-	"PMAXSW",
-	"PMAXUB",
-	"PMINSW",
-	"PMINUB",
-	"PMOVMSKB",
-	"PMULHUW",
-	"PMULHW",
-	"PMULLW",
-	"PMULUDQ",
-	"POR",
-	"PSADBW",
-	"PSHUFD",
-	"PSHUFHW",
-	"PSHUFLW",
-	"PSLLD",
-	"PSLLDQ",
-	"PSLLQ",
-	"PSLLW",
-	"PSRAD",
-	"PSRAW",
-	"PSRLD",
-	"PSRLDQ",
-	"PSRLQ",
-	"PSRLW",
-	"PSUBB",
-	"PSUBD",
-	"PSUBQ",
-	"PSUBSB",
-	"PSUBSW",
-	"PSUBUSB",
-	"PSUBUSW",
-	"PSUBW",
-	"PUNPCKHBW",
-	"PUNPCKHDQ",
-	"PUNPCKHQDQ",
-	"PUNPCKHWD",
-	"PUNPCKLBW",
-	"PUNPCKLDQ",
-	"PUNPCKLQDQ",
-	"PUNPCKLWD",
-	"PXOR",
-	"SHUFPD",
-	"SQRTPD",
-	"SQRTSD",
-	"SUBPD",
-	"SUBSD",
-	"UCOMISD",
-	"UNPCKHPD",
-	"UNPCKLPD",
-	"XORPD",
+// Instruction is one instruction form from golang.org/x/arch, generated into tables.go.
+type Instruction struct {
+	Intel    string // mnemonic in Intel syntax, or as x86asm spells it
+	Go       string // Go assembler opcodes, separated by /
+	CPUID    string // CPUID feature flags, separated by commas
+	Encoding string // as the Intel manual writes it, such as VEX.256.66.0F.WIG FE /r
+	Operands string // operand forms in Intel syntax
 }
 
-var x86SixyFourAssembly = append(append(x86Assembly, sse...), sse2...)
+// AssemblyMode is a level of the architecture, counted from 1. String spells the GOAMD64 levels.
+type AssemblyMode int8
 
-// https://github.com/golang/arch/blob/master/x86/x86.csv
-var cmpxchg16b = []string{
-	"CMPXCHG16B",
-}
-var lahf = []string{
-	"LAHF",
-}
-var sahf = []string{
-	"SAHF",
-}
-var popcnt = []string{
-	"POPCNT",
-}
-var sse3 = []string{
-	"ADDSUBPD",
-	"ADDSUBPS",
-	"HADDPD",
-	"HADDPS",
-	"HSUBPD",
-	"HSUBPS",
-	"LDDQU",
-	"MOVDDUP",
-	"MOVSHDUP",
-	"MOVSLDUP",
-}
-var sse41 = []string{
-	"BLENDPD",
-	"BLENDPS",
-	"BLENDVPD",
-	"BLENDVPS",
-	"DPPD",
-	"DPPS",
-	"EXTRACTPS",
-	"INSERTPS",
-	"MOVNTDQA",
-	"MPSADBW",
-	"PACKUSDW",
-	"PBLENDVB",
-	"PBLENDW",
-	"PCMPEQQ",
-	"PEXTRB",
-	"PEXTRD",
-	"PEXTRQ",
-	"PEXTRW",
-	"PHMINPOSUW",
-	"PINSRB",
-	"PINSRD",
-	"PINSRQ",
-	"PMAXSB",
-	"PMAXSD",
-	"PMAXUD",
-	"PMAXUW",
-	"PMINSB",
-	"PMINSD",
-	"PMINUD",
-	"PMINUW",
-	"PMOVSXBD",
-	"PMOVSXBQ",
-	"PMOVSXBW",
-	"PMOVSXDQ",
-	"PMOVSXWD",
-	"PMOVSXWQ",
-	"PMOVZXBD",
-	"PMOVZXBQ",
-	"PMOVZXBW",
-	"PMOVZXDQ",
-	"PMOVZXWD",
-	"PMOVZXWQ",
-	"PMULDQ",
-	"PMULLD",
-	"PTEST",
-	"ROUNDPD",
-	"ROUNDPS",
-	"ROUNDSD",
-	"ROUNDSS",
+const (
+	na AssemblyMode = 0
+	v1 AssemblyMode = 1
+	v2 AssemblyMode = 2
+	v3 AssemblyMode = 3
+	v4 AssemblyMode = 4
+
+	// Intel APX, which no GOAMD64 level includes. It is counted above v4.
+	apx AssemblyMode = 5
+)
+
+func (mode AssemblyMode) String() string {
+	if mode == apx {
+		return "apx"
+	}
+
+	return fmt.Sprintf("v%d", int(mode))
 }
-var sse42 = []string{
-	"PCMPESTRI",
-	"PCMPESTRM",
-	"PCMPGTQ",
-	"PCMPISTRI",
-	"PCMPISTRM",
+
+func printSorted(basket map[string]int) {
+	keys := make([]string, len(basket))
+	i := 0
+	for k := range basket {
+		keys[i] = k
+		i++
+	}
+
+	sort.Strings(keys)
+	for _, token := range keys {
+		count := basket[token]
+		fmt.Println("    ", token, count)
+	}
 }
 
-var ssse3 = []string{
-	"PABSB",
-	"PABSD",
-	"PABSW",
-	"PALIGNR",
-	"PHADDD",
-	"PHADDSW",
-	"PHADDW",
-	"PHSUBD",
-	"PHSUBSW",
-	"PHSUBW",
-	"PMADDUBSW",
-	"PMULHRSW",
-	"PSHUFB",
-	"PSIGNB",
-	"PSIGND",
-	"PSIGNW",
+func contains(collection []string, token string) bool {
+	idx := sort.SearchStrings(collection, token)
+	return idx != len(collection) && idx >= 0 && collection[idx] == token
 }
-var v2Assembly = append(append(append(append(append(append(append(cmpxchg16b, lahf...), sahf...), popcnt...), sse3...), sse41...), sse42...), ssse3...)
-
-var avx1 = []string{
-	"VADDPD",
-	"VADDPS",
-	"VADDSD",
-	"VADDSS",
-	"VADDSUBPD",
-	"VADDSUBPS",
-	"VANDNPD",
-	"VANDNPS",
-	"VANDPD",
-	"VANDPS",
-	"VBLENDPD",
-	"VBLENDPS",
-	"VBLENDVPD",
-	"VBLENDVPS",
-	"VBROADCASTF128",
-	"VBROADCASTSD",
-	"VBROADCASTSS",
-	"VCMPPD",
-	"VCMPPS",
-	"VCMPSD",
-	"VCMPSS",
-	"VCOMISD",
-	"VCOMISS",
-	"VCVTDQ2PD",
-	"VCVTDQ2PS",
-	"VCVTPD2DQ",
-	"VCVTPD2PS",
-	"VCVTPS2DQ",
-	"VCVTPS2PD",
-	"VCVTSD2SI",
-	"VCVTSD2SS",
-	"VCVTSI2SD",
-	"VCVTSI2SS",
-	"VCVTSS2SD",
-	"VCVTSS2SI",
-	"VCVTTPD2DQ",
-	"VCVTTPS2DQ",
-	"VCVTTSD2SI",
-	"VCVTTSS2SI",
-	"VDIVPD",
-	"VDIVPS",
-	"VDIVSD",
-	"VDIVSS",
-	"VDPPD",
-	"VDPPS",
-	"VEXTRACTF128",
-	"VEXTRACTPS",
-	"VHADDPD",
-	"VHADDPS",
-	"VHSUBPD",
-	"VHSUBPS",
-	"VINSERTF128",
-	"VINSERTPS",
-	"VLDDQU",
-	"VLDMXCSR",
-	"VMASKMOVDQU",
-	"VMASKMOVPD",
-	"VMASKMOVPS",
-	"VMAXPD",
-	"VMAXPS",
-	"VMAXSD",
-	"VMAXSS",
-	"VMINPD",
-	"VMINPS",
-	"VMINSD",
-	"VMINSS",
-	"VMOVAPD",
-	"VMOVAPS",
-	"VMOVD",
-	"VMOVDDUP",
-	"VMOVDQA",
-	"VMOVDQU",
-	"VMOVHLPS",
-	"VMOVHPD",
-	"VMOVHPS",
-	"VMOVLHPS",
-	"VMOVLPD",
-	"VMOVLPS",
-	"VMOVMSKPD",
-	"VMOVMSKPS",
-	"VMOVNTDQ",
-	"VMOVNTDQA",
-	"VMOVNTPD",
-	"VMOVNTPS",
-	"VMOVQ",
-	"VMOVSD",
-	"VMOVSHDUP",
-	"VMOVSLDUP",
-	"VMOVSS",
-	"VMOVUPD",
-	"VMOVUPS",
-	"VMPSADBW",
-	"VMULPD",
-	"VMULPS",
-	"VMULSD",
-	"VMULSS",
-	"VORPD",
-	"VORPS",
-	"VPABSB",
-	"VPABSD",
-	"VPABSW",
-	"VPACKSSDW",
-	"VPACKSSWB",
-	"VPACKUSDW",
-	"VPACKUSWB",
-	"VPADDB",
-	"VPADDD",
-	"VPADDQ",
-	"VPADDSB",
-	"VPADDSW",
-	"VPADDUSB",
-	"VPADDUSW",
-	"VPADDW",
-	"VPALIGNR",
-	"VPAND",
-	"VPANDN",
-	"VPAVGB",
-	"VPAVGW",
-	"VPBLENDVB",
-	"VPBLENDW",
-	"VPCMPEQB",
-	"VPCMPEQD",
-	"VPCMPEQQ",
-	"VPCMPEQW",
-	"VPCMPESTRI",
-	"VPCMPESTRM",
-	"VPCMPGTB",
-	"VPCMPGTD",
-	"VPCMPGTQ",
-	"VPCMPGTW",
-	"VPCMPISTRI",
-	"VPCMPISTRM",
-	"VPERM2F128",
-	"VPERMILPD",
-	"VPERMILPS",
-	"VPEXTRB",
-	"VPEXTRD",
-	"VPEXTRQ",
-	"VPEXTRW",
-	"VPHADDD",
-	"VPHADDSW",
-	"VPHADDW",
-	"VPHMINPOSUW",
-	"VPHSUBD",
-	"VPHSUBSW",
-	"VPHSUBW",
-	"VPINSRB",
-	"VPINSRD",
-	"VPINSRQ",
-	"VPINSRW",
-	"VPMADDUBSW",
-	"VPMADDWD",
-	"VPMAXSB",
-	"VPMAXSD",
-	"VPMAXSW",
-	"VPMAXUB",
-	"VPMAXUD",
-	"VPMAXUW",
-	"VPMINSB",
-	"VPMINSD",
-	"VPMINSW",
-	"VPMINUB",
-	"VPMINUD",
-	"VPMINUW",
-	"VPMOVMSKB",
-	"VPMOVSXBD",
-	"VPMOVSXBQ",
-	"VPMOVSXBW",
-	"VPMOVSXDQ",
-	"VPMOVSXWD",
-	"VPMOVSXWQ",
-	"VPMOVZXBD",
-	"VPMOVZXBQ",
-	"VPMOVZXBW",
-	"VPMOVZXDQ",
-	"VPMOVZXWD",
-	"VPMOVZXWQ",
-	"VPMULDQ",
-	"VPMULHRSW",
-	"VPMULHUW",
-	"VPMULHW",
-	"VPMULLD",
-	"VPMULLW",
-	"VPMULUDQ",
-	"VPOR",
-	"VPSADBW",
-	"VPSHUFB",
-	"VPSHUFD",
-	"VPSHUFHW",
-	"VPSHUFLW",
-	"VPSIGNB",
-	"VPSIGND",
-	"VPSIGNW",
-	"VPSLLD",
-	"VPSLLDQ",
-	"VPSLLQ",
-	"VPSLLW",
-	"VPSRAD",
-	"VPSRAW",
-	"VPSRLD",
-	"VPSRLDQ",
-	"VPSRLQ",
-	"VPSRLW",
-	"VPSUBB",
-	"VPSUBD",
-	"VPSUBQ",
-	"VPSUBSB",
-	"VPSUBSW",
-	"VPSUBUSB",
-	"VPSUBUSW",
-	"VPSUBW",
-	"VPTEST",
-	"VPUNPCKHBW",
-	"VPUNPCKHDQ",
-	"VPUNPCKHQDQ",
-	"VPUNPCKHWD",
-	"VPUNPCKLBW",
-	"VPUNPCKLDQ",
-	"VPUNPCKLQDQ",
-	"VPUNPCKLWD",
-	"VPXOR",
-	"VRCPPS",
-	"VRCPSS",
-	"VROUNDPD",
-	"VROUNDPS",
-	"VROUNDSD",
-	"VROUNDSS",
-	"VRSQRTPS",
-	"VRSQRTSS",
-	"VSHUFPD",
-	"VSHUFPS",
-	"VSQRTPD",
-	"VSQRTPS",
-	"VSQRTSD",
-	"VSQRTSS",
-	"VSTMXCSR",
-	"VSUBPD",
-	"VSUBPS",
-	"VSUBSD",
-	"VSUBSS",
-	"VTESTPD",
-	"VTESTPS",
-	"VUCOMISD",
-	"VUCOMISS",
-	"VUNPCKHPD",
-	"VUNPCKHPS",
-	"VUNPCKLPD",
-	"VUNPCKLPS",
-	"VXORPD",
-	"VXORPS",
-	"VZEROALL",
-	"VZEROUPPER",
+
+// Analysis holds the instruction counts and the highest level found in one input.
+type Analysis struct {
+	Arch       *architecture
+	Mode       AssemblyMode
+	Operations []int
+	Counts     []map[string]int
+	Functions  map[string]AssemblyMode
+	Features   map[string]int // instructions counted per CPUID feature
+	Lengths    map[int]int    // EVEX instructions counted per vector length
+	Wide       map[string]int // EVEX instructions on 512-bit vectors per function
+	Verbose    bool
+	Listing    bool
+	Bits       int // 32 for GOARCH=386 code, which gets a GO386 verdict instead of GOAMD64
+	SSEFloat   int // SSE and SSE2 instructions that do floating point, which GO386=sse2 needs
+
+	afterBranch bool // the last arm64 instruction was an unconditional branch, data may follow
+
+	// functions of an ELF file that its IFUNC resolvers pick for the CPU at run time, as glibc does
+	Dispatched map[string]bool
+	// the glibc dynamic loader, which picks its trampolines for the CPU without IFUNC symbols
+	Loader bool
+
+	// GNU_PROPERTY_X86_ISA_1_NEEDED and _USED from an ELF .note.gnu.property
+	ISANote   bool
+	ISANeeded uint32
+	ISAUsed   uint32
 }
-var avx2 = []string{
-	"VBROADCASTI128",
-	"VBROADCASTSD",
-	"VBROADCASTSS",
-	"VEXTRACTI128",
-	"VGATHERDPD",
-	"VGATHERDPS",
-	"VGATHERQPD",
-	"VGATHERQPS",
-	"VINSERTI128",
-	"VMOVNTDQA",
-	"VMPSADBW",
-	"VPABSB",
-	"VPABSD",
-	"VPABSW",
-	"VPACKSSDW",
-	"VPACKSSWB",
-	"VPACKUSDW",
-	"VPACKUSWB",
-	"VPADDB",
-	"VPADDD",
-	"VPADDQ",
-	"VPADDSB",
-	"VPADDSW",
-	"VPADDUSB",
-	"VPADDUSW",
-	"VPADDW",
-	"VPALIGNR",
-	"VPAND",
-	"VPANDN",
-	"VPAVGB",
-	"VPAVGW",
-	"VPBLENDD",
-	"VPBLENDVB",
-	"VPBLENDW",
-	"VPBROADCASTB",
-	"VPBROADCASTD",
-	"VPBROADCASTQ",
-	"VPBROADCASTW",
-	"VPCMPEQD",
-	"VPCMPEQQ",
-	"VPCMPEQW",
-	"VPCMPGTB",
-	"VPCMPGTD",
-	"VPCMPGTQ",
-	"VPCMPGTW",
-	"VPERM2I128",
-	"VPERMD",
-	"VPERMPD",
-	"VPERMPS",
-	"VPERMQ",
-	"VPGATHERDD",
-	"VPGATHERDQ",
-	"VPGATHERQD",
-	"VPGATHERQQ",
-	"VPHADDD",
-	"VPHADDSW",
-	"VPHADDW",
-	"VPHSUBD",
-	"VPHSUBSW",
-	"VPHSUBW",
-	"VPMADDUBSW",
-	"VPMADDWD",
-	"VPMASKMOVD",
-	"VPMASKMOVQ",
-	"VPMAXSB",
-	"VPMAXSD",
-	"VPMAXSW",
-	"VPMAXUB",
-	"VPMAXUD",
-	"VPMAXUW",
-	"VPMINSB",
-	"VPMINSD",
-	"VPMINSW",
-	"VPMINUB",
-	"VPMINUD",
-	"VPMINUW",
-	"VPMOVMSKB",
-	"VPMOVSXBD",
-	"VPMOVSXBQ",
-	"VPMOVSXBW",
-	"VPMOVSXWD",
-	"VPMOVZXBD",
-	"VPMOVZXBQ",
-	"VPMOVZXBW",
-	"VPMOVZXWD",
-	"VPMULDQ",
-	"VPMULHRSW",
-	"VPMULHUW",
-	"VPMULHW",
-	"VPMULLD",
-	"VPMULLW",
-	"VPMULUDQ",
-	"VPOR",
-	"VPSADBW",
-	"VPSHUFB",
-	"VPSHUFD",
-	"VPSHUFHW",
-	"VPSHUFLW",
-	"VPSIGNB",
-	"VPSIGND",
-	"VPSIGNW",
-	"VPSLLDQ",
-	"VPSLLVD",
-	"VPSLLVQ",
-	"VPSLLW",
-	"VPSRAD",
-	"VPSRAVD",
-	"VPSRAW",
-	"VPSRLDQ",
-	"VPSRLVD",
-	"VPSRLVQ",
-	"VPSRLW",
-	"VPSUBB",
-	"VPSUBD",
-	"VPSUBQ",
-	"VPSUBSB",
-	"VPSUBSW",
-	"VPSUBUSB",
-	"VPSUBUSW",
-	"VPSUBW",
-	"VPUNPCKHBW",
-	"VPUNPCKHDQ",
-	"VPUNPCKHQDQ",
-	"VPUNPCKHWD",
-	"VPUNPCKLBW",
-	"VPUNPCKLDQ",
-	"VPUNPCKLQDQ",
-	"VPUNPCKLWD",
-	"VPXOR",
+
+func NewAnalysis(verbose bool) *Analysis {
+	analysis := &Analysis{
+		Mode:       na,
+		Functions:  make(map[string]AssemblyMode),
+		Features:   make(map[string]int),
+		Lengths:    make(map[int]int),
+		Wide:       make(map[string]int),
+		Dispatched: make(map[string]bool),
+		Verbose:    verbose,
+	}
+
+	analysis.SetArch(amd64Arch)
+	return analysis
 }
-var bmi1 = []string{
-	"ANDN",
-	"BEXTR",
-	"BLSI",
-	"BLSMSK",
-	"BLSR",
-	"TZCNT",
+
+// Classify returns the level of the instruction on one line of disassembly, together
+// with the instruction, the CPUID features it needs and the vector length of EVEX
+// instructions. The mnemonic is the first known token after the address, the tokens
+// behind it are the operands, and a pseudo-prefix such as {evex} before it picks the
+// encoding. Unknown instructions on the AMX tile registers still need AMX-TILE.
+func (analysis *Analysis) Classify(tokens []string, context string) (AssemblyMode, string, []string, int) {
+	encoding := ""
+	for i, token := range tokens {
+		if i == 0 || contains(gnuPrefixes, strings.ToLower(strings.TrimSuffix(token, ";"))) {
+			if strings.HasPrefix(token, "{") {
+				encoding = strings.ToLower(token)
+			}
+			continue
+		}
+
+		f, length := classifyMnemonic(token, tokens[i+1:], encoding)
+		if f == nil {
+			continue
+		}
+
+		if analysis.Verbose && f.Level > v1 {
+			fmt.Printf("Found %s instruction %s (%s) in function %s %s\n", f.Level, token, strings.Join(f.Features, ","), tokens[0], context)
+		}
+
+		return f.Level, token, f.Features, length
+	}
+
+	if instruction, ok := tileInstruction(tokens[1:]); ok {
+		return v1, instruction, []string{"AMXTILE"}, 0
+	}
+
+	return na, "", nil, 0
 }
-var bmi2 = []string{
-	"BZHI",
-	"MULX",
-	"PDEP",
-	"PEXT",
-	"RORX",
-	"SARX",
-	"SHLX",
-	"SHRX",
+
+// Add classifies one line of disassembly as the architecture does and counts the instruction in its level.
+func (analysis *Analysis) Add(tokens []string, context string) (AssemblyMode, []string) {
+	mode, instruction, features, length := analysis.Arch.Classify(analysis, tokens, context)
+	analysis.Count(mode, instruction, features, length, context)
+	return mode, features
 }
-var f16c = []string{
-	"VCVTPH2PS",
-	"VCVTPS2PH",
+
+// Count records one instruction already known to need mode and features. length
+// is the vector length of an EVEX instruction, and 0 for any other instruction.
+func (analysis *Analysis) Count(mode AssemblyMode, instruction string, features []string, length int, context string) {
+	if length > 0 {
+		analysis.Lengths[length]++
+		if length == 512 {
+			analysis.Wide[context]++
+		}
+	}
+
+	if mode != na {
+		analysis.Operations[mode-1]++
+		analysis.Counts[mode-1][countKey(instruction, features)]++
+		if mode > analysis.Functions[context] {
+			analysis.Functions[context] = mode
+		}
+
+		for _, feature := range features {
+			analysis.Features[feature]++
+		}
+		if sseFloat(instruction, features) {
+			analysis.SSEFloat++
+		}
+	}
+
+	analysis.Mode = AssemblyMode(math.Max(float64(mode), float64(analysis.Mode)))
 }
-var fma = []string{
-	"VFMADD132PD",
-	"VFMADD132PS",
-	"VFMADD132SD",
-	"VFMADD132SS",
-	"VFMADD213PD",
-	"VFMADD213PS",
-	"VFMADD213SD",
-	"VFMADD213SS",
-	"VFMADD231PD",
-	"VFMADD231PS",
-	"VFMADD231SD",
-	"VFMADD231SS",
-	"VFMADDSUB132PD",
-	"VFMADDSUB132PS",
-	"VFMADDSUB213PD",
-	"VFMADDSUB213PS",
-	"VFMADDSUB231PD",
-	"VFMADDSUB231PS",
-	"VFMSUB132PD",
-	"VFMSUB132PS",
-	"VFMSUB132SD",
-	"VFMSUB132SS",
-	"VFMSUB213PD",
-	"VFMSUB213PS",
-	"VFMSUB213SD",
-	"VFMSUB213SS",
-	"VFMSUB231PD",
-	"VFMSUB231PS",
-	"VFMSUB231SD",
-	"VFMSUB231SS",
-	"VFMSUBADD132PD",
-	"VFMSUBADD132PS",
-	"VFMSUBADD213PD",
-	"VFMSUBADD213PS",
-	"VFMSUBADD231PD",
-	"VFMSUBADD231PS",
-	"VFNMADD132PD",
-	"VFNMADD132PS",
-	"VFNMADD132SD",
-	"VFNMADD132SS",
-	"VFNMADD213PD",
-	"VFNMADD213PS",
-	"VFNMADD213SD",
-	"VFNMADD213SS",
-	"VFNMADD231PD",
-	"VFNMADD231PS",
-	"VFNMADD231SD",
-	"VFNMADD231SS",
-	"VFNMSUB132PD",
-	"VFNMSUB132PS",
-	"VFNMSUB132SD",
-	"VFNMSUB132SS",
-	"VFNMSUB213PD",
-	"VFNMSUB213PS",
-	"VFNMSUB213SD",
-	"VFNMSUB213SS",
-	"VFNMSUB231PD",
-	"VFNMSUB231PS",
-	"VFNMSUB231SD",
-	"VFNMSUB231SS",
+
+// countKey names an instruction in the counts together with the psABI features behind it, as in CMOVQEQ [CMOV].
+func countKey(instruction string, features []string) string {
+	if len(features) == 0 {
+		return instruction
+	}
+
+	return instruction + " [" + strings.Join(features, ",") + "]"
 }
-var lzcnt = []string{
-	"LZCNT",
+
+// List prints one decoded instruction with its address, level and features when a listing was asked for.
+func (analysis *Analysis) List(addr uint64, code []byte, mode AssemblyMode, features []string, text string) {
+	if !analysis.Listing {
+		return
+	}
+
+	level := "-"
+	if mode != na {
+		level = analysis.Arch.LevelName(mode)
+	}
+
+	feature := "-"
+	if len(features) > 0 {
+		feature = strings.Join(features, ",")
+	}
+	fmt.Printf("%#x\t%x\t%s\t%s\t%s\n", addr, code, level, feature, text)
 }
 
-// var movbe = []string{}
-var osxsave = []string{
-	"OSXSAVE",
+// lineParser turns one line of disassembly into the instructions on it, each as tokens
+// spelled the way go tool objdump spells them. Lines that start a function set context.
+type lineParser func(text string, context *string) [][]string
+
+// Parsers keyed by -format. Each call makes a new parser, as some keep state between lines.
+var lineParsers = map[string]func() lineParser{
+	"go":      func() lineParser { return parseGoObjdump },
+	"att":     func() lineParser { return parseATT },
+	"intel":   func() lineParser { return parseIntel },
+	"compile": func() lineParser { return parseCompile },
+	"asm":     func() lineParser { return newPlan9Parser().parse },
 }
-var v3Assembly = append(append(append(append(append(append(append(avx1, avx2...), bmi1...), bmi2...), f16c...), fma...), lzcnt...), osxsave...)
-
-// https://raw.githubusercontent.com/intel-go/avx512counters/master/avx512_core_i9_7900x.csv
-var avx512Assembly = []string{
-	"KADDB",
-	"KADDD",
-	"KADDQ",
-	"KADDW",
-	"KANDB",
-	"KANDD",
-	"KANDNB",
-	"KANDND",
-	"KANDNQ",
-	"KANDNW",
-	"KANDQ",
-	"KANDW",
-	"KMOVB",
-	"KMOVD",
-	"KMOVQ",
-	"KMOVW",
-	"KNOTB",
-	"KNOTD",
-	"KNOTQ",
-	"KNOTW",
-	"KORB",
-	"KORD",
-	"KORQ",
-	"KORTESTB",
-	"KORTESTD",
-	"KORTESTQ",
-	"KORTESTW",
-	"KORW",
-	"KSHIFTLB",
-	"KSHIFTLD",
-	"KSHIFTLQ",
-	"KSHIFTLW",
-	"KSHIFTRB",
-	"KSHIFTRD",
-	"KSHIFTRQ",
-	"KSHIFTRW",
-	"KTESTB",
-	"KTESTD",
-	"KTESTQ",
-	"KTESTW",
-	"KUNPCKBW",
-	"KUNPCKDQ",
-	"KUNPCKWD",
-	"KXNORB",
-	"KXNORD",
-	"KXNORQ",
-	"KXNORW",
-	"KXORB",
-	"KXORD",
-	"KXORQ",
-	"KXORW",
-	"VADDPD",
-	"VADDPS",
-	"VADDSD",
-	"VADDSS",
-	"VALIGND",
-	"VALIGNQ",
-	"VANDNPD",
-	"VANDNPS",
-	"VANDPD",
-	"VANDPS",
-	"VBLENDMPD",
-	"VBLENDMPS",
-	"VBROADCASTF32X2",
-	"VBROADCASTF32X4",
-	"VBROADCASTF32X8",
-	"VBROADCASTF64X2",
-	"VBROADCASTF64X4",
-	"VBROADCASTI32X2",
-	"VBROADCASTI32X4",
-	"VBROADCASTI32X8",
-	"VBROADCASTI64X2",
-	"VBROADCASTI64X4",
-	"VBROADCASTSD",
-	"VBROADCASTSS",
-	"VCMPPD",
-	"VCMPPS",
-	"VCMPSD",
-	"VCMPSS",
-	"VCOMISD",
-	"VCOMISS",
-	"VCOMPRESSPD",
-	"VCOMPRESSPS",
-	"VCVTDQ2PD",
-	"VCVTDQ2PS",
-	"VCVTPD2DQ",
-	"VCVTPD2DQX",
-	"VCVTPD2DQY",
-	"VCVTPD2PS",
-	"VCVTPD2PSX",
-	"VCVTPD2PSY",
-	"VCVTPD2QQ",
-	"VCVTPD2UDQ",
-	"VCVTPD2UDQX",
-	"VCVTPD2UDQY",
-	"VCVTPD2UQQ",
-	"VCVTPH2PS",
-	"VCVTPS2DQ",
-	"VCVTPS2PD",
-	"VCVTPS2PH",
-	"VCVTPS2QQ",
-	"VCVTPS2UDQ",
-	"VCVTPS2UQQ",
-	"VCVTQQ2PD",
-	"VCVTQQ2PS",
-	"VCVTQQ2PSX",
-	"VCVTQQ2PSY",
-	"VCVTSD2SI",
-	"VCVTSD2SS",
-	"VCVTSD2USIL",
-	"VCVTSD2USIQ",
-	"VCVTSI2SDL",
-	"VCVTSI2SDQ",
-	"VCVTSI2SSL",
-	"VCVTSS2SD",
-	"VCVTSS2SI",
-	"VCVTSS2USIL",
-	"VCVTSS2USIQ",
-	"VCVTTPD2DQ",
-	"VCVTTPD2DQX",
-	"VCVTTPD2DQY",
-	"VCVTTPD2QQ",
-	"VCVTTPD2UDQ",
-	"VCVTTPD2UDQX",
-	"VCVTTPD2UDQY",
-	"VCVTTPD2UQQ",
-	"VCVTTPS2DQ",
-	"VCVTTPS2QQ",
-	"VCVTTPS2UDQ",
-	"VCVTTPS2UQQ",
-	"VCVTTSD2SI",
-	"VCVTTSD2USIL",
-	"VCVTTSD2USIQ",
-	"VCVTTSS2SI",
-	"VCVTTSS2SIQ",
-	"VCVTTSS2USIL",
-	"VCVTTSS2USIQ",
-	"VCVTUDQ2PD",
-	"VCVTUDQ2PS",
-	"VCVTUQQ2PD",
-	"VCVTUQQ2PS",
-	"VCVTUQQ2PSX",
-	"VCVTUQQ2PSY",
-	"VCVTUSI2SDL",
-	"VCVTUSI2SDQ",
-	"VCVTUSI2SSL",
-	"VCVTUSI2SSQ",
-	"VDBPSADBW",
-	"VDIVPD",
-	"VDIVPS",
-	"VDIVSD",
-	"VDIVSS",
-	"VEXPANDPD",
-	"VEXPANDPS",
-	"VEXTRACTF32X4",
-	"VEXTRACTF32X8",
-	"VEXTRACTF64X2",
-	"VEXTRACTF64X4",
-	"VEXTRACTI32X4",
-	"VEXTRACTI32X8",
-	"VEXTRACTI64X2",
-	"VEXTRACTI64X4",
-	"VFIXUPIMMPD",
-	"VFIXUPIMMPS",
-	"VFIXUPIMMSD",
-	"VFIXUPIMMSS",
-	"VFMADD132PD",
-	"VFMADD132PS",
-	"VFMADD132SD",
-	"VFMADD132SS",
-	"VFMADD213PD",
-	"VFMADD213PS",
-	"VFMADD213SD",
-	"VFMADD213SS",
-	"VFMADD231PD",
-	"VFMADD231PS",
-	"VFMADD231SD",
-	"VFMADD231SS",
-	"VFMADDSUB132PD",
-	"VFMADDSUB132PS",
-	"VFMADDSUB213PD",
-	"VFMADDSUB213PS",
-	"VFMADDSUB231PD",
-	"VFMADDSUB231PS",
-	"VFMSUB132PD",
-	"VFMSUB132PS",
-	"VFMSUB132SD",
-	"VFMSUB132SS",
-	"VFMSUB213PD",
-	"VFMSUB213PS",
-	"VFMSUB213SD",
-	"VFMSUB213SS",
-	"VFMSUB231PD",
-	"VFMSUB231PS",
-	"VFMSUB231SD",
-	"VFMSUB231SS",
-	"VFMSUBADD132PD",
-	"VFMSUBADD132PS",
-	"VFMSUBADD213PD",
-	"VFMSUBADD213PS",
-	"VFMSUBADD231PD",
-	"VFMSUBADD231PS",
-	"VFNMADD132PD",
-	"VFNMADD132PS",
-	"VFNMADD132SD",
-	"VFNMADD132SS",
-	"VFNMADD213PD",
-	"VFNMADD213PS",
-	"VFNMADD213SD",
-	"VFNMADD213SS",
-	"VFNMADD231PD",
-	"VFNMADD231PS",
-	"VFNMADD231SD",
-	"VFNMADD231SS",
-	"VFNMSUB132PD",
-	"VFNMSUB132PS",
-	"VFNMSUB132SD",
-	"VFNMSUB132SS",
-	"VFNMSUB213PD",
-	"VFNMSUB213PS",
-	"VFNMSUB213SD",
-	"VFNMSUB213SS",
-	"VFNMSUB231PD",
-	"VFNMSUB231PS",
-	"VFNMSUB231SD",
-	"VFNMSUB231SS",
-	"VFPCLASSPDX",
-	"VFPCLASSPDY",
-	"VFPCLASSPDZ",
-	"VFPCLASSPSX",
-	"VFPCLASSPSY",
-	"VFPCLASSPSZ",
-	"VFPCLASSSD",
-	"VFPCLASSSS",
-	"VGATHERDPD",
-	"VGATHERDPS",
-	"VGATHERQPD",
-	"VGATHERQPS",
-	"VGETEXPPD",
-	"VGETEXPPS",
-	"VGETEXPSD",
-	"VGETEXPSS",
-	"VGETMANTPD",
-	"VGETMANTPS",
-	"VGETMANTSD",
-	"VGETMANTSS",
-	"VINSERTF32X4",
-	"VINSERTF32X8",
-	"VINSERTF64X2",
-	"VINSERTF64X4",
-	"VINSERTI32X4",
-	"VINSERTI32X8",
-	"VINSERTI64X2",
-	"VINSERTI64X4",
-	"VMAXPD",
-	"VMAXPS",
-	"VMAXSD",
-	"VMAXSS",
-	"VMINPD",
-	"VMINPS",
-	"VMINSD",
-	"VMINSS",
-	"VMOVAPD",
-	"VMOVAPS",
-	"VMOVDDUP",
-	"VMOVDQA32",
-	"VMOVDQA64",
-	"VMOVDQU16",
-	"VMOVDQU32",
-	"VMOVDQU64",
-	"VMOVDQU8",
-	"VMOVHPS",
-	"VMOVLHPS",
-	"VMOVNTDQ",
-	"VMOVNTDQA",
-	"VMOVNTPD",
-	"VMOVNTPS",
-	"VMOVSD",
-	"VMOVSHDUP",
-	"VMOVSLDUP",
-	"VMOVSS",
-	"VMOVUPD",
-	"VMOVUPS",
-	"VMULPD",
-	"VMULPS",
-	"VMULSD",
-	"VMULSS",
-	"VORPD",
-	"VORPS",
-	"VPABSB",
-	"VPABSD",
-	"VPABSQ",
-	"VPABSW",
-	"VPACKSSDW",
-	"VPACKSSWB",
-	"VPACKUSDW",
-	"VPACKUSWB",
-	"VPADDB",
-	"VPADDD",
-	"VPADDQ",
-	"VPADDSB",
-	"VPADDSW",
-	"VPADDUSB",
-	"VPADDUSW",
-	"VPADDW",
-	"VPALIGNR",
-	"VPANDD",
-	"VPANDND",
-	"VPANDNQ",
-	"VPANDQ",
-	"VPAVGB",
-	"VPAVGW",
-	"VPBLENDMB",
-	"VPBLENDMD",
-	"VPBLENDMQ",
-	"VPBLENDMW",
-	"VPBROADCASTB",
-	"VPBROADCASTD",
-	"VPBROADCASTMB2Q",
-	"VPBROADCASTMW2D",
-	"VPBROADCASTQ",
-	"VPBROADCASTW",
-	"VPCMPB",
-	"VPCMPD",
-	"VPCMPEQB",
-	"VPCMPEQD",
-	"VPCMPEQQ",
-	"VPCMPEQW",
-	"VPCMPGTB",
-	"VPCMPGTD",
-	"VPCMPGTQ",
-	"VPCMPGTW",
-	"VPCMPQ",
-	"VPCMPUB",
-	"VPCMPUD",
-	"VPCMPUQ",
-	"VPCMPUW",
-	"VPCMPW",
-	"VPCOMPRESSD",
-	"VPCOMPRESSQ",
-	"VPCONFLICTD",
-	"VPCONFLICTQ",
-	"VPERMD",
-	"VPERMI2D",
-	"VPERMI2PD",
-	"VPERMI2PS",
-	"VPERMI2Q",
-	"VPERMI2W",
-	"VPERMILPD",
-	"VPERMILPS",
-	"VPERMPD",
-	"VPERMPS",
-	"VPERMQ",
-	"VPERMT2D",
-	"VPERMT2PD",
-	"VPERMT2PS",
-	"VPERMT2Q",
-	"VPERMT2W",
-	"VPERMW",
-	"VPEXPANDD",
-	"VPEXPANDQ",
-	"VPEXTRB",
-	"VPEXTRD",
-	"VPEXTRQ",
-	"VPGATHERDD",
-	"VPGATHERDQ",
-	"VPGATHERQD",
-	"VPGATHERQQ",
-	"VPINSRD",
-	"VPINSRQ",
-	"VPLZCNTD",
-	"VPLZCNTQ",
-	"VPMADDUBSW",
-	"VPMADDWD",
-	"VPMAXSB",
-	"VPMAXSD",
-	"VPMAXSQ",
-	"VPMAXSW",
-	"VPMAXUB",
-	"VPMAXUD",
-	"VPMAXUQ",
-	"VPMAXUW",
-	"VPMINSB",
-	"VPMINSD",
-	"VPMINSQ",
-	"VPMINSW",
-	"VPMINUB",
-	"VPMINUD",
-	"VPMINUQ",
-	"VPMINUW",
-	"VPMOVB2M",
-	"VPMOVD2M",
-	"VPMOVDB",
-	"VPMOVDW",
-	"VPMOVM2B",
-	"VPMOVM2D",
-	"VPMOVM2Q",
-	"VPMOVM2W",
-	"VPMOVQ2M",
-	"VPMOVQB",
-	"VPMOVQD",
-	"VPMOVQW",
-	"VPMOVSDB",
-	"VPMOVSDW",
-	"VPMOVSQB",
-	"VPMOVSQD",
-	"VPMOVSQW",
-	"VPMOVSWB",
-	"VPMOVSXBD",
-	"VPMOVSXBQ",
-	"VPMOVSXBW",
-	"VPMOVSXDQ",
-	"VPMOVSXWD",
-	"VPMOVSXWQ",
-	"VPMOVUSDB",
-	"VPMOVUSDW",
-	"VPMOVUSQB",
-	"VPMOVUSQD",
-	"VPMOVUSQW",
-	"VPMOVUSWB",
-	"VPMOVW2M",
-	"VPMOVWB",
-	"VPMOVZXBD",
-	"VPMOVZXBQ",
-	"VPMOVZXBW",
-	"VPMOVZXDQ",
-	"VPMOVZXWD",
-	"VPMOVZXWQ",
-	"VPMULDQ",
-	"VPMULHRSW",
-	"VPMULHUW",
-	"VPMULHW",
-	"VPMULLD",
-	"VPMULLQ",
-	"VPMULLW",
-	"VPMULUDQ",
-	"VPORD",
-	"VPORQ",
-	"VPROLD",
-	"VPROLQ",
-	"VPROLVD",
-	"VPROLVQ",
-	"VPRORD",
-	"VPRORQ",
-	"VPRORVD",
-	"VPRORVQ",
-	"VPSADBW",
-	"VPSCATTERDD",
-	"VPSCATTERDQ",
-	"VPSCATTERQD",
-	"VPSCATTERQQ",
-	"VPSHUFB",
-	"VPSHUFD",
-	"VPSHUFHW",
-	"VPSHUFLW",
-	"VPSLLD",
-	"VPSLLDQ",
-	"VPSLLQ",
-	"VPSLLVD",
-	"VPSLLVQ",
-	"VPSLLVW",
-	"VPSLLW",
-	"VPSRAD",
-	"VPSRAQ",
-	"VPSRAVD",
-	"VPSRAVQ",
-	"VPSRAVW",
-	"VPSRAW",
-	"VPSRLD",
-	"VPSRLDQ",
-	"VPSRLQ",
-	"VPSRLVD",
-	"VPSRLVQ",
-	"VPSRLVW",
-	"VPSRLW",
-	"VPSUBB",
-	"VPSUBD",
-	"VPSUBQ",
-	"VPSUBSB",
-	"VPSUBSW",
-	"VPSUBUSB",
-	"VPSUBUSW",
-	"VPSUBW",
-	"VPTERNLOGD",
-	"VPTERNLOGQ",
-	"VPTESTMB",
-	"VPTESTMD",
-	"VPTESTMQ",
-	"VPTESTMW",
-	"VPTESTNMB",
-	"VPTESTNMD",
-	"VPTESTNMQ",
-	"VPTESTNMW",
-	"VPUNPCKHBW",
-	"VPUNPCKHDQ",
-	"VPUNPCKHQDQ",
-	"VPUNPCKHWD",
-	"VPUNPCKLBW",
-	"VPUNPCKLDQ",
-	"VPUNPCKLQDQ",
-	"VPUNPCKLWD",
-	"VPXORD",
-	"VPXORQ",
-	"VRANGEPD",
-	"VRANGEPS",
-	"VRANGESD",
-	"VRANGESS",
-	"VRCP14PD",
-	"VRCP14PS",
-	"VRCP14SD",
-	"VRCP14SS",
-	"VREDUCEPD",
-	"VREDUCEPS",
-	"VREDUCESD",
-	"VREDUCESS",
-	"VRNDSCALEPD",
-	"VRNDSCALEPS",
-	"VRNDSCALESD",
-	"VRNDSCALESS",
-	"VRSQRT14PD",
-	"VRSQRT14PS",
-	"VRSQRT14SD",
-	"VRSQRT14SS",
-	"VSCALEFPD",
-	"VSCALEFPS",
-	"VSCALEFSD",
-	"VSCALEFSS",
-	"VSCATTERDPD",
-	"VSCATTERDPS",
-	"VSCATTERQPD",
-	"VSCATTERQPS",
-	"VSHUFF32X4",
-	"VSHUFF64X2",
-	"VSHUFI32X4",
-	"VSHUFI64X2",
-	"VSHUFPD",
-	"VSHUFPS",
-	"VSQRTPD",
-	"VSQRTPS",
-	"VSQRTSD",
-	"VSQRTSS",
-	"VSUBPD",
-	"VSUBPS",
-	"VSUBSD",
-	"VSUBSS",
-	"VUCOMISD",
-	"VUCOMISS",
-	"VUNPCKHPD",
-	"VUNPCKHPS",
-	"VUNPCKLPD",
-	"VUNPCKLPS",
-	"VXORPD",
-	"VXORPS",
+
+// parseGoObjdump reads the text printed by go tool objdump.
+func parseGoObjdump(text string, context *string) [][]string {
+	if len(text) > 4 && text[:4] == "TEXT" {
+		*context = text[5:]
+		return nil
+	}
+
+	// the file and line, the address and the encoding come before the instruction
+	tokens := strings.Fields(text)
+	for i := 3; i < len(tokens); i++ {
+		if !contains(gnuPrefixes, strings.ToLower(strings.TrimSuffix(tokens[i], ";"))) {
+			tokens[i] = normalizeMnemonic(tokens[i])
+			break
+		}
+	}
+
+	return [][]string{tokens}
 }
 
-var v4Assembly = avx512Assembly
+func scanLines(scanner *bufio.Scanner, parse lineParser, analysis *Analysis) error {
+	var context string = ""
+	for scanner.Scan() {
+		if gnuFileFormat32.MatchString(scanner.Text()) {
+			analysis.Bits = 32
+		}
 
-// VMOVNTDQ is problematic. it is both AVX, AVX512VL and AVX512F depending on what registers it operates on
-// https://www.felixcloutier.com/x86/movntdq
-var detectModeByRegisters = []string{
-	"PUNPCKHQDQ",
-	"VMOVDQU",
-	"VMOVNTDQ",
-	"VMOVNTDQA",
+		for _, tokens := range parse(scanner.Text(), &context) {
+			if len(tokens) > 0 {
+				analysis.Add(tokens, context)
+			}
+		}
+	}
+
+	return scanner.Err()
 }
 
-type AssemblyMode int8
+func (analysis *Analysis) Print(printStatistics bool, extended bool) {
+	arch := analysis.Arch
+	if printStatistics {
+		for i := range arch.Levels {
+			fmt.Println(arch.Label(i), analysis.Operations[i])
+			if extended {
+				printSorted(analysis.Counts[i])
+			}
+			fmt.Println()
+		}
 
-const (
-	na AssemblyMode = 0
-	v1 AssemblyMode = 1
-	v2 AssemblyMode = 2
-	v3 AssemblyMode = 3
-	v4 AssemblyMode = 4
-)
+		analysis.PrintFeatures()
+		analysis.PrintExtensions()
 
-var findRegisterExpression *regexp.Regexp
-var findRegisterExpressionError error
+		if arch == amd64Arch {
+			fmt.Println("EVEX", analysis.Lengths[128]+analysis.Lengths[256]+analysis.Lengths[512])
+			for _, length := range []int{128, 256, 512} {
+				fmt.Println("    ", length, analysis.Lengths[length])
+			}
+			fmt.Println()
+		}
+	}
 
-func printSorted(basket map[string]int) {
-	keys := make([]string, len(basket))
-	i := 0
-	for k := range basket {
-		keys[i] = k
-		i++
+	if analysis.ISANote {
+		analysis.PrintISANote()
 	}
 
-	sort.Strings(keys)
-	for _, token := range keys {
-		count := basket[token]
-		fmt.Println("    ", token, count)
+	if len(analysis.Lengths) > 0 {
+		analysis.PrintAVX10()
+	}
+
+	if analysis.Bits == 32 {
+		analysis.PrintGO386(printStatistics)
+		return
+	}
+
+	if analysis.Verbose {
+		if features := arch.sortedFeatures(analysis.Features, false); len(features) > 0 {
+			fmt.Printf("Required %s features: %s\n", arch.Check, strings.Join(features, " "))
+		}
+	}
+
+	if extensions := arch.sortedFeatures(analysis.Features, true); len(extensions) > 0 {
+		if analysis.Verbose {
+			fmt.Printf("Extensions outside the %s levels, the code must check %s for them: %s\n", arch.Variable, arch.Check, strings.Join(extensions, " "))
+		} else {
+			fmt.Println("Extensions:", strings.Join(extensions, " "))
+		}
+	}
+
+	if analysis.Verbose {
+		if arch == amd64Arch && analysis.Mode == apx {
+			fmt.Println("APX is above v4 and no GOAMD64 level, only CPUs with APX run this code")
+		}
+		fmt.Printf("Minimum required %s=%s\n", arch.Variable, analysis.Level())
+	} else {
+		fmt.Printf("s%s=%s\n", arch.Variable, analysis.Level())
+	}
+}
+
+// PrintFeatures lists the features of the levels and options with the number of instructions that need them.
+func (analysis *Analysis) PrintFeatures() {
+	features := analysis.Arch.sortedFeatures(analysis.Features, false)
+	fmt.Println(analysis.Arch.Check, "features", len(features))
+	for _, feature := range features {
+		fmt.Println("    ", feature, analysis.Features[feature])
 	}
+	fmt.Println()
 }
 
-func init() {
-	findRegisterExpression, findRegisterExpressionError = regexp.Compile(`[^\(]*\(([^\(]*)\).*`)
-	sort.Strings(x86SixyFourAssembly)
-	sort.Strings(v2Assembly)
-	sort.Strings(v3Assembly)
+// PrintExtensions lists the features outside the levels with the number of instructions that need them.
+func (analysis *Analysis) PrintExtensions() {
+	extensions := analysis.Arch.sortedFeatures(analysis.Features, true)
+	fmt.Println("extensions", len(extensions))
+	for _, extension := range extensions {
+		fmt.Println("    ", extension, analysis.Features[extension])
+	}
+	fmt.Println()
 }
 
-func PickRegisterName(operand string) string {
-	if findRegisterExpressionError != nil {
-		panic(findRegisterExpressionError)
+// PrintAVX10 tells whether the EVEX instructions run on AVX10/256 processors, which
+// have all of AVX-512 at 128 and 256 bits but no 512-bit vectors, and which functions
+// keep them from it. Verbose output lists the functions.
+func (analysis *Analysis) PrintAVX10() {
+	var missing []string
+	for _, feature := range xeonPhiFeatures {
+		if analysis.Features[feature] > 0 {
+			missing = append(missing, feature)
+		}
 	}
 
-	result := findRegisterExpression.FindStringSubmatch(operand)
-	if len(result) == 1 {
-		return result[0]
+	functions := make([]string, 0, len(analysis.Wide))
+	for function := range analysis.Wide {
+		functions = append(functions, function)
+	}
+	sort.Strings(functions)
+
+	switch {
+	case len(missing) > 0:
+		fmt.Println("AVX10/256: no, AVX10 lacks", strings.Join(missing, " "))
+	case len(functions) > 0:
+		fmt.Printf("AVX10/256: no, %d functions use 512-bit vectors\n", len(functions))
+	default:
+		fmt.Println("AVX10/256: yes, no EVEX instruction uses 512-bit vectors")
 	}
 
-	return operand
+	if analysis.Verbose {
+		for _, function := range functions {
+			fmt.Printf("    %s %d\n", function, analysis.Wide[function])
+		}
+	}
 }
 
-var avxRegisters = []string{
-	"Y0", "Y1", "Y2", "Y3", "Y4", "Y5", "Y6", "Y7", "Y8", "Y9", "Y10", "Y11", "Y12", "Y13", "Y14", "Y15", "Y16", "Y17", "Y18", "Y19", "Y20", "Y21", "Y22", "Y23", "Y24", "Y25", "Y26", "Y28", "Y29", "Y30", "Y31",
+// PrintISANote compares the ISA level in the ELF note with the detected one.
+func (analysis *Analysis) PrintISANote() {
+	if analysis.Verbose {
+		if analysis.ISANeeded != 0 {
+			fmt.Printf("GNU_PROPERTY_X86_ISA_1_NEEDED=%s\n", isaLevel(analysis.ISANeeded))
+		}
+		if analysis.ISAUsed != 0 {
+			fmt.Printf("GNU_PROPERTY_X86_ISA_1_USED=%s\n", isaLevel(analysis.ISAUsed))
+		}
+	}
+
+	if mismatch := analysis.isaMismatch(); mismatch != "" {
+		fmt.Println(mismatch)
+	}
 }
 
-var avx512Registers = []string{
-	"Z0", "Z1", "Z2", "Z3", "Z4", "Z5", "Z6", "Z7", "Z8", "Z9", "Z10", "Z11", "Z12", "Z13", "Z14", "Z15", "Z16", "Z17", "Z18", "Z19", "Z20", "Z21", "Z22", "Z23", "Z24", "Z25", "Z26", "Z28", "Z29", "Z30", "Z31",
+// isaMismatch tells how the level of GNU_PROPERTY_X86_ISA_1_NEEDED differs from the detected
+// one, or returns "" when it doesn't. A note with only ISA_1_USED needs nothing to compare.
+func (analysis *Analysis) isaMismatch() string {
+	if analysis.ISANeeded == 0 {
+		return ""
+	}
+
+	needed := isaLevel(analysis.ISANeeded)
+	switch {
+	case needed < analysis.Mode:
+		return fmt.Sprintf("Mismatch: the ISA note needs %s but the instructions need %s", needed, analysis.Mode)
+	case needed > analysis.Mode:
+		return fmt.Sprintf("Mismatch: the ISA note needs %s but the instructions only need %s", needed, analysis.Mode)
+	}
+
+	return ""
 }
 
-func contains(collection []string, token string) bool {
-	idx := sort.SearchStrings(collection, token)
-	return idx != len(collection) && idx >= 0 && collection[idx] == token
+// PrintFunctions lists the functions that need more than the baseline, with their level,
+// and marks those that use 512-bit EVEX vectors.
+func (analysis *Analysis) PrintFunctions() {
+	functions := make([]string, 0, len(analysis.Functions))
+	for function, mode := range analysis.Functions {
+		if mode > v1 {
+			functions = append(functions, function)
+		}
+	}
+
+	sort.Strings(functions)
+	for _, function := range functions {
+		if analysis.Wide[function] > 0 {
+			fmt.Printf("    %s %s 512-bit\n", analysis.Arch.LevelName(analysis.Functions[function]), function)
+		} else {
+			fmt.Printf("    %s %s\n", analysis.Arch.LevelName(analysis.Functions[function]), function)
+		}
+	}
+	fmt.Println()
 }
 
 func main() {
@@ -1824,174 +435,138 @@ func main() {
 	flag.BoolVar(&printStatistics, "s", false, "Print statistics")
 
 	var inputFileName string
-	flag.StringVar(&inputFileName, "input", "", "Input file name")
-	flag.StringVar(&inputFileName, "i", "", "Input file name")
+	flag.StringVar(&inputFileName, "input", "", "Input file or directory name")
+	flag.StringVar(&inputFileName, "i", "", "Input file or directory name")
+
+	var format string
+	flag.StringVar(&format, "format", "auto", "Input format: auto, binary (executable or Go archive), go (go tool objdump), att (objdump -d), intel (objdump -M intel), compile (go build -gcflags=-S), asm (Go assembly source), hex or raw (machine code)")
+
+	var functions bool
+	flag.BoolVar(&functions, "functions", false, "List the functions that need more than the baseline, v1 or v8.0")
+
+	var listing bool
+	flag.BoolVar(&listing, "list", false, "List every decoded instruction with its address and level")
+
+	var base string
+	flag.StringVar(&base, "base", "0", "Address of the first byte for -format hex and raw")
+
+	var bits int
+	flag.IntVar(&bits, "bits", 64, "Decode -format hex and raw as 64-bit (x86-64) or 32-bit (i386) code, 32 also reads disassembly as GOARCH=386 code, which is detected in go tool objdump output")
+
+	var arch string
+	flag.StringVar(&arch, "arch", "auto", "Architecture of disassembly and of -format hex and raw: auto, amd64 or arm64; executables name their own")
+
+	var buildFlags string
+	flag.StringVar(&buildFlags, "buildflags", "", "Flags for go build when a package is given, such as \"-tags netgo -trimpath\"")
+
+	var deps bool
+	flag.BoolVar(&deps, "deps", false, "Analyze an ELF executable together with the shared libraries it loads")
+
+	var sysroot string
+	flag.StringVar(&sysroot, "sysroot", "/", "Root directory for the default library directories with -deps")
+
+	var libraryPath string
+	flag.StringVar(&libraryPath, "libpath", "", "Colon-separated library directories searched before DT_RUNPATH with -deps")
+
+	var writeNote string
+	flag.StringVar(&writeNote, "write-note", "", "Write a copy of the Go executable input to this file with a GNU_PROPERTY_X86_ISA_1_NEEDED note for its GOAMD64 setting")
+
+	var hwcaps string
+	flag.StringVar(&hwcaps, "hwcaps", "", "Lay out the shared object builds given as level=path arguments below this library directory in glibc-hwcaps subdirectories")
+
+	var binary bool
+	flag.BoolVar(&binary, "binary", false, "Same as -format binary")
 
 	flag.Parse()
 
-	var globalMode AssemblyMode = na
-	var operations = []int{0, 0, 0, 0}
-	var v1Count map[string]int = make(map[string]int)
-	var v2Count map[string]int = make(map[string]int)
-	var v3Count map[string]int = make(map[string]int)
-	var v4Count map[string]int = make(map[string]int)
-	var scanner *bufio.Scanner
-	if inputFileName != "" {
-		reader, openErr := os.Open(inputFileName)
-		if openErr != nil {
-			log.Printf("Failed opening file %s\n", inputFileName)
-			log.Panicln(openErr)
+	if binary {
+		format = "binary"
+	}
+
+	address, err := strconv.ParseUint(base, 0, 64)
+	if err != nil {
+		log.Printf("Invalid base address %s\n", base)
+		log.Panicln(err)
+	}
+
+	options := Options{Format: format, Base: address, Bits: bits, Arch: arch, BuildFlags: strings.Fields(buildFlags)}
+	if hwcaps != "" {
+		if err := layoutHwcaps(hwcaps, flag.Args(), options, verbose); err != nil {
+			log.Panicln(err)
 		}
+		return
+	}
 
-		defer reader.Close()
-		scanner = bufio.NewScanner(reader)
-	} else {
-		scanner = bufio.NewScanner(os.Stdin)
+	paths := flag.Args()
+	if info, err := os.Stat(inputFileName); err == nil && info.IsDir() {
+		paths = append([]string{inputFileName}, paths...)
+		inputFileName = ""
 	}
 
-	var context string = ""
-	for scanner.Scan() {
-		var mode AssemblyMode = na
+	// a single file or package gets the full report, more than that the summary table
+	if len(paths) == 1 && isGoPackage(paths[0]) {
+		output, cleanup, err := buildPackage(paths[0], options.BuildFlags)
+		if err != nil {
+			log.Fatalf("Failed building package %s: %v\n", paths[0], err)
+		}
 
-		text := scanner.Text()
-		if len(text) > 4 && text[:4] == "TEXT" {
-			context = text[5:]
-		} else {
-			tokens := strings.Fields(text)
-			var function string
-			var instruction string
-			for i, token := range tokens {
-				if i == 0 {
-					function = token
-				}
-
-				if contains(x86SixyFourAssembly, token) && mode != v4 && mode != v3 && mode != v2 {
-					mode = v1
-					instruction = token
-				}
-
-				if contains(v2Assembly, token) && mode != v4 && mode != v3 {
-					mode = v2
-					if verbose {
-						fmt.Println("Found v2 instruction", token, "in function", function, context)
-					}
-					instruction = token
-
-				}
-
-				if contains(v3Assembly, token) && mode != v4 {
-					mode = v3
-					if contains(detectModeByRegisters, token) {
-						var registerOneRaw = strings.TrimRight(tokens[i+1], ",")
-						var registerTwoRaw = strings.TrimRight(tokens[i+2], ",")
-						var registerOne = PickRegisterName(registerOneRaw)
-						var registerTwo = PickRegisterName(registerTwoRaw)
-						if contains(avxRegisters, registerOne) || contains(avxRegisters, registerTwo) {
-							mode = v3
-						} else {
-							mode = v2
-						}
-					} else {
-						mode = v3
-					}
-
-					if verbose {
-						fmt.Println("Found v3 instruction", token, "in function", function, context)
-					}
-					instruction = token
-				}
-
-				if contains(v4Assembly, token) {
-					if contains(detectModeByRegisters, token) {
-						var registerOneRaw = strings.TrimRight(tokens[i+1], ",")
-						var registerTwoRaw = strings.TrimRight(tokens[i+2], ",")
-						var registerOne = PickRegisterName(registerOneRaw)
-						var registerTwo = PickRegisterName(registerTwoRaw)
-						if contains(avx512Registers, registerOne) || contains(avx512Registers, registerTwo) {
-							mode = v4
-						} else if contains(avxRegisters, registerOne) || contains(avxRegisters, registerTwo) {
-							mode = v3
-						} else {
-							mode = v2
-						}
-					} else {
-						mode = v4
-					}
-
-					if verbose {
-						fmt.Println("Found v4 instruction", token, "in function", function, context)
-					}
-					instruction = token
-				}
-			}
+		defer cleanup()
+		inputFileName, paths = output, nil
+	} else if len(paths) == 1 {
+		if info, err := os.Stat(paths[0]); err == nil && info.Mode().IsRegular() {
+			inputFileName, paths = paths[0], nil
+		}
+	}
 
-			if mode == v1 {
-				operations[0]++
-				if val, ok := v1Count[instruction]; ok {
-					v1Count[instruction] = val + 1
-				} else {
-					v1Count[instruction] = 1
-				}
-			} else if mode == v2 {
-				operations[1]++
-				if val, ok := v2Count[instruction]; ok {
-					v2Count[instruction] = val + 1
-				} else {
-					v2Count[instruction] = 1
-				}
-			} else if mode == v3 {
-				operations[2]++
-				if val, ok := v3Count[instruction]; ok {
-					v3Count[instruction] = val + 1
-				} else {
-					v3Count[instruction] = 1
-				}
-			} else if mode == v4 {
-				operations[3]++
-				if val, ok := v4Count[instruction]; ok {
-					v4Count[instruction] = val + 1
-				} else {
-					v4Count[instruction] = 1
-				}
-			}
+	if deps {
+		if inputFileName == "" || len(paths) > 0 {
+			log.Panicln("-deps needs a single executable or package")
 		}
 
-		globalMode = AssemblyMode(math.Max(float64(mode), float64(globalMode)))
+		analyzeLoadSet(inputFileName, sysroot, filepath.SplitList(libraryPath), options, verbose)
+		return
 	}
 
-	if err := scanner.Err(); err != nil {
-		log.Println(err)
+	if len(paths) > 0 {
+		printSummary(analyzePaths(paths, options, verbose))
+		return
 	}
 
-	if printStatistics {
-		fmt.Println("x86", operations[0])
-		if extended {
-			printSorted(v1Count)
+	var analysis = NewAnalysis(verbose)
+	analysis.Listing = listing
+	var input io.Reader = os.Stdin
+	if inputFileName != "" {
+		reader, openErr := os.Open(inputFileName)
+		if openErr != nil {
+			log.Printf("Failed opening file %s\n", inputFileName)
+			log.Panicln(openErr)
 		}
-		fmt.Println()
 
-		fmt.Println("v2", operations[1])
-		if extended {
-			printSorted(v2Count)
-		}
-		fmt.Println()
+		defer reader.Close()
+		input = reader
+	}
+
+	if err := analyzeInput(input, options, analysis); err != nil {
+		log.Println(err)
+	}
+
+	if functions {
+		analysis.PrintFunctions()
+	}
+	analysis.Print(printStatistics, extended)
 
-		fmt.Println("v3", operations[2])
-		if extended {
-			printSorted(v3Count)
+	if writeNote != "" {
+		if inputFileName == "" {
+			log.Panicln("-write-note needs an input file")
 		}
-		fmt.Println()
 
-		fmt.Println("v4", operations[3])
-		if extended {
-			printSorted(v4Count)
+		if analysis.Arch != amd64Arch {
+			log.Panicln("-write-note writes an x86 ISA note, the input is", analysis.Arch.Name)
 		}
-		fmt.Println()
-	}
 
-	if verbose {
-		fmt.Printf("Minimum required GOAMD64=v%d\n", int(globalMode))
-	} else {
-		fmt.Printf("sGOAMD64=v%d\n", int(globalMode))
+		if err := writeISANote(inputFileName, writeNote, analysis.Mode); err != nil {
+			log.Printf("Failed writing %s\n", writeNote)
+			log.Panicln(err)
+		}
 	}
 }
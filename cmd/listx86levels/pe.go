@@ -0,0 +1,65 @@
+package main
+
+import (
+	"debug/pe"
+	"fmt"
+	"io"
+)
+
+// analyzePE decodes the code sections of a PE executable, such as a GOOS=windows build.
+func analyzePE(r io.ReaderAt, analysis *Analysis) error {
+	file, err := pe.NewFile(r)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var bits int
+	var imageBase uint64
+	switch file.Machine {
+	case pe.IMAGE_FILE_MACHINE_AMD64:
+		bits = 64
+	case pe.IMAGE_FILE_MACHINE_I386:
+		bits = 32
+	default:
+		return fmt.Errorf("unsupported PE machine %#x", file.Machine)
+	}
+
+	switch header := file.OptionalHeader.(type) {
+	case *pe.OptionalHeader64:
+		imageBase = header.ImageBase
+	case *pe.OptionalHeader32:
+		imageBase = uint64(header.ImageBase)
+	}
+
+	for i, section := range file.Sections {
+		if section.Characteristics&(pe.IMAGE_SCN_CNT_CODE|pe.IMAGE_SCN_MEM_EXECUTE) == 0 {
+			continue
+		}
+
+		code, err := section.Data()
+		if err != nil {
+			return fmt.Errorf("reading section %s: %w", section.Name, err)
+		}
+
+		// the raw data is padded to the file alignment
+		if section.VirtualSize != 0 && int(section.VirtualSize) < len(code) {
+			code = code[:section.VirtualSize]
+		}
+
+		addr := imageBase + uint64(section.VirtualAddress)
+		var symbols []symbol
+		for _, s := range file.Symbols {
+			// COFF section numbers start at 1
+			if int(s.SectionNumber) == i+1 {
+				symbols = append(symbols, symbol{Name: s.Name, Addr: addr + uint64(s.Value)})
+			}
+		}
+		sortSymbols(symbols)
+
+		skip := goBuildIDLength(code)
+		decodeX86(code[skip:], addr+uint64(skip), symbols, bits, analysis)
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package main
+
+import "testing"
+
+func TestAnalyzePE(t *testing.T) {
+	// b is 0x62, which starts EVEX instructions
+	path := buildExecutable(t, "GOOS=windows", "GOAMD64=v1", "GOFLAGS=-ldflags=-buildid=bbbbbbbbbbbbbbbb")
+	result := analyzeFile(path, Options{Format: "auto", Arch: "auto"}, false)
+	if result.Err != nil {
+		t.Fatal(result.Err)
+	}
+
+	// the build ID and padding come before runtime.text
+	for _, function := range []string{"go:buildid", "runtime.text"} {
+		if mode := result.Analysis.Functions[function]; mode > v1 {
+			t.Errorf("%s needs %s, the build ID was decoded", function, mode)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Assembler directives that do not produce instructions.
+var plan9Directives = []string{
+	"BYTE",
+	"DATA",
+	"FUNCDATA",
+	"GLOBL",
+	"LONG",
+	"NO_LOCAL_POINTERS",
+	"PCALIGN",
+	"PCDATA",
+	"QUAD",
+	"WORD",
+}
+
+// loop: or ·done:
+var plan9Label = regexp.MustCompile(`^[\pL_·∕][\pL\pN_·∕]*:\s*`)
+
+var plan9Word = regexp.MustCompile(`[\pL_·∕][\pL\pN_·∕]*`)
+
+// #define NAME(a, b) body or #define NAME body
+var plan9Define = regexp.MustCompile(`^#\s*define\s+([\pL_][\pL\pN_]*)(\(([^)]*)\))?\s*(.*)$`)
+
+var plan9Undef = regexp.MustCompile(`^#\s*undef\s+([\pL_][\pL\pN_]*)`)
+
+// Macros are expanded to this depth, which is enough for the nesting seen in the standard library.
+const plan9MacroDepth = 16
+
+type plan9Macro struct {
+	params   []string
+	body     string
+	function bool
+}
+
+// plan9Parser reads hand-written Go assembly, *_amd64.s files. It expands
+// #define macros, skips data directives and uses TEXT symbols as function context.
+// #ifdef and #include are not evaluated, so both branches of a conditional count.
+type plan9Parser struct {
+	macros  map[string]plan9Macro
+	pending string
+	comment bool
+	line    int
+}
+
+func newPlan9Parser() *plan9Parser {
+	return &plan9Parser{macros: make(map[string]plan9Macro)}
+}
+
+// stripComments removes // and /* */ comments, which may span lines.
+func (parser *plan9Parser) stripComments(text string) string {
+	var result strings.Builder
+	for text != "" {
+		if parser.comment {
+			end := strings.Index(text, "*/")
+			if end < 0 {
+				return result.String()
+			}
+			text = text[end+2:]
+			parser.comment = false
+			continue
+		}
+
+		line := strings.Index(text, "//")
+		block := strings.Index(text, "/*")
+		switch {
+		case line >= 0 && (block < 0 || line < block):
+			result.WriteString(text[:line])
+			return result.String()
+		case block >= 0:
+			result.WriteString(text[:block])
+			result.WriteString(" ")
+			text = text[block+2:]
+			parser.comment = true
+		default:
+			result.WriteString(text)
+			text = ""
+		}
+	}
+
+	return result.String()
+}
+
+func (parser *plan9Parser) directive(text string) {
+	if match := plan9Define.FindStringSubmatch(text); match != nil {
+		macro := plan9Macro{body: match[4], function: match[2] != ""}
+		for _, param := range strings.Split(match[3], ",") {
+			if param = strings.TrimSpace(param); param != "" {
+				macro.params = append(macro.params, param)
+			}
+		}
+		parser.macros[match[1]] = macro
+	} else if match := plan9Undef.FindStringSubmatch(text); match != nil {
+		delete(parser.macros, match[1])
+	}
+}
+
+// macroArguments splits the arguments of a macro call that starts at text[0] == '('.
+// It returns them and the length of the call including the parentheses.
+func macroArguments(text string) ([]string, int) {
+	depth := 0
+	start := 1
+	var args []string
+	for i, c := range text {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(text[start:i]))
+				return args, i + 1
+			}
+		case ',':
+			if depth == 1 {
+				args = append(args, strings.TrimSpace(text[start:i]))
+				start = i + 1
+			}
+		}
+	}
+
+	return nil, -1
+}
+
+// expandWords replaces every macro name in text with its body, calling function macros with their arguments.
+func (parser *plan9Parser) expandWords(text string) string {
+	var result strings.Builder
+	for {
+		location := plan9Word.FindStringIndex(text)
+		if location == nil {
+			result.WriteString(text)
+			return result.String()
+		}
+
+		result.WriteString(text[:location[0]])
+		word := text[location[0]:location[1]]
+		text = text[location[1]:]
+		macro, ok := parser.macros[word]
+		switch {
+		case !ok:
+			result.WriteString(word)
+		case !macro.function:
+			result.WriteString(macro.body)
+		case strings.HasPrefix(text, "("):
+			args, length := macroArguments(text)
+			if length < 0 {
+				result.WriteString(word)
+				continue
+			}
+
+			body := macro.body
+			if len(macro.params) > 0 {
+				body = plan9Word.ReplaceAllStringFunc(body, func(name string) string {
+					for i, param := range macro.params {
+						if name == param && i < len(args) {
+							return args[i]
+						}
+					}
+					return name
+				})
+			}
+			result.WriteString(body)
+			text = text[length:]
+		default:
+			result.WriteString(word)
+		}
+	}
+}
+
+func (parser *plan9Parser) statements(text string, depth int) []string {
+	var result []string
+	for _, statement := range strings.Split(text, ";") {
+		statement = strings.TrimSpace(plan9Label.ReplaceAllString(strings.TrimSpace(statement), ""))
+		if statement == "" {
+			continue
+		}
+
+		expanded := parser.expandWords(statement)
+		if expanded != statement && depth < plan9MacroDepth {
+			result = append(result, parser.statements(expanded, depth+1)...)
+		} else {
+			result = append(result, statement)
+		}
+	}
+
+	return result
+}
+
+func (parser *plan9Parser) parse(text string, context *string) [][]string {
+	parser.line++
+	text = strings.TrimSpace(parser.stripComments(text))
+	if strings.HasSuffix(text, `\`) {
+		parser.pending += strings.TrimSuffix(text, `\`) + " "
+		return nil
+	}
+
+	text = strings.TrimSpace(parser.pending + text)
+	parser.pending = ""
+	if strings.HasPrefix(text, "#") {
+		parser.directive(text)
+		return nil
+	}
+
+	var instructions [][]string
+	position := strconv.Itoa(parser.line)
+	for _, statement := range parser.statements(text, 0) {
+		fields := strings.Fields(statement)
+		if fields[0] == "TEXT" {
+			if len(fields) > 1 {
+				*context = strings.TrimSuffix(fields[1], ",")
+				if i := strings.Index(*context, "("); i > 0 {
+					*context = (*context)[:i]
+				}
+			}
+			continue
+		}
+
+		if contains(plan9Directives, fields[0]) {
+			continue
+		}
+
+		fields[0] = normalizeMnemonic(fields[0])
+		instructions = append(instructions, append([]string{position}, fields...))
+	}
+
+	return instructions
+}
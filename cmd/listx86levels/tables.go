@@ -0,0 +1,5551 @@
+// Code generated by gentables.go from x86.v0.2.csv, x86.csv and XED; DO NOT EDIT.
+
+package main
+
+// x86Instructions lists every instruction form in x86.v0.2.csv, x86.csv and the XED data of x86avxgen,
+// and the newer forms in gentables.go.
+var x86Instructions = []Instruction{
+	{Intel: "AAA", Go: "AAA", CPUID: "", Encoding: "37", Operands: ""},
+	{Intel: "AAD", Go: "AAD", CPUID: "", Encoding: "D5 0A", Operands: ""},
+	{Intel: "AAD", Go: "AAD", CPUID: "", Encoding: "D5 ib", Operands: "imm8"},
+	{Intel: "AAM", Go: "AAM", CPUID: "", Encoding: "D4 0A", Operands: ""},
+	{Intel: "AAM", Go: "AAM", CPUID: "", Encoding: "D4 ib", Operands: "imm8"},
+	{Intel: "AAS", Go: "AAS", CPUID: "", Encoding: "3F", Operands: ""},
+	{Intel: "ADC", Go: "ADCB", CPUID: "", Encoding: "14 ib", Operands: "AL, imm8"},
+	{Intel: "ADC", Go: "ADCW", CPUID: "", Encoding: "15 iw", Operands: "AX, imm16"},
+	{Intel: "ADC", Go: "ADCL", CPUID: "", Encoding: "15 id", Operands: "EAX, imm32"},
+	{Intel: "ADC", Go: "ADCQ", CPUID: "", Encoding: "REX.W 15 id", Operands: "RAX, imm32"},
+	{Intel: "ADC", Go: "ADCW", CPUID: "", Encoding: "81 /2 iw", Operands: "r/m16, imm16"},
+	{Intel: "ADC", Go: "ADCW", CPUID: "", Encoding: "83 /2 ib", Operands: "r/m16, imm8"},
+	{Intel: "ADC", Go: "ADCW", CPUID: "", Encoding: "11 /r", Operands: "r/m16, r16"},
+	{Intel: "ADC", Go: "ADCL", CPUID: "", Encoding: "81 /2 id", Operands: "r/m32, imm32"},
+	{Intel: "ADC", Go: "ADCL", CPUID: "", Encoding: "83 /2 ib", Operands: "r/m32, imm8"},
+	{Intel: "ADC", Go: "ADCL", CPUID: "", Encoding: "11 /r", Operands: "r/m32, r32"},
+	{Intel: "ADC", Go: "ADCQ", CPUID: "", Encoding: "REX.W 81 /2 id", Operands: "r/m64, imm32"},
+	{Intel: "ADC", Go: "ADCQ", CPUID: "", Encoding: "REX.W 83 /2 ib", Operands: "r/m64, imm8"},
+	{Intel: "ADC", Go: "ADCQ", CPUID: "", Encoding: "REX.W 11 /r", Operands: "r/m64, r64"},
+	{Intel: "ADC", Go: "ADCB", CPUID: "", Encoding: "80 /2 ib", Operands: "r/m8, imm8"},
+	{Intel: "ADC", Go: "ADCB", CPUID: "", Encoding: "REX 80 /2 ib", Operands: "r/m8, imm8"},
+	{Intel: "ADC", Go: "ADCB", CPUID: "", Encoding: "10 /r", Operands: "r/m8, r8"},
+	{Intel: "ADC", Go: "ADCB", CPUID: "", Encoding: "REX 10 /r", Operands: "r/m8, r8"},
+	{Intel: "ADC", Go: "ADCW", CPUID: "", Encoding: "13 /r", Operands: "r16, r/m16"},
+	{Intel: "ADC", Go: "ADCL", CPUID: "", Encoding: "13 /r", Operands: "r32, r/m32"},
+	{Intel: "ADC", Go: "ADCQ", CPUID: "", Encoding: "REX.W 13 /r", Operands: "r64, r/m64"},
+	{Intel: "ADC", Go: "ADCB", CPUID: "", Encoding: "12 /r", Operands: "r8, r/m8"},
+	{Intel: "ADC", Go: "ADCB", CPUID: "", Encoding: "REX 12 /r", Operands: "r8, r/m8"},
+	{Intel: "ADCX", Go: "ADCXL", CPUID: "ADX", Encoding: "66 0F 38 F6 /r", Operands: "r32, r/m32"},
+	{Intel: "ADCX", Go: "ADCXQ", CPUID: "ADX", Encoding: "66 REX.W 0F 38 F6 /r", Operands: "r64, r/m64"},
+	{Intel: "ADD", Go: "ADDB", CPUID: "", Encoding: "04 ib", Operands: "AL, imm8"},
+	{Intel: "ADD", Go: "ADDW", CPUID: "", Encoding: "05 iw", Operands: "AX, imm16"},
+	{Intel: "ADD", Go: "ADDL", CPUID: "", Encoding: "05 id", Operands: "EAX, imm32"},
+	{Intel: "ADD", Go: "ADDQ", CPUID: "", Encoding: "REX.W 05 id", Operands: "RAX, imm32"},
+	{Intel: "ADD", Go: "ADDW", CPUID: "", Encoding: "81 /0 iw", Operands: "r/m16, imm16"},
+	{Intel: "ADD", Go: "ADDW", CPUID: "", Encoding: "83 /0 ib", Operands: "r/m16, imm8"},
+	{Intel: "ADD", Go: "ADDW", CPUID: "", Encoding: "01 /r", Operands: "r/m16, r16"},
+	{Intel: "ADD", Go: "ADDL", CPUID: "", Encoding: "81 /0 id", Operands: "r/m32, imm32"},
+	{Intel: "ADD", Go: "ADDL", CPUID: "", Encoding: "83 /0 ib", Operands: "r/m32, imm8"},
+	{Intel: "ADD", Go: "ADDL", CPUID: "", Encoding: "01 /r", Operands: "r/m32, r32"},
+	{Intel: "ADD", Go: "ADDQ", CPUID: "", Encoding: "REX.W 81 /0 id", Operands: "r/m64, imm32"},
+	{Intel: "ADD", Go: "ADDQ", CPUID: "", Encoding: "REX.W 83 /0 ib", Operands: "r/m64, imm8"},
+	{Intel: "ADD", Go: "ADDQ", CPUID: "", Encoding: "REX.W 01 /r", Operands: "r/m64, r64"},
+	{Intel: "ADD", Go: "ADDB", CPUID: "", Encoding: "80 /0 ib", Operands: "r/m8, imm8"},
+	{Intel: "ADD", Go: "ADDB", CPUID: "", Encoding: "REX 80 /0 ib", Operands: "r/m8, imm8"},
+	{Intel: "ADD", Go: "ADDB", CPUID: "", Encoding: "00 /r", Operands: "r/m8, r8"},
+	{Intel: "ADD", Go: "ADDB", CPUID: "", Encoding: "REX 00 /r", Operands: "r/m8, r8"},
+	{Intel: "ADD", Go: "ADDW", CPUID: "", Encoding: "03 /r", Operands: "r16, r/m16"},
+	{Intel: "ADD", Go: "ADDL", CPUID: "", Encoding: "03 /r", Operands: "r32, r/m32"},
+	{Intel: "ADD", Go: "ADDQ", CPUID: "", Encoding: "REX.W 03 /r", Operands: "r64, r/m64"},
+	{Intel: "ADD", Go: "ADDB", CPUID: "", Encoding: "02 /r", Operands: "r8, r/m8"},
+	{Intel: "ADD", Go: "ADDB", CPUID: "", Encoding: "REX 02 /r", Operands: "r8, r/m8"},
+	{Intel: "ADDPD", Go: "ADDPD", CPUID: "SSE2", Encoding: "66 0F 58 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "ADDPS", Go: "ADDPS", CPUID: "SSE", Encoding: "0F 58 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "ADDSD", Go: "ADDSD", CPUID: "SSE2", Encoding: "F2 0F 58 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "ADDSS", Go: "ADDSS", CPUID: "SSE", Encoding: "F3 0F 58 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "ADDSUBPD", Go: "ADDSUBPD", CPUID: "SSE3", Encoding: "66 0F D0 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "ADDSUBPS", Go: "ADDSUBPS", CPUID: "SSE3", Encoding: "F2 0F D0 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "ADOX", Go: "ADOXL", CPUID: "ADX", Encoding: "F3 0F 38 F6 /r", Operands: "r32, r/m32"},
+	{Intel: "ADOX", Go: "ADOXQ", CPUID: "ADX", Encoding: "F3 REX.W 0F 38 F6 /r", Operands: "r64, r/m64"},
+	{Intel: "AESDEC", Go: "AESDEC", CPUID: "AES", Encoding: "66 0F 38 DE /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "AESDECLAST", Go: "AESDECLAST", CPUID: "AES", Encoding: "66 0F 38 DF /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "AESENC", Go: "AESENC", CPUID: "AES", Encoding: "66 0F 38 DC /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "AESENCLAST", Go: "AESENCLAST", CPUID: "AES", Encoding: "66 0F 38 DD /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "AESIMC", Go: "AESIMC", CPUID: "AES", Encoding: "66 0F 38 DB /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "AESKEYGENASSIST", Go: "AESKEYGENASSIST", CPUID: "AES", Encoding: "66 0F 3A DF /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "AND", Go: "ANDB", CPUID: "", Encoding: "24 ib", Operands: "AL, imm8"},
+	{Intel: "AND", Go: "ANDW", CPUID: "", Encoding: "25 iw", Operands: "AX, imm16"},
+	{Intel: "AND", Go: "ANDL", CPUID: "", Encoding: "25 id", Operands: "EAX, imm32"},
+	{Intel: "AND", Go: "ANDQ", CPUID: "", Encoding: "REX.W 25 id", Operands: "RAX, imm32"},
+	{Intel: "AND", Go: "ANDW", CPUID: "", Encoding: "81 /4 iw", Operands: "r/m16, imm16"},
+	{Intel: "AND", Go: "ANDW", CPUID: "", Encoding: "83 /4 ib", Operands: "r/m16, imm8"},
+	{Intel: "AND", Go: "ANDW", CPUID: "", Encoding: "21 /r", Operands: "r/m16, r16"},
+	{Intel: "AND", Go: "ANDL", CPUID: "", Encoding: "81 /4 id", Operands: "r/m32, imm32"},
+	{Intel: "AND", Go: "ANDL", CPUID: "", Encoding: "83 /4 ib", Operands: "r/m32, imm8"},
+	{Intel: "AND", Go: "ANDL", CPUID: "", Encoding: "21 /r", Operands: "r/m32, r32"},
+	{Intel: "AND", Go: "ANDQ", CPUID: "", Encoding: "REX.W 81 /4 id", Operands: "r/m64, imm32"},
+	{Intel: "AND", Go: "ANDQ", CPUID: "", Encoding: "REX.W 83 /4 ib", Operands: "r/m64, imm8"},
+	{Intel: "AND", Go: "ANDQ", CPUID: "", Encoding: "REX.W 21 /r", Operands: "r/m64, r64"},
+	{Intel: "AND", Go: "ANDB", CPUID: "", Encoding: "80 /4 ib", Operands: "r/m8, imm8"},
+	{Intel: "AND", Go: "ANDB", CPUID: "", Encoding: "REX 80 /4 ib", Operands: "r/m8, imm8"},
+	{Intel: "AND", Go: "ANDB", CPUID: "", Encoding: "20 /r", Operands: "r/m8, r8"},
+	{Intel: "AND", Go: "ANDB", CPUID: "", Encoding: "REX 20 /r", Operands: "r/m8, r8"},
+	{Intel: "AND", Go: "ANDW", CPUID: "", Encoding: "23 /r", Operands: "r16, r/m16"},
+	{Intel: "AND", Go: "ANDL", CPUID: "", Encoding: "23 /r", Operands: "r32, r/m32"},
+	{Intel: "AND", Go: "ANDQ", CPUID: "", Encoding: "REX.W 23 /r", Operands: "r64, r/m64"},
+	{Intel: "AND", Go: "ANDB", CPUID: "", Encoding: "22 /r", Operands: "r8, r/m8"},
+	{Intel: "AND", Go: "ANDB", CPUID: "", Encoding: "REX 22 /r", Operands: "r8, r/m8"},
+	{Intel: "ANDN", Go: "ANDNL", CPUID: "BMI1", Encoding: "VEX.NDS.LZ.0F38.W0 F2 /r", Operands: "r32, r32V, r/m32"},
+	{Intel: "ANDN", Go: "ANDNQ", CPUID: "BMI1", Encoding: "VEX.NDS.LZ.0F38.W1 F2 /r", Operands: "r64, r64V, r/m64"},
+	{Intel: "ANDNPD", Go: "ANDNPD", CPUID: "SSE2", Encoding: "66 0F 55 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "ANDNPS", Go: "ANDNPS", CPUID: "SSE", Encoding: "0F 55 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "ANDPD", Go: "ANDPD", CPUID: "SSE2", Encoding: "66 0F 54 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "ANDPS", Go: "ANDPS", CPUID: "SSE", Encoding: "0F 54 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "ARPL", Go: "ARPL", CPUID: "", Encoding: "63 /r", Operands: "r/m16, r16"},
+	{Intel: "BEXTR", Go: "BEXTRL", CPUID: "BMI1", Encoding: "VEX.NDS.LZ.0F38.W0 F7 /r", Operands: "r32, r/m32, r32V"},
+	{Intel: "BEXTR", Go: "BEXTRQ", CPUID: "BMI1", Encoding: "VEX.NDS.LZ.0F38.W1 F7 /r", Operands: "r64, r/m64, r64V"},
+	{Intel: "BLENDPD", Go: "BLENDPD", CPUID: "SSE4_1", Encoding: "66 0F 3A 0D /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "BLENDPS", Go: "BLENDPS", CPUID: "SSE4_1", Encoding: "66 0F 3A 0C /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "BLENDVPD", Go: "BLENDVPD", CPUID: "SSE4_1", Encoding: "66 0F 38 15 /r", Operands: "xmm1, xmm2/m128, <XMM0>"},
+	{Intel: "BLENDVPS", Go: "BLENDVPS", CPUID: "SSE4_1", Encoding: "66 0F 38 14 /r", Operands: "xmm1, xmm2/m128, <XMM0>"},
+	{Intel: "BLSI", Go: "BLSIL", CPUID: "BMI1", Encoding: "VEX.NDD.LZ.0F38.W0 F3 /3", Operands: "r32V, r/m32"},
+	{Intel: "BLSI", Go: "BLSIQ", CPUID: "BMI1", Encoding: "VEX.NDD.LZ.0F38.W1 F3 /3", Operands: "r64V, r/m64"},
+	{Intel: "BLSMSK", Go: "BLSMSKL", CPUID: "BMI1", Encoding: "VEX.NDD.LZ.0F38.W0 F3 /2", Operands: "r32V, r/m32"},
+	{Intel: "BLSMSK", Go: "BLSMSKQ", CPUID: "BMI1", Encoding: "VEX.NDD.LZ.0F38.W1 F3 /2", Operands: "r64V, r/m64"},
+	{Intel: "BLSR", Go: "BLSRL", CPUID: "BMI1", Encoding: "VEX.NDD.LZ.0F38.W0 F3 /1", Operands: "r32V, r/m32"},
+	{Intel: "BLSR", Go: "BLSRQ", CPUID: "BMI1", Encoding: "VEX.NDD.LZ.0F38.W1 F3 /1", Operands: "r64V, r/m64"},
+	{Intel: "BNDCL", Go: "BNDCL", CPUID: "MPX", Encoding: "F3 0F 1A /r", Operands: "bnd1, r/m32"},
+	{Intel: "BNDCL", Go: "BNDCL", CPUID: "MPX", Encoding: "F3 0F 1A /r", Operands: "bnd1, r/m64"},
+	{Intel: "BNDCN", Go: "BNDCN", CPUID: "MPX", Encoding: "F2 0F 1B /r", Operands: "bnd1, r/m32"},
+	{Intel: "BNDCN", Go: "BNDCN", CPUID: "MPX", Encoding: "F2 0F 1B /r", Operands: "bnd1, r/m64"},
+	{Intel: "BNDCU", Go: "BNDCU", CPUID: "MPX", Encoding: "F2 0F 1A /r", Operands: "bnd1, r/m32"},
+	{Intel: "BNDCU", Go: "BNDCU", CPUID: "MPX", Encoding: "F2 0F 1A /r", Operands: "bnd1, r/m64"},
+	{Intel: "BNDLDX", Go: "BNDLDX", CPUID: "MPX", Encoding: "0F 1A /r", Operands: "bnd1, mib"},
+	{Intel: "BNDMK", Go: "BNDMK", CPUID: "MPX", Encoding: "F3 0F 1B /r", Operands: "bnd1, m32"},
+	{Intel: "BNDMK", Go: "BNDMK", CPUID: "MPX", Encoding: "F3 0F 1B /r", Operands: "bnd1, m64"},
+	{Intel: "BNDMOV", Go: "BNDMOV", CPUID: "MPX", Encoding: "66 0F 1A /r", Operands: "bnd1, bnd2/m128"},
+	{Intel: "BNDMOV", Go: "BNDMOV", CPUID: "MPX", Encoding: "66 0F 1A /r", Operands: "bnd1, bnd2/m64"},
+	{Intel: "BNDMOV", Go: "BNDMOV", CPUID: "MPX", Encoding: "66 0F 1B /r", Operands: "bnd2/m128, bnd1"},
+	{Intel: "BNDMOV", Go: "BNDMOV", CPUID: "MPX", Encoding: "66 0F 1B /r", Operands: "bnd2/m64, bnd1"},
+	{Intel: "BNDSTX", Go: "BNDSTX", CPUID: "MPX", Encoding: "0F 1B /r", Operands: "mib, bnd1"},
+	{Intel: "BOUND", Go: "BOUNDW", CPUID: "", Encoding: "62 /r", Operands: "r16, m16&16"},
+	{Intel: "BOUND", Go: "BOUNDL", CPUID: "", Encoding: "62 /r", Operands: "r32, m32&32"},
+	{Intel: "BSF", Go: "BSFW", CPUID: "", Encoding: "0F BC /r", Operands: "r16, r/m16"},
+	{Intel: "BSF", Go: "BSFL", CPUID: "", Encoding: "0F BC /r", Operands: "r32, r/m32"},
+	{Intel: "BSF", Go: "BSFQ", CPUID: "", Encoding: "REX.W 0F BC /r", Operands: "r64, r/m64"},
+	{Intel: "BSR", Go: "BSRW", CPUID: "", Encoding: "0F BD /r", Operands: "r16, r/m16"},
+	{Intel: "BSR", Go: "BSRL", CPUID: "", Encoding: "0F BD /r", Operands: "r32, r/m32"},
+	{Intel: "BSR", Go: "BSRQ", CPUID: "", Encoding: "REX.W 0F BD /r", Operands: "r64, r/m64"},
+	{Intel: "BSWAP", Go: "BSWAPW", CPUID: "", Encoding: "0F C8+rd", Operands: "r16op"},
+	{Intel: "BSWAP", Go: "BSWAPL", CPUID: "", Encoding: "0F C8+rd", Operands: "r32op"},
+	{Intel: "BSWAP", Go: "BSWAPQ", CPUID: "", Encoding: "REX.W 0F C8+rd", Operands: "r64op"},
+	{Intel: "BT", Go: "BTW", CPUID: "", Encoding: "0F BA /4 ib", Operands: "r/m16, imm8"},
+	{Intel: "BT", Go: "BTW", CPUID: "", Encoding: "0F A3 /r", Operands: "r/m16, r16"},
+	{Intel: "BT", Go: "BTL", CPUID: "", Encoding: "0F BA /4 ib", Operands: "r/m32, imm8"},
+	{Intel: "BT", Go: "BTL", CPUID: "", Encoding: "0F A3 /r", Operands: "r/m32, r32"},
+	{Intel: "BT", Go: "BTQ", CPUID: "", Encoding: "REX.W 0F BA /4 ib", Operands: "r/m64, imm8"},
+	{Intel: "BT", Go: "BTQ", CPUID: "", Encoding: "REX.W 0F A3 /r", Operands: "r/m64, r64"},
+	{Intel: "BTC", Go: "BTCW", CPUID: "", Encoding: "0F BA /7 ib", Operands: "r/m16, imm8"},
+	{Intel: "BTC", Go: "BTCW", CPUID: "", Encoding: "0F BB /r", Operands: "r/m16, r16"},
+	{Intel: "BTC", Go: "BTCL", CPUID: "", Encoding: "0F BA /7 ib", Operands: "r/m32, imm8"},
+	{Intel: "BTC", Go: "BTCL", CPUID: "", Encoding: "0F BB /r", Operands: "r/m32, r32"},
+	{Intel: "BTC", Go: "BTCQ", CPUID: "", Encoding: "REX.W 0F BA /7 ib", Operands: "r/m64, imm8"},
+	{Intel: "BTC", Go: "BTCQ", CPUID: "", Encoding: "REX.W 0F BB /r", Operands: "r/m64, r64"},
+	{Intel: "BTR", Go: "BTRW", CPUID: "", Encoding: "0F BA /6 ib", Operands: "r/m16, imm8"},
+	{Intel: "BTR", Go: "BTRW", CPUID: "", Encoding: "0F B3 /r", Operands: "r/m16, r16"},
+	{Intel: "BTR", Go: "BTRL", CPUID: "", Encoding: "0F BA /6 ib", Operands: "r/m32, imm8"},
+	{Intel: "BTR", Go: "BTRL", CPUID: "", Encoding: "0F B3 /r", Operands: "r/m32, r32"},
+	{Intel: "BTR", Go: "BTRQ", CPUID: "", Encoding: "REX.W 0F BA /6 ib", Operands: "r/m64, imm8"},
+	{Intel: "BTR", Go: "BTRQ", CPUID: "", Encoding: "REX.W 0F B3 /r", Operands: "r/m64, r64"},
+	{Intel: "BTS", Go: "BTSW", CPUID: "", Encoding: "0F BA /5 ib", Operands: "r/m16, imm8"},
+	{Intel: "BTS", Go: "BTSW", CPUID: "", Encoding: "0F AB /r", Operands: "r/m16, r16"},
+	{Intel: "BTS", Go: "BTSL", CPUID: "", Encoding: "0F BA /5 ib", Operands: "r/m32, imm8"},
+	{Intel: "BTS", Go: "BTSL", CPUID: "", Encoding: "0F AB /r", Operands: "r/m32, r32"},
+	{Intel: "BTS", Go: "BTSQ", CPUID: "", Encoding: "REX.W 0F BA /5 ib", Operands: "r/m64, imm8"},
+	{Intel: "BTS", Go: "BTSQ", CPUID: "", Encoding: "REX.W 0F AB /r", Operands: "r/m64, r64"},
+	{Intel: "BZHI", Go: "BZHIL", CPUID: "BMI2", Encoding: "VEX.NDS.LZ.0F38.W0 F5 /r", Operands: "r32, r/m32, r32V"},
+	{Intel: "BZHI", Go: "BZHIQ", CPUID: "BMI2", Encoding: "VEX.NDS.LZ.0F38.W1 F5 /r", Operands: "r64, r/m64, r64V"},
+	{Intel: "CALL", Go: "CALLW", CPUID: "", Encoding: "FF /2", Operands: "r/m16"},
+	{Intel: "CALL", Go: "CALLL", CPUID: "", Encoding: "FF /2", Operands: "r/m32"},
+	{Intel: "CALL", Go: "CALLQ", CPUID: "", Encoding: "FF /2", Operands: "r/m64"},
+	{Intel: "CALL", Go: "CALL", CPUID: "", Encoding: "E8 cw", Operands: "rel16"},
+	{Intel: "CALL", Go: "CALL", CPUID: "", Encoding: "E8 cd", Operands: "rel32"},
+	{Intel: "CALL_FAR", Go: "LCALLW", CPUID: "", Encoding: "FF /3", Operands: "m16:16"},
+	{Intel: "CALL_FAR", Go: "LCALLL", CPUID: "", Encoding: "FF /3", Operands: "m16:32"},
+	{Intel: "CALL_FAR", Go: "LCALLQ", CPUID: "", Encoding: "REX.W FF /3", Operands: "m16:64"},
+	{Intel: "CALL_FAR", Go: "LCALLW", CPUID: "", Encoding: "9A cd", Operands: "ptr16:16"},
+	{Intel: "CALL_FAR", Go: "LCALLL", CPUID: "", Encoding: "9A cp", Operands: "ptr16:32"},
+	{Intel: "CBW", Go: "CBW", CPUID: "", Encoding: "98", Operands: ""},
+	{Intel: "CDQ", Go: "CDQ", CPUID: "", Encoding: "99", Operands: ""},
+	{Intel: "CDQE", Go: "CDQE", CPUID: "", Encoding: "REX.W 98", Operands: ""},
+	{Intel: "CLAC", Go: "CLAC", CPUID: "SMAP", Encoding: "0F 01 CA", Operands: ""},
+	{Intel: "CLC", Go: "CLC", CPUID: "", Encoding: "F8", Operands: ""},
+	{Intel: "CLD", Go: "CLD", CPUID: "", Encoding: "FC", Operands: ""},
+	{Intel: "CLFLUSH", Go: "CLFLUSH", CPUID: "CLFSH", Encoding: "0F AE /7", Operands: "m8"},
+	{Intel: "CLFLUSHOPT", Go: "CLFLUSHOPT", CPUID: "CLFLUSHOPT", Encoding: "66 0F AE /7", Operands: "m8"},
+	{Intel: "CLI", Go: "CLI", CPUID: "", Encoding: "FA", Operands: ""},
+	{Intel: "CLTS", Go: "CLTS", CPUID: "", Encoding: "0F 06", Operands: ""},
+	{Intel: "CMC", Go: "CMC", CPUID: "", Encoding: "F5", Operands: ""},
+	{Intel: "CMOVA", Go: "CMOVWHI", CPUID: "CMOV", Encoding: "0F 47 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVA", Go: "CMOVLHI", CPUID: "CMOV", Encoding: "0F 47 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVA", Go: "CMOVQHI", CPUID: "CMOV", Encoding: "REX.W 0F 47 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVAE", Go: "CMOVWCC", CPUID: "CMOV", Encoding: "0F 43 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVAE", Go: "CMOVLCC", CPUID: "CMOV", Encoding: "0F 43 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVAE", Go: "CMOVQCC", CPUID: "CMOV", Encoding: "REX.W 0F 43 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVB", Go: "CMOVWCS", CPUID: "CMOV", Encoding: "0F 42 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVB", Go: "CMOVLCS", CPUID: "CMOV", Encoding: "0F 42 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVB", Go: "CMOVQCS", CPUID: "CMOV", Encoding: "REX.W 0F 42 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVBE", Go: "CMOVWLS", CPUID: "CMOV", Encoding: "0F 46 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVBE", Go: "CMOVLLS", CPUID: "CMOV", Encoding: "0F 46 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVBE", Go: "CMOVQLS", CPUID: "CMOV", Encoding: "REX.W 0F 46 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVC", Go: "CMOVC", CPUID: "CMOV", Encoding: "0F 42 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVC", Go: "CMOVC", CPUID: "CMOV", Encoding: "0F 42 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVC", Go: "CMOVC", CPUID: "CMOV", Encoding: "REX.W 0F 42 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVE", Go: "CMOVWEQ", CPUID: "CMOV", Encoding: "0F 44 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVE", Go: "CMOVLEQ", CPUID: "CMOV", Encoding: "0F 44 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVE", Go: "CMOVQEQ", CPUID: "CMOV", Encoding: "REX.W 0F 44 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVG", Go: "CMOVWGT", CPUID: "CMOV", Encoding: "0F 4F /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVG", Go: "CMOVLGT", CPUID: "CMOV", Encoding: "0F 4F /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVG", Go: "CMOVQGT", CPUID: "CMOV", Encoding: "REX.W 0F 4F /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVGE", Go: "CMOVWGE", CPUID: "CMOV", Encoding: "0F 4D /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVGE", Go: "CMOVLGE", CPUID: "CMOV", Encoding: "0F 4D /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVGE", Go: "CMOVQGE", CPUID: "CMOV", Encoding: "REX.W 0F 4D /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVL", Go: "CMOVWLT", CPUID: "CMOV", Encoding: "0F 4C /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVL", Go: "CMOVLLT", CPUID: "CMOV", Encoding: "0F 4C /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVL", Go: "CMOVQLT", CPUID: "CMOV", Encoding: "REX.W 0F 4C /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVLE", Go: "CMOVWLE", CPUID: "CMOV", Encoding: "0F 4E /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVLE", Go: "CMOVLLE", CPUID: "CMOV", Encoding: "0F 4E /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVLE", Go: "CMOVQLE", CPUID: "CMOV", Encoding: "REX.W 0F 4E /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNA", Go: "CMOVNA", CPUID: "CMOV", Encoding: "0F 46 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNA", Go: "CMOVNA", CPUID: "CMOV", Encoding: "0F 46 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNA", Go: "CMOVNA", CPUID: "CMOV", Encoding: "REX.W 0F 46 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNAE", Go: "CMOVNAE", CPUID: "CMOV", Encoding: "0F 42 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNAE", Go: "CMOVNAE", CPUID: "CMOV", Encoding: "0F 42 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNAE", Go: "CMOVNAE", CPUID: "CMOV", Encoding: "REX.W 0F 42 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNB", Go: "CMOVNB", CPUID: "CMOV", Encoding: "0F 43 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNB", Go: "CMOVNB", CPUID: "CMOV", Encoding: "0F 43 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNB", Go: "CMOVNB", CPUID: "CMOV", Encoding: "REX.W 0F 43 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNBE", Go: "CMOVNBE", CPUID: "CMOV", Encoding: "0F 47 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNBE", Go: "CMOVNBE", CPUID: "CMOV", Encoding: "0F 47 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNBE", Go: "CMOVNBE", CPUID: "CMOV", Encoding: "REX.W 0F 47 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNC", Go: "CMOVNC", CPUID: "CMOV", Encoding: "0F 43 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNC", Go: "CMOVNC", CPUID: "CMOV", Encoding: "0F 43 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNC", Go: "CMOVNC", CPUID: "CMOV", Encoding: "REX.W 0F 43 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNE", Go: "CMOVWNE", CPUID: "CMOV", Encoding: "0F 45 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNE", Go: "CMOVLNE", CPUID: "CMOV", Encoding: "0F 45 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNE", Go: "CMOVQNE", CPUID: "CMOV", Encoding: "REX.W 0F 45 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNG", Go: "CMOVNG", CPUID: "CMOV", Encoding: "0F 4E /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNG", Go: "CMOVNG", CPUID: "CMOV", Encoding: "0F 4E /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNG", Go: "CMOVNG", CPUID: "CMOV", Encoding: "REX.W 0F 4E /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNGE", Go: "CMOVNGE", CPUID: "CMOV", Encoding: "0F 4C /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNGE", Go: "CMOVNGE", CPUID: "CMOV", Encoding: "0F 4C /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNGE", Go: "CMOVNGE", CPUID: "CMOV", Encoding: "REX.W 0F 4C /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNL", Go: "CMOVNL", CPUID: "CMOV", Encoding: "0F 4D /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNL", Go: "CMOVNL", CPUID: "CMOV", Encoding: "0F 4D /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNL", Go: "CMOVNL", CPUID: "CMOV", Encoding: "REX.W 0F 4D /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNLE", Go: "CMOVNLE", CPUID: "CMOV", Encoding: "0F 4F /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNLE", Go: "CMOVNLE", CPUID: "CMOV", Encoding: "0F 4F /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNLE", Go: "CMOVNLE", CPUID: "CMOV", Encoding: "REX.W 0F 4F /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNO", Go: "CMOVWOC", CPUID: "CMOV", Encoding: "0F 41 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNO", Go: "CMOVLOC", CPUID: "CMOV", Encoding: "0F 41 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNO", Go: "CMOVQOC", CPUID: "CMOV", Encoding: "REX.W 0F 41 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNP", Go: "CMOVWPC", CPUID: "CMOV", Encoding: "0F 4B /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNP", Go: "CMOVLPC", CPUID: "CMOV", Encoding: "0F 4B /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNP", Go: "CMOVQPC", CPUID: "CMOV", Encoding: "REX.W 0F 4B /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNS", Go: "CMOVWPL", CPUID: "CMOV", Encoding: "0F 49 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNS", Go: "CMOVLPL", CPUID: "CMOV", Encoding: "0F 49 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNS", Go: "CMOVQPL", CPUID: "CMOV", Encoding: "REX.W 0F 49 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVNZ", Go: "CMOVNZ", CPUID: "CMOV", Encoding: "0F 45 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVNZ", Go: "CMOVNZ", CPUID: "CMOV", Encoding: "0F 45 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVNZ", Go: "CMOVNZ", CPUID: "CMOV", Encoding: "REX.W 0F 45 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVO", Go: "CMOVWOS", CPUID: "CMOV", Encoding: "0F 40 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVO", Go: "CMOVLOS", CPUID: "CMOV", Encoding: "0F 40 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVO", Go: "CMOVQOS", CPUID: "CMOV", Encoding: "REX.W 0F 40 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVP", Go: "CMOVWPS", CPUID: "CMOV", Encoding: "0F 4A /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVP", Go: "CMOVLPS", CPUID: "CMOV", Encoding: "0F 4A /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVP", Go: "CMOVQPS", CPUID: "CMOV", Encoding: "REX.W 0F 4A /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVPE", Go: "CMOVPE", CPUID: "CMOV", Encoding: "0F 4A /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVPE", Go: "CMOVPE", CPUID: "CMOV", Encoding: "0F 4A /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVPE", Go: "CMOVPE", CPUID: "CMOV", Encoding: "REX.W 0F 4A /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVPO", Go: "CMOVPO", CPUID: "CMOV", Encoding: "0F 4B /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVPO", Go: "CMOVPO", CPUID: "CMOV", Encoding: "0F 4B /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVPO", Go: "CMOVPO", CPUID: "CMOV", Encoding: "REX.W 0F 4B /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVS", Go: "CMOVWMI", CPUID: "CMOV", Encoding: "0F 48 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVS", Go: "CMOVLMI", CPUID: "CMOV", Encoding: "0F 48 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVS", Go: "CMOVQMI", CPUID: "CMOV", Encoding: "REX.W 0F 48 /r", Operands: "r64, r/m64"},
+	{Intel: "CMOVZ", Go: "CMOVZ", CPUID: "CMOV", Encoding: "0F 44 /r", Operands: "r16, r/m16"},
+	{Intel: "CMOVZ", Go: "CMOVZ", CPUID: "CMOV", Encoding: "0F 44 /r", Operands: "r32, r/m32"},
+	{Intel: "CMOVZ", Go: "CMOVZ", CPUID: "CMOV", Encoding: "REX.W 0F 44 /r", Operands: "r64, r/m64"},
+	{Intel: "CMP", Go: "CMPB", CPUID: "", Encoding: "3C ib", Operands: "AL, imm8"},
+	{Intel: "CMP", Go: "CMPW", CPUID: "", Encoding: "3D iw", Operands: "AX, imm16"},
+	{Intel: "CMP", Go: "CMPL", CPUID: "", Encoding: "3D id", Operands: "EAX, imm32"},
+	{Intel: "CMP", Go: "CMPQ", CPUID: "", Encoding: "REX.W 3D id", Operands: "RAX, imm32"},
+	{Intel: "CMP", Go: "CMPW", CPUID: "", Encoding: "81 /7 iw", Operands: "r/m16, imm16"},
+	{Intel: "CMP", Go: "CMPW", CPUID: "", Encoding: "83 /7 ib", Operands: "r/m16, imm8"},
+	{Intel: "CMP", Go: "CMPW", CPUID: "", Encoding: "39 /r", Operands: "r/m16, r16"},
+	{Intel: "CMP", Go: "CMPL", CPUID: "", Encoding: "81 /7 id", Operands: "r/m32, imm32"},
+	{Intel: "CMP", Go: "CMPL", CPUID: "", Encoding: "83 /7 ib", Operands: "r/m32, imm8"},
+	{Intel: "CMP", Go: "CMPL", CPUID: "", Encoding: "39 /r", Operands: "r/m32, r32"},
+	{Intel: "CMP", Go: "CMPQ", CPUID: "", Encoding: "REX.W 81 /7 id", Operands: "r/m64, imm32"},
+	{Intel: "CMP", Go: "CMPQ", CPUID: "", Encoding: "REX.W 83 /7 ib", Operands: "r/m64, imm8"},
+	{Intel: "CMP", Go: "CMPQ", CPUID: "", Encoding: "REX.W 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CMP", Go: "CMPB", CPUID: "", Encoding: "80 /7 ib", Operands: "r/m8, imm8"},
+	{Intel: "CMP", Go: "CMPB", CPUID: "", Encoding: "REX 80 /7 ib", Operands: "r/m8, imm8"},
+	{Intel: "CMP", Go: "CMPB", CPUID: "", Encoding: "38 /r", Operands: "r/m8, r8"},
+	{Intel: "CMP", Go: "CMPB", CPUID: "", Encoding: "REX 38 /r", Operands: "r/m8, r8"},
+	{Intel: "CMP", Go: "CMPW", CPUID: "", Encoding: "3B /r", Operands: "r16, r/m16"},
+	{Intel: "CMP", Go: "CMPL", CPUID: "", Encoding: "3B /r", Operands: "r32, r/m32"},
+	{Intel: "CMP", Go: "CMPQ", CPUID: "", Encoding: "REX.W 3B /r", Operands: "r64, r/m64"},
+	{Intel: "CMP", Go: "CMPB", CPUID: "", Encoding: "3A /r", Operands: "r8, r/m8"},
+	{Intel: "CMP", Go: "CMPB", CPUID: "", Encoding: "REX 3A /r", Operands: "r8, r/m8"},
+	{Intel: "CMPPD", Go: "CMPPD", CPUID: "SSE2", Encoding: "66 0F C2 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "CMPPS", Go: "CMPPS", CPUID: "SSE", Encoding: "0F C2 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "CMPSB", Go: "CMPSB", CPUID: "", Encoding: "A6", Operands: ""},
+	{Intel: "CMPSD", Go: "CMPSL", CPUID: "", Encoding: "A7", Operands: ""},
+	{Intel: "CMPSD", Go: "CMPSD", CPUID: "SSE2", Encoding: "F2 0F C2 /r ib", Operands: "xmm1, xmm2/m64, imm8"},
+	{Intel: "CMPSQ", Go: "CMPSQ", CPUID: "", Encoding: "REX.W A7", Operands: ""},
+	{Intel: "CMPSS", Go: "CMPSS", CPUID: "SSE", Encoding: "F3 0F C2 /r ib", Operands: "xmm1, xmm2/m32, imm8"},
+	{Intel: "CMPSW", Go: "CMPSW", CPUID: "", Encoding: "A7", Operands: ""},
+	{Intel: "CMPXCHG", Go: "CMPXCHGW", CPUID: "", Encoding: "0F B1 /r", Operands: "r/m16, r16"},
+	{Intel: "CMPXCHG", Go: "CMPXCHGL", CPUID: "", Encoding: "0F B1 /r", Operands: "r/m32, r32"},
+	{Intel: "CMPXCHG", Go: "CMPXCHGQ", CPUID: "", Encoding: "REX.W 0F B1 /r", Operands: "r/m64, r64"},
+	{Intel: "CMPXCHG", Go: "CMPXCHGB", CPUID: "", Encoding: "0F B0 /r", Operands: "r/m8, r8"},
+	{Intel: "CMPXCHG", Go: "CMPXCHGB", CPUID: "", Encoding: "REX 0F B0 /r", Operands: "r/m8, r8"},
+	{Intel: "CMPXCHG16B", Go: "CMPXCHG16B", CPUID: "CMPXCHG16B", Encoding: "REX.W 0F C7 /1", Operands: "m128"},
+	{Intel: "CMPXCHG8B", Go: "CMPXCHG8B", CPUID: "CX8", Encoding: "0F C7 /1", Operands: "m64"},
+	{Intel: "COMISD", Go: "COMISD", CPUID: "SSE2", Encoding: "66 0F 2F /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "COMISS", Go: "COMISS", CPUID: "SSE", Encoding: "0F 2F /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "CPUID", Go: "CPUID", CPUID: "", Encoding: "0F A2", Operands: ""},
+	{Intel: "CQO", Go: "CQO", CPUID: "", Encoding: "REX.W 99", Operands: ""},
+	{Intel: "CRC32", Go: "CRC32W", CPUID: "SSE4_2", Encoding: "F2 0F 38 F1 /r", Operands: "r32, r/m16"},
+	{Intel: "CRC32", Go: "CRC32L", CPUID: "SSE4_2", Encoding: "F2 0F 38 F1 /r", Operands: "r32, r/m32"},
+	{Intel: "CRC32", Go: "CRC32B", CPUID: "SSE4_2", Encoding: "F2 0F 38 F0 /r", Operands: "r32, r/m8"},
+	{Intel: "CRC32", Go: "CRC32B", CPUID: "SSE4_2", Encoding: "F2 REX 0F 38 F0 /r", Operands: "r32, r/m8"},
+	{Intel: "CRC32", Go: "CRC32Q", CPUID: "SSE4_2", Encoding: "F2 REX.W 0F 38 F1 /r", Operands: "r64, r/m64"},
+	{Intel: "CRC32", Go: "CRC32B", CPUID: "SSE4_2", Encoding: "F2 REX.W 0F 38 F0 /r", Operands: "r64, r/m8"},
+	{Intel: "CVTDQ2PD", Go: "CVTPL2PD", CPUID: "SSE2", Encoding: "F3 0F E6 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "CVTDQ2PS", Go: "CVTPL2PS", CPUID: "SSE2", Encoding: "0F 5B /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "CVTPD2DQ", Go: "CVTPD2PL", CPUID: "SSE2", Encoding: "F2 0F E6 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "CVTPD2PI", Go: "CVTPD2PI", CPUID: "SSE2", Encoding: "66 0F 2D /r", Operands: "mm1, xmm2/m128"},
+	{Intel: "CVTPD2PS", Go: "CVTPD2PS", CPUID: "SSE2", Encoding: "66 0F 5A /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "CVTPI2PD", Go: "CVTPI2PD", CPUID: "SSE2", Encoding: "66 0F 2A /r", Operands: "xmm1, mm2/m64"},
+	{Intel: "CVTPI2PS", Go: "CVTPI2PS", CPUID: "SSE", Encoding: "0F 2A /r", Operands: "xmm1, mm2/m64"},
+	{Intel: "CVTPS2DQ", Go: "CVTPS2PL", CPUID: "SSE2", Encoding: "66 0F 5B /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "CVTPS2PD", Go: "CVTPS2PD", CPUID: "SSE2", Encoding: "0F 5A /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "CVTPS2PI", Go: "CVTPS2PI", CPUID: "SSE", Encoding: "0F 2D /r", Operands: "mm1, xmm2/m64"},
+	{Intel: "CVTSD2SI", Go: "CVTSD2SL", CPUID: "SSE2", Encoding: "F2 0F 2D /r", Operands: "r32, xmm2/m64"},
+	{Intel: "CVTSD2SI", Go: "CVTSD2SL", CPUID: "SSE2", Encoding: "F2 REX.W 0F 2D /r", Operands: "r64, xmm2/m64"},
+	{Intel: "CVTSD2SS", Go: "CVTSD2SS", CPUID: "SSE2", Encoding: "F2 0F 5A /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "CVTSI2SD", Go: "CVTSL2SD", CPUID: "SSE2", Encoding: "F2 0F 2A /r", Operands: "xmm1, r/m32"},
+	{Intel: "CVTSI2SD", Go: "CVTSQ2SD", CPUID: "SSE2", Encoding: "F2 REX.W 0F 2A /r", Operands: "xmm1, r/m64"},
+	{Intel: "CVTSI2SS", Go: "CVTSL2SS", CPUID: "SSE", Encoding: "F3 0F 2A /r", Operands: "xmm1, r/m32"},
+	{Intel: "CVTSI2SS", Go: "CVTSQ2SS", CPUID: "SSE", Encoding: "F3 REX.W 0F 2A /r", Operands: "xmm1, r/m64"},
+	{Intel: "CVTSS2SD", Go: "CVTSS2SD", CPUID: "SSE2", Encoding: "F3 0F 5A /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "CVTSS2SI", Go: "CVTSS2SL", CPUID: "SSE", Encoding: "F3 0F 2D /r", Operands: "r32, xmm2/m32"},
+	{Intel: "CVTSS2SI", Go: "CVTSS2SL", CPUID: "SSE", Encoding: "F3 REX.W 0F 2D /r", Operands: "r64, xmm2/m32"},
+	{Intel: "CVTTPD2DQ", Go: "CVTTPD2PL", CPUID: "SSE2", Encoding: "66 0F E6 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "CVTTPD2PI", Go: "CVTTPD2PI", CPUID: "SSE2", Encoding: "66 0F 2C /r", Operands: "mm1, xmm2/m128"},
+	{Intel: "CVTTPS2DQ", Go: "CVTTPS2PL", CPUID: "SSE2", Encoding: "F3 0F 5B /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "CVTTPS2PI", Go: "CVTTPS2PI", CPUID: "SSE", Encoding: "0F 2C /r", Operands: "mm1, xmm2/m64"},
+	{Intel: "CVTTSD2SI", Go: "CVTTSD2SL", CPUID: "SSE2", Encoding: "F2 0F 2C /r", Operands: "r32, xmm2/m64"},
+	{Intel: "CVTTSD2SI", Go: "CVTTSD2SL", CPUID: "SSE2", Encoding: "F2 REX.W 0F 2C /r", Operands: "r64, xmm2/m64"},
+	{Intel: "CVTTSS2SI", Go: "CVTTSS2SL", CPUID: "SSE", Encoding: "F3 0F 2C /r", Operands: "r32, xmm2/m32"},
+	{Intel: "CVTTSS2SI", Go: "CVTTSS2SL", CPUID: "SSE", Encoding: "F3 REX.W 0F 2C /r", Operands: "r64, xmm2/m32"},
+	{Intel: "CWD", Go: "CWD", CPUID: "", Encoding: "99", Operands: ""},
+	{Intel: "CWDE", Go: "CWDE", CPUID: "", Encoding: "98", Operands: ""},
+	{Intel: "DAA", Go: "DAA", CPUID: "", Encoding: "27", Operands: ""},
+	{Intel: "DAS", Go: "DAS", CPUID: "", Encoding: "2F", Operands: ""},
+	{Intel: "DEC", Go: "DECW", CPUID: "", Encoding: "FF /1", Operands: "r/m16"},
+	{Intel: "DEC", Go: "DECL", CPUID: "", Encoding: "FF /1", Operands: "r/m32"},
+	{Intel: "DEC", Go: "DECQ", CPUID: "", Encoding: "REX.W FF /1", Operands: "r/m64"},
+	{Intel: "DEC", Go: "DECB", CPUID: "", Encoding: "FE /1", Operands: "r/m8"},
+	{Intel: "DEC", Go: "DECB", CPUID: "", Encoding: "REX FE /1", Operands: "r/m8"},
+	{Intel: "DEC", Go: "DECW", CPUID: "", Encoding: "48+rw", Operands: "r16op"},
+	{Intel: "DEC", Go: "DECL", CPUID: "", Encoding: "48+rd", Operands: "r32op"},
+	{Intel: "DIV", Go: "DIVW", CPUID: "", Encoding: "F7 /6", Operands: "r/m16"},
+	{Intel: "DIV", Go: "DIVL", CPUID: "", Encoding: "F7 /6", Operands: "r/m32"},
+	{Intel: "DIV", Go: "DIVQ", CPUID: "", Encoding: "REX.W F7 /6", Operands: "r/m64"},
+	{Intel: "DIV", Go: "DIVB", CPUID: "", Encoding: "F6 /6", Operands: "r/m8"},
+	{Intel: "DIV", Go: "DIVB", CPUID: "", Encoding: "REX F6 /6", Operands: "r/m8"},
+	{Intel: "DIVPD", Go: "DIVPD", CPUID: "SSE2", Encoding: "66 0F 5E /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "DIVPS", Go: "DIVPS", CPUID: "SSE", Encoding: "0F 5E /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "DIVSD", Go: "DIVSD", CPUID: "SSE2", Encoding: "F2 0F 5E /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "DIVSS", Go: "DIVSS", CPUID: "SSE", Encoding: "F3 0F 5E /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "DPPD", Go: "DPPD", CPUID: "SSE4_1", Encoding: "66 0F 3A 41 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "DPPS", Go: "DPPS", CPUID: "SSE4_1", Encoding: "66 0F 3A 40 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "EMMS", Go: "EMMS", CPUID: "MMX", Encoding: "0F 77", Operands: ""},
+	{Intel: "ENTER", Go: "ENTER", CPUID: "", Encoding: "C8 iw 00", Operands: "imm16, 0"},
+	{Intel: "ENTER", Go: "ENTER", CPUID: "", Encoding: "C8 iw 01", Operands: "imm16, 1"},
+	{Intel: "ENTER", Go: "ENTERW/ENTERL/ENTERQ", CPUID: "", Encoding: "C8 iw ib", Operands: "imm16, imm8b"},
+	{Intel: "EXTRACTPS", Go: "EXTRACTPS", CPUID: "SSE4_1", Encoding: "66 0F 3A 17 /r ib", Operands: "r/m32, xmm1, imm8"},
+	{Intel: "F2XM1", Go: "F2XM1", CPUID: "FPU", Encoding: "D9 F0", Operands: ""},
+	{Intel: "FABS", Go: "FABS", CPUID: "FPU", Encoding: "D9 E1", Operands: ""},
+	{Intel: "FADD", Go: "FADDD", CPUID: "FPU", Encoding: "D8 C0+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FADD", Go: "FADDD", CPUID: "FPU", Encoding: "DC C0+i", Operands: "ST(i), ST(0)"},
+	{Intel: "FADD", Go: "FADDD", CPUID: "FPU", Encoding: "D8 /0", Operands: "m32fp"},
+	{Intel: "FADD", Go: "FADDD", CPUID: "FPU", Encoding: "DC /0", Operands: "m64fp"},
+	{Intel: "FADDP", Go: "FADDDP", CPUID: "FPU", Encoding: "DE C1", Operands: ""},
+	{Intel: "FADDP", Go: "FADDDP", CPUID: "FPU", Encoding: "DE C0+i", Operands: "ST(i), ST(0)"},
+	{Intel: "FBLD", Go: "FBLD", CPUID: "FPU", Encoding: "DF /4", Operands: "m80dec"},
+	{Intel: "FBSTP", Go: "FBSTP", CPUID: "FPU", Encoding: "DF /6", Operands: "m80bcd"},
+	{Intel: "FCHS", Go: "FCHS", CPUID: "FPU", Encoding: "D9 E0", Operands: ""},
+	{Intel: "FCLEX", Go: "FCLEX", CPUID: "FPU", Encoding: "9B DB E2", Operands: ""},
+	{Intel: "FCMOVB", Go: "FCMOVB", CPUID: "CMOV,FPU", Encoding: "DA C0+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FCMOVBE", Go: "FCMOVBE", CPUID: "CMOV,FPU", Encoding: "DA D0+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FCMOVE", Go: "FCMOVE", CPUID: "CMOV,FPU", Encoding: "DA C8+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FCMOVNB", Go: "FCMOVNB", CPUID: "CMOV,FPU", Encoding: "DB C0+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FCMOVNBE", Go: "FCMOVNBE", CPUID: "CMOV,FPU", Encoding: "DB D0+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FCMOVNE", Go: "FCMOVNE", CPUID: "CMOV,FPU", Encoding: "DB C8+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FCMOVNU", Go: "FCMOVNU", CPUID: "CMOV,FPU", Encoding: "DB D8+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FCMOVU", Go: "FCMOVU", CPUID: "CMOV,FPU", Encoding: "DA D8+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FCOM", Go: "FCOMD", CPUID: "FPU", Encoding: "D8 D1", Operands: ""},
+	{Intel: "FCOM", Go: "FCOMD", CPUID: "FPU", Encoding: "D8 D0+i", Operands: "ST(i)"},
+	{Intel: "FCOM", Go: "FCOMD", CPUID: "FPU", Encoding: "D8 /2", Operands: "m32fp"},
+	{Intel: "FCOM", Go: "FCOMD", CPUID: "FPU", Encoding: "DC /2", Operands: "m64fp"},
+	{Intel: "FCOMI", Go: "FCOMI", CPUID: "CMOV,FPU", Encoding: "DB F0+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FCOMIP", Go: "FCOMIP", CPUID: "CMOV,FPU", Encoding: "DF F0+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FCOMP", Go: "FCOMP", CPUID: "FPU", Encoding: "D8 D9", Operands: ""},
+	{Intel: "FCOMP", Go: "FCOMP", CPUID: "FPU", Encoding: "D8 D8+i", Operands: "ST(i)"},
+	{Intel: "FCOMP", Go: "FCOMFP", CPUID: "FPU", Encoding: "D8 /3", Operands: "m32fp"},
+	{Intel: "FCOMP", Go: "FCOMPL", CPUID: "FPU", Encoding: "DC /3", Operands: "m64fp"},
+	{Intel: "FCOMPP", Go: "FCOMPP", CPUID: "FPU", Encoding: "DE D9", Operands: ""},
+	{Intel: "FCOS", Go: "FCOS", CPUID: "FPU", Encoding: "D9 FF", Operands: ""},
+	{Intel: "FDECSTP", Go: "FDECSTP", CPUID: "FPU", Encoding: "D9 F6", Operands: ""},
+	{Intel: "FDIV", Go: "FDIVD", CPUID: "FPU", Encoding: "D8 F0+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FDIV", Go: "FDIVD", CPUID: "FPU", Encoding: "DC F8+i", Operands: "ST(i), ST(0)"},
+	{Intel: "FDIV", Go: "FDIVD", CPUID: "FPU", Encoding: "D8 /6", Operands: "m32fp"},
+	{Intel: "FDIV", Go: "FDIVD", CPUID: "FPU", Encoding: "DC /6", Operands: "m64fp"},
+	{Intel: "FDIVP", Go: "FDIVP", CPUID: "FPU", Encoding: "DE F9", Operands: ""},
+	{Intel: "FDIVP", Go: "FDIVRP", CPUID: "FPU", Encoding: "DE F8+i", Operands: "ST(i), ST(0)"},
+	{Intel: "FDIVR", Go: "FDIVR", CPUID: "FPU", Encoding: "D8 F8+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FDIVR", Go: "FDIVD", CPUID: "FPU", Encoding: "DC F0+i", Operands: "ST(i), ST(0)"},
+	{Intel: "FDIVR", Go: "FDIVFR", CPUID: "FPU", Encoding: "D8 /7", Operands: "m32fp"},
+	{Intel: "FDIVR", Go: "FDIVRL", CPUID: "FPU", Encoding: "DC /7", Operands: "m64fp"},
+	{Intel: "FDIVRP", Go: "FDIVRP", CPUID: "FPU", Encoding: "DE F1", Operands: ""},
+	{Intel: "FDIVRP", Go: "FDIVP", CPUID: "FPU", Encoding: "DE F0+i", Operands: "ST(i), ST(0)"},
+	{Intel: "FFREE", Go: "FFREE", CPUID: "FPU", Encoding: "DD C0+i", Operands: "ST(i)"},
+	{Intel: "FFREEP", Go: "FFREEP", CPUID: "FPU", Encoding: "DF C0+i", Operands: "ST(i)"},
+	{Intel: "FIADD", Go: "FIADD", CPUID: "FPU", Encoding: "DE /0", Operands: "m16int"},
+	{Intel: "FIADD", Go: "FIADDL", CPUID: "FPU", Encoding: "DA /0", Operands: "m32int"},
+	{Intel: "FICOM", Go: "FICOM", CPUID: "FPU", Encoding: "DE /2", Operands: "m16int"},
+	{Intel: "FICOM", Go: "FICOML", CPUID: "FPU", Encoding: "DA /2", Operands: "m32int"},
+	{Intel: "FICOMP", Go: "FICOMP", CPUID: "FPU", Encoding: "DE /3", Operands: "m16int"},
+	{Intel: "FICOMP", Go: "FICOMPL", CPUID: "FPU", Encoding: "DA /3", Operands: "m32int"},
+	{Intel: "FIDIV", Go: "FIDIV", CPUID: "FPU", Encoding: "DE /6", Operands: "m16int"},
+	{Intel: "FIDIV", Go: "FIDIVL", CPUID: "FPU", Encoding: "DA /6", Operands: "m32int"},
+	{Intel: "FIDIVR", Go: "FIDIVR", CPUID: "FPU", Encoding: "DE /7", Operands: "m16int"},
+	{Intel: "FIDIVR", Go: "FIDIVRL", CPUID: "FPU", Encoding: "DA /7", Operands: "m32int"},
+	{Intel: "FILD", Go: "FILD", CPUID: "FPU", Encoding: "DF /0", Operands: "m16int"},
+	{Intel: "FILD", Go: "FILDL", CPUID: "FPU", Encoding: "DB /0", Operands: "m32int"},
+	{Intel: "FILD", Go: "FILDLL", CPUID: "FPU", Encoding: "DF /5", Operands: "m64int"},
+	{Intel: "FIMUL", Go: "FIMUL", CPUID: "FPU", Encoding: "DE /1", Operands: "m16int"},
+	{Intel: "FIMUL", Go: "FIMULL", CPUID: "FPU", Encoding: "DA /1", Operands: "m32int"},
+	{Intel: "FINCSTP", Go: "FINCSTP", CPUID: "FPU", Encoding: "D9 F7", Operands: ""},
+	{Intel: "FINIT", Go: "FINIT", CPUID: "FPU", Encoding: "9B DB E3", Operands: ""},
+	{Intel: "FIST", Go: "FIST", CPUID: "FPU", Encoding: "DF /2", Operands: "m16int"},
+	{Intel: "FIST", Go: "FISTL", CPUID: "FPU", Encoding: "DB /2", Operands: "m32int"},
+	{Intel: "FISTP", Go: "FISTP", CPUID: "FPU", Encoding: "DF /3", Operands: "m16int"},
+	{Intel: "FISTP", Go: "FISTPL", CPUID: "FPU", Encoding: "DB /3", Operands: "m32int"},
+	{Intel: "FISTP", Go: "FISTPLL", CPUID: "FPU", Encoding: "DF /7", Operands: "m64int"},
+	{Intel: "FISTTP", Go: "FISTTP", CPUID: "SSE3", Encoding: "DF /1", Operands: "m16int"},
+	{Intel: "FISTTP", Go: "FISTTPL", CPUID: "SSE3", Encoding: "DB /1", Operands: "m32int"},
+	{Intel: "FISTTP", Go: "FISTTPLL", CPUID: "SSE3", Encoding: "DD /1", Operands: "m64int"},
+	{Intel: "FISUB", Go: "FISUB", CPUID: "FPU", Encoding: "DE /4", Operands: "m16int"},
+	{Intel: "FISUB", Go: "FISUBL", CPUID: "FPU", Encoding: "DA /4", Operands: "m32int"},
+	{Intel: "FISUBR", Go: "FISUBR", CPUID: "FPU", Encoding: "DE /5", Operands: "m16int"},
+	{Intel: "FISUBR", Go: "FISUBRL", CPUID: "FPU", Encoding: "DA /5", Operands: "m32int"},
+	{Intel: "FLD", Go: "FLD", CPUID: "FPU", Encoding: "D9 C0+i", Operands: "ST(i)"},
+	{Intel: "FLD", Go: "FLDS", CPUID: "FPU", Encoding: "D9 /0", Operands: "m32fp"},
+	{Intel: "FLD", Go: "FLDL", CPUID: "FPU", Encoding: "DD /0", Operands: "m64fp"},
+	{Intel: "FLD", Go: "FLDT", CPUID: "FPU", Encoding: "DB /5", Operands: "m80fp"},
+	{Intel: "FLD1", Go: "FLD1", CPUID: "FPU", Encoding: "D9 E8", Operands: ""},
+	{Intel: "FLDCW", Go: "FLDCW", CPUID: "FPU", Encoding: "D9 /5", Operands: "m2byte"},
+	{Intel: "FLDENV", Go: "FLDENVS/FLDENVL", CPUID: "FPU", Encoding: "D9 /4", Operands: "m14/28byte"},
+	{Intel: "FLDL2E", Go: "FLDL2E", CPUID: "FPU", Encoding: "D9 EA", Operands: ""},
+	{Intel: "FLDL2T", Go: "FLDL2T", CPUID: "FPU", Encoding: "D9 E9", Operands: ""},
+	{Intel: "FLDLG2", Go: "FLDLG2", CPUID: "FPU", Encoding: "D9 EC", Operands: ""},
+	{Intel: "FLDPI", Go: "FLDPI", CPUID: "FPU", Encoding: "D9 EB", Operands: ""},
+	{Intel: "FMUL", Go: "FMUL", CPUID: "FPU", Encoding: "D8 C8+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FMUL", Go: "FMUL", CPUID: "FPU", Encoding: "DC C8+i", Operands: "ST(i), ST(0)"},
+	{Intel: "FMUL", Go: "FMULS", CPUID: "FPU", Encoding: "D8 /1", Operands: "m32fp"},
+	{Intel: "FMUL", Go: "FMULL", CPUID: "FPU", Encoding: "DC /1", Operands: "m64fp"},
+	{Intel: "FMULP", Go: "FMULP", CPUID: "FPU", Encoding: "DE C9", Operands: ""},
+	{Intel: "FMULP", Go: "FMULP", CPUID: "FPU", Encoding: "DE C8+i", Operands: "ST(i), ST(0)"},
+	{Intel: "FNCLEX", Go: "FNCLEX", CPUID: "FPU", Encoding: "DB E2", Operands: ""},
+	{Intel: "FNINIT", Go: "FNINIT", CPUID: "FPU", Encoding: "DB E3", Operands: ""},
+	{Intel: "FNOP", Go: "FNOP", CPUID: "FPU", Encoding: "D9 D0", Operands: ""},
+	{Intel: "FNSAVE", Go: "FNSAVES/FNSAVEL", CPUID: "FPU", Encoding: "DD /6", Operands: "m94/108byte"},
+	{Intel: "FNSTCW", Go: "FNSTCW", CPUID: "FPU", Encoding: "D9 /7", Operands: "m2byte"},
+	{Intel: "FNSTENV", Go: "FNSTENVS/FNSTENVL", CPUID: "FPU", Encoding: "D9 /6", Operands: "m14/28byte"},
+	{Intel: "FNSTSW", Go: "FNSTSW", CPUID: "FPU", Encoding: "DF E0", Operands: "AX"},
+	{Intel: "FNSTSW", Go: "FNSTSW", CPUID: "FPU", Encoding: "DD /7", Operands: "m2byte"},
+	{Intel: "FPATAN", Go: "FPATAN", CPUID: "FPU", Encoding: "D9 F3", Operands: ""},
+	{Intel: "FPREM", Go: "FPREM", CPUID: "FPU", Encoding: "D9 F8", Operands: ""},
+	{Intel: "FPREM1", Go: "FPREM1", CPUID: "FPU", Encoding: "D9 F5", Operands: ""},
+	{Intel: "FPTAN", Go: "FPTAN", CPUID: "FPU", Encoding: "D9 F2", Operands: ""},
+	{Intel: "FRNDINT", Go: "FRNDINT", CPUID: "FPU", Encoding: "D9 FC", Operands: ""},
+	{Intel: "FRSTOR", Go: "FRSTORS/FRSTORL", CPUID: "FPU", Encoding: "DD /4", Operands: "m94/108byte"},
+	{Intel: "FSAVE", Go: "FSAVE", CPUID: "FPU", Encoding: "9B DD /6", Operands: "m94/108byte"},
+	{Intel: "FSCALE", Go: "FSCALE", CPUID: "FPU", Encoding: "D9 FD", Operands: ""},
+	{Intel: "FSIN", Go: "FSIN", CPUID: "FPU", Encoding: "D9 FE", Operands: ""},
+	{Intel: "FSINCOS", Go: "FSINCOS", CPUID: "FPU", Encoding: "D9 FB", Operands: ""},
+	{Intel: "FSQRT", Go: "FSQRT", CPUID: "FPU", Encoding: "D9 FA", Operands: ""},
+	{Intel: "FST", Go: "FST", CPUID: "FPU", Encoding: "DD D0+i", Operands: "ST(i)"},
+	{Intel: "FST", Go: "FSTS", CPUID: "FPU", Encoding: "D9 /2", Operands: "m32fp"},
+	{Intel: "FST", Go: "FSTL", CPUID: "FPU", Encoding: "DD /2", Operands: "m64fp"},
+	{Intel: "FSTCW", Go: "FSTCW", CPUID: "FPU", Encoding: "9B D9 /7", Operands: "m2byte"},
+	{Intel: "FSTENV", Go: "FSTENV", CPUID: "FPU", Encoding: "9B D9 /6", Operands: "m14/28byte"},
+	{Intel: "FSTP", Go: "FSTP", CPUID: "FPU", Encoding: "DD D8+i", Operands: "ST(i)"},
+	{Intel: "FSTP", Go: "FSTPS", CPUID: "FPU", Encoding: "D9 /3", Operands: "m32fp"},
+	{Intel: "FSTP", Go: "FSTPL", CPUID: "FPU", Encoding: "DD /3", Operands: "m64fp"},
+	{Intel: "FSTP", Go: "FSTPT", CPUID: "FPU", Encoding: "DB /7", Operands: "m80fp"},
+	{Intel: "FSTSW", Go: "FSTSW", CPUID: "FPU", Encoding: "9B DF E0", Operands: "AX"},
+	{Intel: "FSTSW", Go: "FSTSW", CPUID: "FPU", Encoding: "9B DD /7", Operands: "m2byte"},
+	{Intel: "FSUB", Go: "FSUB", CPUID: "FPU", Encoding: "D8 E0+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FSUB", Go: "FSUBR", CPUID: "FPU", Encoding: "DC E8+i", Operands: "ST(i), ST(0)"},
+	{Intel: "FSUB", Go: "FSUBS", CPUID: "FPU", Encoding: "D8 /4", Operands: "m32fp"},
+	{Intel: "FSUB", Go: "FSUBL", CPUID: "FPU", Encoding: "DC /4", Operands: "m64fp"},
+	{Intel: "FSUBP", Go: "FSUBP", CPUID: "FPU", Encoding: "DE E9", Operands: ""},
+	{Intel: "FSUBP", Go: "FSUBRP", CPUID: "FPU", Encoding: "DE E8+i", Operands: "ST(i), ST(0)"},
+	{Intel: "FSUBR", Go: "FSUBR", CPUID: "FPU", Encoding: "D8 E8+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FSUBR", Go: "FSUB", CPUID: "FPU", Encoding: "DC E0+i", Operands: "ST(i), ST(0)"},
+	{Intel: "FSUBR", Go: "FSUBRS", CPUID: "FPU", Encoding: "D8 /5", Operands: "m32fp"},
+	{Intel: "FSUBR", Go: "FSUBRL", CPUID: "FPU", Encoding: "DC /5", Operands: "m64fp"},
+	{Intel: "FSUBRP", Go: "FSUBRP", CPUID: "FPU", Encoding: "DE E1", Operands: ""},
+	{Intel: "FSUBRP", Go: "FSUBP", CPUID: "FPU", Encoding: "DE E0+i", Operands: "ST(i), ST(0)"},
+	{Intel: "FTST", Go: "FTST", CPUID: "FPU", Encoding: "D9 E4", Operands: ""},
+	{Intel: "FUCOM", Go: "FUCOM", CPUID: "FPU", Encoding: "DD E1", Operands: ""},
+	{Intel: "FUCOM", Go: "FUCOM", CPUID: "FPU", Encoding: "DD E0+i", Operands: "ST(i)"},
+	{Intel: "FUCOMI", Go: "FUCOMI", CPUID: "CMOV,FPU", Encoding: "DB E8+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FUCOMIP", Go: "FUCOMIP", CPUID: "CMOV,FPU", Encoding: "DF E8+i", Operands: "ST(0), ST(i)"},
+	{Intel: "FUCOMP", Go: "FUCOMP", CPUID: "FPU", Encoding: "DD E9", Operands: ""},
+	{Intel: "FUCOMP", Go: "FUCOMP", CPUID: "FPU", Encoding: "DD E8+i", Operands: "ST(i)"},
+	{Intel: "FUCOMPP", Go: "FUCOMPP", CPUID: "FPU", Encoding: "DA E9", Operands: ""},
+	{Intel: "FWAIT", Go: "FWAIT", CPUID: "FPU", Encoding: "9B", Operands: ""},
+	{Intel: "FXAM", Go: "FXAM", CPUID: "FPU", Encoding: "D9 E5", Operands: ""},
+	{Intel: "FXCH", Go: "FXCH", CPUID: "FPU", Encoding: "D9 C9", Operands: ""},
+	{Intel: "FXCH", Go: "FXCH", CPUID: "FPU", Encoding: "D9 C8+i", Operands: "ST(i)"},
+	{Intel: "FXRSTOR", Go: "FXRSTOR", CPUID: "FXSR", Encoding: "0F AE /1", Operands: "m512byte"},
+	{Intel: "FXRSTOR64", Go: "FXRSTOR64", CPUID: "FXSR", Encoding: "REX.W 0F AE /1", Operands: "m512byte"},
+	{Intel: "FXSAVE", Go: "FXSAVE", CPUID: "FXSR", Encoding: "0F AE /0", Operands: "m512byte"},
+	{Intel: "FXSAVE64", Go: "FXSAVE64", CPUID: "FXSR", Encoding: "REX.W 0F AE /0", Operands: "m512byte"},
+	{Intel: "FXTRACT", Go: "FXTRACT", CPUID: "FPU", Encoding: "D9 F4", Operands: ""},
+	{Intel: "FYL2X", Go: "FYL2X", CPUID: "FPU", Encoding: "D9 F1", Operands: ""},
+	{Intel: "FYL2XP1", Go: "FYL2XP1", CPUID: "FPU", Encoding: "D9 F9", Operands: ""},
+	{Intel: "HADDPD", Go: "HADDPD", CPUID: "SSE3", Encoding: "66 0F 7C /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "HADDPS", Go: "HADDPS", CPUID: "SSE3", Encoding: "F2 0F 7C /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "HLT", Go: "HLT", CPUID: "", Encoding: "F4", Operands: ""},
+	{Intel: "HSUBPD", Go: "HSUBPD", CPUID: "SSE3", Encoding: "66 0F 7D /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "HSUBPS", Go: "HSUBPS", CPUID: "SSE3", Encoding: "F2 0F 7D /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "ICEBP", Go: "ICEBP", CPUID: "", Encoding: "F1", Operands: ""},
+	{Intel: "IDIV", Go: "IDIVW", CPUID: "", Encoding: "F7 /7", Operands: "r/m16"},
+	{Intel: "IDIV", Go: "IDIVL", CPUID: "", Encoding: "F7 /7", Operands: "r/m32"},
+	{Intel: "IDIV", Go: "IDIVQ", CPUID: "", Encoding: "REX.W F7 /7", Operands: "r/m64"},
+	{Intel: "IDIV", Go: "IDIVB", CPUID: "", Encoding: "F6 /7", Operands: "r/m8"},
+	{Intel: "IDIV", Go: "IDIVB", CPUID: "", Encoding: "REX F6 /7", Operands: "r/m8"},
+	{Intel: "IMUL", Go: "IMULW", CPUID: "", Encoding: "F7 /5", Operands: "r/m16"},
+	{Intel: "IMUL", Go: "IMULL", CPUID: "", Encoding: "F7 /5", Operands: "r/m32"},
+	{Intel: "IMUL", Go: "IMULQ", CPUID: "", Encoding: "REX.W F7 /5", Operands: "r/m64"},
+	{Intel: "IMUL", Go: "IMULB", CPUID: "", Encoding: "F6 /5", Operands: "r/m8"},
+	{Intel: "IMUL", Go: "IMULW", CPUID: "", Encoding: "0F AF /r", Operands: "r16, r/m16"},
+	{Intel: "IMUL", Go: "IMULW", CPUID: "", Encoding: "69 /r iw", Operands: "r16, r/m16, imm16"},
+	{Intel: "IMUL", Go: "IMULW", CPUID: "", Encoding: "6B /r ib", Operands: "r16, r/m16, imm8"},
+	{Intel: "IMUL", Go: "IMULL", CPUID: "", Encoding: "0F AF /r", Operands: "r32, r/m32"},
+	{Intel: "IMUL", Go: "IMULL", CPUID: "", Encoding: "69 /r id", Operands: "r32, r/m32, imm32"},
+	{Intel: "IMUL", Go: "IMULL", CPUID: "", Encoding: "6B /r ib", Operands: "r32, r/m32, imm8"},
+	{Intel: "IMUL", Go: "IMULQ", CPUID: "", Encoding: "REX.W 0F AF /r", Operands: "r64, r/m64"},
+	{Intel: "IMUL", Go: "IMULQ", CPUID: "", Encoding: "REX.W 69 /r id", Operands: "r64, r/m64, imm32"},
+	{Intel: "IMUL", Go: "IMULQ", CPUID: "", Encoding: "REX.W 6B /r ib", Operands: "r64, r/m64, imm8"},
+	{Intel: "IN", Go: "INB", CPUID: "", Encoding: "EC", Operands: "AL, DX"},
+	{Intel: "IN", Go: "INB", CPUID: "", Encoding: "E4 ib", Operands: "AL, imm8u"},
+	{Intel: "IN", Go: "INW", CPUID: "", Encoding: "ED", Operands: "AX, DX"},
+	{Intel: "IN", Go: "INW", CPUID: "", Encoding: "E5 ib", Operands: "AX, imm8u"},
+	{Intel: "IN", Go: "INL", CPUID: "", Encoding: "ED", Operands: "EAX, DX"},
+	{Intel: "IN", Go: "INL", CPUID: "", Encoding: "E5 ib", Operands: "EAX, imm8u"},
+	{Intel: "INC", Go: "INCW", CPUID: "", Encoding: "FF /0", Operands: "r/m16"},
+	{Intel: "INC", Go: "INCL", CPUID: "", Encoding: "FF /0", Operands: "r/m32"},
+	{Intel: "INC", Go: "INCQ", CPUID: "", Encoding: "REX.W FF /0", Operands: "r/m64"},
+	{Intel: "INC", Go: "INCB", CPUID: "", Encoding: "FE /0", Operands: "r/m8"},
+	{Intel: "INC", Go: "INCB", CPUID: "", Encoding: "REX FE /0", Operands: "r/m8"},
+	{Intel: "INC", Go: "INCW", CPUID: "", Encoding: "40+rw", Operands: "r16op"},
+	{Intel: "INC", Go: "INCL", CPUID: "", Encoding: "40+rd", Operands: "r32op"},
+	{Intel: "INSB", Go: "INSB", CPUID: "", Encoding: "6C", Operands: ""},
+	{Intel: "INSD", Go: "INSL", CPUID: "", Encoding: "6D", Operands: ""},
+	{Intel: "INSERTPS", Go: "INSERTPS", CPUID: "SSE4_1", Encoding: "66 0F 3A 21 /r ib", Operands: "xmm1, xmm2/m32, imm8"},
+	{Intel: "INSW", Go: "INSW", CPUID: "", Encoding: "6D", Operands: ""},
+	{Intel: "INT", Go: "INT", CPUID: "", Encoding: "CC", Operands: "3"},
+	{Intel: "INT", Go: "INT", CPUID: "", Encoding: "CD ib", Operands: "imm8"},
+	{Intel: "INTO", Go: "INTO", CPUID: "", Encoding: "CE", Operands: ""},
+	{Intel: "INVD", Go: "INVD", CPUID: "", Encoding: "0F 08", Operands: ""},
+	{Intel: "INVLPG", Go: "INVLPG", CPUID: "", Encoding: "0F 01 /7", Operands: "m"},
+	{Intel: "INVPCID", Go: "INVPCID", CPUID: "INVPCID", Encoding: "66 0F 38 82 /r", Operands: "r32, m128"},
+	{Intel: "INVPCID", Go: "INVPCID", CPUID: "INVPCID", Encoding: "66 0F 38 82 /r", Operands: "r64, m128"},
+	{Intel: "IRET", Go: "IRETW", CPUID: "", Encoding: "CF", Operands: ""},
+	{Intel: "IRETD", Go: "IRETL", CPUID: "", Encoding: "CF", Operands: ""},
+	{Intel: "IRETQ", Go: "IRETQ", CPUID: "", Encoding: "REX.W CF", Operands: ""},
+	{Intel: "JA", Go: "JA", CPUID: "", Encoding: "0F 87 cw", Operands: "rel16"},
+	{Intel: "JA", Go: "JA", CPUID: "", Encoding: "0F 87 cd", Operands: "rel32"},
+	{Intel: "JA", Go: "JA", CPUID: "", Encoding: "77 cb", Operands: "rel8"},
+	{Intel: "JAE", Go: "JAE", CPUID: "", Encoding: "0F 83 cw", Operands: "rel16"},
+	{Intel: "JAE", Go: "JAE", CPUID: "", Encoding: "0F 83 cd", Operands: "rel32"},
+	{Intel: "JAE", Go: "JAE", CPUID: "", Encoding: "73 cb", Operands: "rel8"},
+	{Intel: "JB", Go: "JB", CPUID: "", Encoding: "0F 82 cw", Operands: "rel16"},
+	{Intel: "JB", Go: "JB", CPUID: "", Encoding: "0F 82 cd", Operands: "rel32"},
+	{Intel: "JB", Go: "JB", CPUID: "", Encoding: "72 cb", Operands: "rel8"},
+	{Intel: "JBE", Go: "JBE", CPUID: "", Encoding: "0F 86 cw", Operands: "rel16"},
+	{Intel: "JBE", Go: "JBE", CPUID: "", Encoding: "0F 86 cd", Operands: "rel32"},
+	{Intel: "JBE", Go: "JBE", CPUID: "", Encoding: "76 cb", Operands: "rel8"},
+	{Intel: "JC", Go: "JC", CPUID: "", Encoding: "0F 82 cw", Operands: "rel16"},
+	{Intel: "JC", Go: "JC", CPUID: "", Encoding: "0F 82 cd", Operands: "rel32"},
+	{Intel: "JC", Go: "JC", CPUID: "", Encoding: "72 cb", Operands: "rel8"},
+	{Intel: "JCXZ", Go: "JCXZ", CPUID: "", Encoding: "E3 cb", Operands: "rel8"},
+	{Intel: "JE", Go: "JE", CPUID: "", Encoding: "0F 84 cw", Operands: "rel16"},
+	{Intel: "JE", Go: "JE", CPUID: "", Encoding: "0F 84 cd", Operands: "rel32"},
+	{Intel: "JE", Go: "JE", CPUID: "", Encoding: "74 cb", Operands: "rel8"},
+	{Intel: "JECXZ", Go: "JECXZ", CPUID: "", Encoding: "E3 cb", Operands: "rel8"},
+	{Intel: "JG", Go: "JG", CPUID: "", Encoding: "0F 8F cw", Operands: "rel16"},
+	{Intel: "JG", Go: "JG", CPUID: "", Encoding: "0F 8F cd", Operands: "rel32"},
+	{Intel: "JG", Go: "JG", CPUID: "", Encoding: "7F cb", Operands: "rel8"},
+	{Intel: "JGE", Go: "JGE", CPUID: "", Encoding: "0F 8D cw", Operands: "rel16"},
+	{Intel: "JGE", Go: "JGE", CPUID: "", Encoding: "0F 8D cd", Operands: "rel32"},
+	{Intel: "JGE", Go: "JGE", CPUID: "", Encoding: "7D cb", Operands: "rel8"},
+	{Intel: "JL", Go: "JL", CPUID: "", Encoding: "0F 8C cw", Operands: "rel16"},
+	{Intel: "JL", Go: "JL", CPUID: "", Encoding: "0F 8C cd", Operands: "rel32"},
+	{Intel: "JL", Go: "JL", CPUID: "", Encoding: "7C cb", Operands: "rel8"},
+	{Intel: "JLE", Go: "JLE", CPUID: "", Encoding: "0F 8E cw", Operands: "rel16"},
+	{Intel: "JLE", Go: "JLE", CPUID: "", Encoding: "0F 8E cd", Operands: "rel32"},
+	{Intel: "JLE", Go: "JLE", CPUID: "", Encoding: "7E cb", Operands: "rel8"},
+	{Intel: "JMP", Go: "JMPW", CPUID: "", Encoding: "FF /4", Operands: "r/m16"},
+	{Intel: "JMP", Go: "JMPL", CPUID: "", Encoding: "FF /4", Operands: "r/m32"},
+	{Intel: "JMP", Go: "JMPQ", CPUID: "", Encoding: "FF /4", Operands: "r/m64"},
+	{Intel: "JMP", Go: "JMP", CPUID: "", Encoding: "E9 cw", Operands: "rel16"},
+	{Intel: "JMP", Go: "JMP", CPUID: "", Encoding: "E9 cd", Operands: "rel32"},
+	{Intel: "JMP", Go: "JMP", CPUID: "", Encoding: "EB cb", Operands: "rel8"},
+	{Intel: "JMP_FAR", Go: "LJMPW", CPUID: "", Encoding: "FF /5", Operands: "m16:16"},
+	{Intel: "JMP_FAR", Go: "LJMPL", CPUID: "", Encoding: "FF /5", Operands: "m16:32"},
+	{Intel: "JMP_FAR", Go: "LJMPQ", CPUID: "", Encoding: "REX.W FF /5", Operands: "m16:64"},
+	{Intel: "JMP_FAR", Go: "LJMPW", CPUID: "", Encoding: "EA cd", Operands: "ptr16:16"},
+	{Intel: "JMP_FAR", Go: "LJMPL", CPUID: "", Encoding: "EA cp", Operands: "ptr16:32"},
+	{Intel: "JNA", Go: "JNA", CPUID: "", Encoding: "0F 86 cw", Operands: "rel16"},
+	{Intel: "JNA", Go: "JNA", CPUID: "", Encoding: "0F 86 cd", Operands: "rel32"},
+	{Intel: "JNA", Go: "JNA", CPUID: "", Encoding: "76 cb", Operands: "rel8"},
+	{Intel: "JNAE", Go: "JNAE", CPUID: "", Encoding: "0F 82 cw", Operands: "rel16"},
+	{Intel: "JNAE", Go: "JNAE", CPUID: "", Encoding: "0F 82 cd", Operands: "rel32"},
+	{Intel: "JNAE", Go: "JNAE", CPUID: "", Encoding: "72 cb", Operands: "rel8"},
+	{Intel: "JNB", Go: "JNB", CPUID: "", Encoding: "0F 83 cw", Operands: "rel16"},
+	{Intel: "JNB", Go: "JNB", CPUID: "", Encoding: "0F 83 cd", Operands: "rel32"},
+	{Intel: "JNB", Go: "JNB", CPUID: "", Encoding: "73 cb", Operands: "rel8"},
+	{Intel: "JNBE", Go: "JNBE", CPUID: "", Encoding: "0F 87 cw", Operands: "rel16"},
+	{Intel: "JNBE", Go: "JNBE", CPUID: "", Encoding: "0F 87 cd", Operands: "rel32"},
+	{Intel: "JNBE", Go: "JNBE", CPUID: "", Encoding: "77 cb", Operands: "rel8"},
+	{Intel: "JNC", Go: "JNC", CPUID: "", Encoding: "0F 83 cw", Operands: "rel16"},
+	{Intel: "JNC", Go: "JNC", CPUID: "", Encoding: "0F 83 cd", Operands: "rel32"},
+	{Intel: "JNC", Go: "JNC", CPUID: "", Encoding: "73 cb", Operands: "rel8"},
+	{Intel: "JNE", Go: "JNE", CPUID: "", Encoding: "0F 85 cw", Operands: "rel16"},
+	{Intel: "JNE", Go: "JNE", CPUID: "", Encoding: "0F 85 cd", Operands: "rel32"},
+	{Intel: "JNE", Go: "JNE", CPUID: "", Encoding: "75 cb", Operands: "rel8"},
+	{Intel: "JNG", Go: "JNG", CPUID: "", Encoding: "0F 8E cw", Operands: "rel16"},
+	{Intel: "JNG", Go: "JNG", CPUID: "", Encoding: "0F 8E cd", Operands: "rel32"},
+	{Intel: "JNG", Go: "JNG", CPUID: "", Encoding: "7E cb", Operands: "rel8"},
+	{Intel: "JNGE", Go: "JNGE", CPUID: "", Encoding: "0F 8C cw", Operands: "rel16"},
+	{Intel: "JNGE", Go: "JNGE", CPUID: "", Encoding: "0F 8C cd", Operands: "rel32"},
+	{Intel: "JNGE", Go: "JNGE", CPUID: "", Encoding: "7C cb", Operands: "rel8"},
+	{Intel: "JNL", Go: "JNL", CPUID: "", Encoding: "0F 8D cw", Operands: "rel16"},
+	{Intel: "JNL", Go: "JNL", CPUID: "", Encoding: "0F 8D cd", Operands: "rel32"},
+	{Intel: "JNL", Go: "JNL", CPUID: "", Encoding: "7D cb", Operands: "rel8"},
+	{Intel: "JNLE", Go: "JNLE", CPUID: "", Encoding: "0F 8F cw", Operands: "rel16"},
+	{Intel: "JNLE", Go: "JNLE", CPUID: "", Encoding: "0F 8F cd", Operands: "rel32"},
+	{Intel: "JNLE", Go: "JNLE", CPUID: "", Encoding: "7F cb", Operands: "rel8"},
+	{Intel: "JNO", Go: "JNO", CPUID: "", Encoding: "0F 81 cw", Operands: "rel16"},
+	{Intel: "JNO", Go: "JNO", CPUID: "", Encoding: "0F 81 cd", Operands: "rel32"},
+	{Intel: "JNO", Go: "JNO", CPUID: "", Encoding: "71 cb", Operands: "rel8"},
+	{Intel: "JNP", Go: "JNP", CPUID: "", Encoding: "0F 8B cw", Operands: "rel16"},
+	{Intel: "JNP", Go: "JNP", CPUID: "", Encoding: "0F 8B cd", Operands: "rel32"},
+	{Intel: "JNP", Go: "JNP", CPUID: "", Encoding: "7B cb", Operands: "rel8"},
+	{Intel: "JNS", Go: "JNS", CPUID: "", Encoding: "0F 89 cw", Operands: "rel16"},
+	{Intel: "JNS", Go: "JNS", CPUID: "", Encoding: "0F 89 cd", Operands: "rel32"},
+	{Intel: "JNS", Go: "JNS", CPUID: "", Encoding: "79 cb", Operands: "rel8"},
+	{Intel: "JNZ", Go: "JNZ", CPUID: "", Encoding: "0F 85 cw", Operands: "rel16"},
+	{Intel: "JNZ", Go: "JNZ", CPUID: "", Encoding: "0F 85 cd", Operands: "rel32"},
+	{Intel: "JNZ", Go: "JNZ", CPUID: "", Encoding: "75 cb", Operands: "rel8"},
+	{Intel: "JO", Go: "JO", CPUID: "", Encoding: "0F 80 cw", Operands: "rel16"},
+	{Intel: "JO", Go: "JO", CPUID: "", Encoding: "0F 80 cd", Operands: "rel32"},
+	{Intel: "JO", Go: "JO", CPUID: "", Encoding: "70 cb", Operands: "rel8"},
+	{Intel: "JP", Go: "JP", CPUID: "", Encoding: "0F 8A cw", Operands: "rel16"},
+	{Intel: "JP", Go: "JP", CPUID: "", Encoding: "0F 8A cd", Operands: "rel32"},
+	{Intel: "JP", Go: "JP", CPUID: "", Encoding: "7A cb", Operands: "rel8"},
+	{Intel: "JPE", Go: "JPE", CPUID: "", Encoding: "0F 8A cw", Operands: "rel16"},
+	{Intel: "JPE", Go: "JPE", CPUID: "", Encoding: "0F 8A cd", Operands: "rel32"},
+	{Intel: "JPE", Go: "JPE", CPUID: "", Encoding: "7A cb", Operands: "rel8"},
+	{Intel: "JPO", Go: "JPO", CPUID: "", Encoding: "0F 8B cw", Operands: "rel16"},
+	{Intel: "JPO", Go: "JPO", CPUID: "", Encoding: "0F 8B cd", Operands: "rel32"},
+	{Intel: "JPO", Go: "JPO", CPUID: "", Encoding: "7B cb", Operands: "rel8"},
+	{Intel: "JRCXZ", Go: "JRCXZ", CPUID: "", Encoding: "E3 cb", Operands: "rel8"},
+	{Intel: "JS", Go: "JS", CPUID: "", Encoding: "0F 88 cw", Operands: "rel16"},
+	{Intel: "JS", Go: "JS", CPUID: "", Encoding: "0F 88 cd", Operands: "rel32"},
+	{Intel: "JS", Go: "JS", CPUID: "", Encoding: "78 cb", Operands: "rel8"},
+	{Intel: "JZ", Go: "JZ", CPUID: "", Encoding: "0F 84 cw", Operands: "rel16"},
+	{Intel: "JZ", Go: "JZ", CPUID: "", Encoding: "0F 84 cd", Operands: "rel32"},
+	{Intel: "JZ", Go: "JZ", CPUID: "", Encoding: "74 cb", Operands: "rel8"},
+	{Intel: "LAHF", Go: "LAHF", CPUID: "LAHF-SAHF", Encoding: "9F", Operands: ""},
+	{Intel: "LAR", Go: "LARW", CPUID: "", Encoding: "0F 02 /r", Operands: "r16, r/m16"},
+	{Intel: "LAR", Go: "LARL", CPUID: "", Encoding: "0F 02 /r", Operands: "r32, r32/m16"},
+	{Intel: "LAR", Go: "LARQ", CPUID: "", Encoding: "REX.W 0F 02 /r", Operands: "r64, r/m16"},
+	{Intel: "LDDQU", Go: "LDDQU", CPUID: "SSE3", Encoding: "F2 0F F0 /r", Operands: "xmm1, m128"},
+	{Intel: "LDMXCSR", Go: "LDMXCSR", CPUID: "SSE", Encoding: "0F AE /2", Operands: "m32"},
+	{Intel: "LDS", Go: "LDSW", CPUID: "", Encoding: "C5 /r", Operands: "r16, m16:16"},
+	{Intel: "LDS", Go: "LDSL", CPUID: "", Encoding: "C5 /r", Operands: "r32, m16:32"},
+	{Intel: "LEA", Go: "LEAW", CPUID: "", Encoding: "8D /r", Operands: "r16, m"},
+	{Intel: "LEA", Go: "LEAL", CPUID: "", Encoding: "8D /r", Operands: "r32, m"},
+	{Intel: "LEA", Go: "LEAQ", CPUID: "", Encoding: "REX.W 8D /r", Operands: "r64, m"},
+	{Intel: "LEAVE", Go: "LEAVEW/LEAVEL/LEAVEQ", CPUID: "", Encoding: "C9", Operands: ""},
+	{Intel: "LES", Go: "LESW", CPUID: "", Encoding: "C4 /r", Operands: "r16, m16:16"},
+	{Intel: "LES", Go: "LESL", CPUID: "", Encoding: "C4 /r", Operands: "r32, m16:32"},
+	{Intel: "LFENCE", Go: "LFENCE", CPUID: "SSE2", Encoding: "0F AE E8", Operands: ""},
+	{Intel: "LFS", Go: "LFSW", CPUID: "", Encoding: "0F B4 /r", Operands: "r16, m16:16"},
+	{Intel: "LFS", Go: "LFSL", CPUID: "", Encoding: "0F B4 /r", Operands: "r32, m16:32"},
+	{Intel: "LFS", Go: "LFSQ", CPUID: "", Encoding: "REX.W 0F B4 /r", Operands: "r64, m16:64"},
+	{Intel: "LGDT", Go: "LGDTW/LGDTL", CPUID: "", Encoding: "0F 01 /2", Operands: "m16&32"},
+	{Intel: "LGDT", Go: "LGDT", CPUID: "", Encoding: "0F 01 /2", Operands: "m16&64"},
+	{Intel: "LGS", Go: "LGSW", CPUID: "", Encoding: "0F B5 /r", Operands: "r16, m16:16"},
+	{Intel: "LGS", Go: "LGSL", CPUID: "", Encoding: "0F B5 /r", Operands: "r32, m16:32"},
+	{Intel: "LGS", Go: "LGSQ", CPUID: "", Encoding: "REX.W 0F B5 /r", Operands: "r64, m16:64"},
+	{Intel: "LIDT", Go: "LIDTW/LIDTL", CPUID: "", Encoding: "0F 01 /3", Operands: "m16&32"},
+	{Intel: "LIDT", Go: "LIDT", CPUID: "", Encoding: "0F 01 /3", Operands: "m16&64"},
+	{Intel: "LLDT", Go: "LLDT", CPUID: "", Encoding: "0F 00 /2", Operands: "r/m16"},
+	{Intel: "LMSW", Go: "LMSW", CPUID: "", Encoding: "0F 01 /6", Operands: "r/m16"},
+	{Intel: "LOCK", Go: "LOCK", CPUID: "", Encoding: "F0", Operands: ""},
+	{Intel: "LODSB", Go: "LODSB", CPUID: "", Encoding: "AC", Operands: ""},
+	{Intel: "LODSD", Go: "LODSL", CPUID: "", Encoding: "AD", Operands: ""},
+	{Intel: "LODSQ", Go: "LODSQ", CPUID: "", Encoding: "REX.W AD", Operands: ""},
+	{Intel: "LODSW", Go: "LODSW", CPUID: "", Encoding: "AD", Operands: ""},
+	{Intel: "LOOP", Go: "LOOP", CPUID: "", Encoding: "E2 cb", Operands: "rel8"},
+	{Intel: "LOOPE", Go: "LOOPEQ", CPUID: "", Encoding: "E1 cb", Operands: "rel8"},
+	{Intel: "LOOPNE", Go: "LOOPNE", CPUID: "", Encoding: "E0 cb", Operands: "rel8"},
+	{Intel: "LSL", Go: "LSLW", CPUID: "", Encoding: "0F 03 /r", Operands: "r16, r/m16"},
+	{Intel: "LSL", Go: "LSLL", CPUID: "", Encoding: "0F 03 /r", Operands: "r32, r32/m16"},
+	{Intel: "LSL", Go: "LSLQ", CPUID: "", Encoding: "REX.W 0F 03 /r", Operands: "r64, r32/m16"},
+	{Intel: "LSS", Go: "LSSW", CPUID: "", Encoding: "0F B2 /r", Operands: "r16, m16:16"},
+	{Intel: "LSS", Go: "LSSL", CPUID: "", Encoding: "0F B2 /r", Operands: "r32, m16:32"},
+	{Intel: "LSS", Go: "LSSQ", CPUID: "", Encoding: "REX.W 0F B2 /r", Operands: "r64, m16:64"},
+	{Intel: "LTR", Go: "LTR", CPUID: "", Encoding: "0F 00 /3", Operands: "r/m16"},
+	{Intel: "LZCNT", Go: "LZCNTW", CPUID: "LZCNT", Encoding: "F3 0F BD /r", Operands: "r16, r/m16"},
+	{Intel: "LZCNT", Go: "LZCNTL", CPUID: "LZCNT", Encoding: "F3 0F BD /r", Operands: "r32, r/m32"},
+	{Intel: "LZCNT", Go: "LZCNTQ", CPUID: "LZCNT", Encoding: "F3 REX.W 0F BD /r", Operands: "r64, r/m64"},
+	{Intel: "MASKMOVDQU", Go: "MASKMOVOU", CPUID: "SSE2", Encoding: "66 0F F7 /r", Operands: "xmm1, xmm2"},
+	{Intel: "MASKMOVQ", Go: "MASKMOVQ", CPUID: "SSE", Encoding: "0F F7 /r", Operands: "mm1, mm2"},
+	{Intel: "MAXPD", Go: "MAXPD", CPUID: "SSE2", Encoding: "66 0F 5F /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MAXPS", Go: "MAXPS", CPUID: "SSE", Encoding: "0F 5F /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MAXSD", Go: "MAXSD", CPUID: "SSE2", Encoding: "F2 0F 5F /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "MAXSS", Go: "MAXSS", CPUID: "SSE", Encoding: "F3 0F 5F /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "MFENCE", Go: "MFENCE", CPUID: "SSE2", Encoding: "0F AE F0", Operands: ""},
+	{Intel: "MINPD", Go: "MINPD", CPUID: "SSE2", Encoding: "66 0F 5D /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MINPS", Go: "MINPS", CPUID: "SSE", Encoding: "0F 5D /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MINSD", Go: "MINSD", CPUID: "SSE2", Encoding: "F2 0F 5D /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "MINSS", Go: "MINSS", CPUID: "SSE", Encoding: "F3 0F 5D /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "MONITOR", Go: "MONITOR", CPUID: "MONITOR", Encoding: "0F 01 C8", Operands: ""},
+	{Intel: "MOV", Go: "MOVB/MOVB/MOVABSB", CPUID: "", Encoding: "A0 cm", Operands: "AL, moffs8"},
+	{Intel: "MOV", Go: "MOVB/MOVB/MOVABSB", CPUID: "", Encoding: "REX.W A0 cm", Operands: "AL, moffs8"},
+	{Intel: "MOV", Go: "MOVW", CPUID: "", Encoding: "A1 cm", Operands: "AX, moffs16"},
+	{Intel: "MOV", Go: "MOVL", CPUID: "", Encoding: "0F 22 /r", Operands: "CR0-CR7, rmr32"},
+	{Intel: "MOV", Go: "MOVQ", CPUID: "", Encoding: "0F 22 /r", Operands: "CR0-CR7, rmr64"},
+	{Intel: "MOV", Go: "MOVQ", CPUID: "", Encoding: "REX.R + 0F 22 /0", Operands: "CR8, rmr64"},
+	{Intel: "MOV", Go: "MOVL", CPUID: "", Encoding: "0F 23 /r", Operands: "DR0-DR7, rmr32"},
+	{Intel: "MOV", Go: "MOVQ", CPUID: "", Encoding: "0F 23 /r", Operands: "DR0-DR7, rmr64"},
+	{Intel: "MOV", Go: "MOVL", CPUID: "", Encoding: "A1 cm", Operands: "EAX, moffs32"},
+	{Intel: "MOV", Go: "MOVQ", CPUID: "", Encoding: "REX.W A1 cm", Operands: "RAX, moffs64"},
+	{Intel: "MOV", Go: "MOVW", CPUID: "", Encoding: "8E /r", Operands: "Sreg, r/m16"},
+	{Intel: "MOV", Go: "MOVW", CPUID: "", Encoding: "REX.W 8E /r", Operands: "Sreg, r/m16"},
+	{Intel: "MOV", Go: "MOV{L/W}", CPUID: "", Encoding: "8E /r", Operands: "Sreg, r32/m16"},
+	{Intel: "MOV", Go: "MOVW", CPUID: "", Encoding: "A3 cm", Operands: "moffs16, AX"},
+	{Intel: "MOV", Go: "MOVL", CPUID: "", Encoding: "A3 cm", Operands: "moffs32, EAX"},
+	{Intel: "MOV", Go: "MOVQ", CPUID: "", Encoding: "REX.W A3 cm", Operands: "moffs64, RAX"},
+	{Intel: "MOV", Go: "MOVB/MOVB/MOVABSB", CPUID: "", Encoding: "A2 cm", Operands: "moffs8, AL"},
+	{Intel: "MOV", Go: "MOVB/MOVB/MOVABSB", CPUID: "", Encoding: "REX.W A2 cm", Operands: "moffs8, AL"},
+	{Intel: "MOV", Go: "MOVW", CPUID: "", Encoding: "8C /r", Operands: "r/m16, Sreg"},
+	{Intel: "MOV", Go: "MOVW", CPUID: "", Encoding: "REX.W 8C /r", Operands: "r/m16, Sreg"},
+	{Intel: "MOV", Go: "MOVW", CPUID: "", Encoding: "C7 /0 iw", Operands: "r/m16, imm16"},
+	{Intel: "MOV", Go: "MOVW", CPUID: "", Encoding: "89 /r", Operands: "r/m16, r16"},
+	{Intel: "MOV", Go: "MOVL", CPUID: "", Encoding: "8C /r", Operands: "r/m32, Sreg"},
+	{Intel: "MOV", Go: "MOVL", CPUID: "", Encoding: "C7 /0 id", Operands: "r/m32, imm32"},
+	{Intel: "MOV", Go: "MOVL", CPUID: "", Encoding: "89 /r", Operands: "r/m32, r32"},
+	{Intel: "MOV", Go: "MOVQ", CPUID: "", Encoding: "REX.W C7 /0 id", Operands: "r/m64, imm32"},
+	{Intel: "MOV", Go: "MOVQ", CPUID: "", Encoding: "REX.W 89 /r", Operands: "r/m64, r64"},
+	{Intel: "MOV", Go: "MOVB", CPUID: "", Encoding: "C6 /0 ib", Operands: "r/m8, imm8u"},
+	{Intel: "MOV", Go: "MOVB", CPUID: "", Encoding: "REX C6 /0 ib", Operands: "r/m8, imm8u"},
+	{Intel: "MOV", Go: "MOVB", CPUID: "", Encoding: "88 /r", Operands: "r/m8, r8"},
+	{Intel: "MOV", Go: "MOVB", CPUID: "", Encoding: "REX 88 /r", Operands: "r/m8, r8"},
+	{Intel: "MOV", Go: "MOVW", CPUID: "", Encoding: "8B /r", Operands: "r16, r/m16"},
+	{Intel: "MOV", Go: "MOVW", CPUID: "", Encoding: "B8+rw iw", Operands: "r16op, imm16"},
+	{Intel: "MOV", Go: "MOVL", CPUID: "", Encoding: "8B /r", Operands: "r32, r/m32"},
+	{Intel: "MOV", Go: "MOVL", CPUID: "", Encoding: "B8+rd id", Operands: "r32op, imm32"},
+	{Intel: "MOV", Go: "MOVQ", CPUID: "", Encoding: "REX.W 8B /r", Operands: "r64, r/m64"},
+	{Intel: "MOV", Go: "MOVQ", CPUID: "", Encoding: "REX.W B8+rd io", Operands: "r64op, imm64"},
+	{Intel: "MOV", Go: "MOVB", CPUID: "", Encoding: "8A /r", Operands: "r8, r/m8"},
+	{Intel: "MOV", Go: "MOVB", CPUID: "", Encoding: "REX 8A /r", Operands: "r8, r/m8"},
+	{Intel: "MOV", Go: "MOVB", CPUID: "", Encoding: "B0+rb ib", Operands: "r8op, imm8u"},
+	{Intel: "MOV", Go: "MOVB", CPUID: "", Encoding: "REX B0+rb ib", Operands: "r8op, imm8u"},
+	{Intel: "MOV", Go: "MOVL", CPUID: "", Encoding: "0F 20 /r", Operands: "rmr32, CR0-CR7"},
+	{Intel: "MOV", Go: "MOVL", CPUID: "", Encoding: "0F 21 /r", Operands: "rmr32, DR0-DR7"},
+	{Intel: "MOV", Go: "MOVQ", CPUID: "", Encoding: "0F 20 /r", Operands: "rmr64, CR0-CR7"},
+	{Intel: "MOV", Go: "MOVQ", CPUID: "", Encoding: "REX.R + 0F 20 /0", Operands: "rmr64, CR8"},
+	{Intel: "MOV", Go: "MOVQ", CPUID: "", Encoding: "0F 21 /r", Operands: "rmr64, DR0-DR7"},
+	{Intel: "MOVAPD", Go: "MOVAPD", CPUID: "SSE2", Encoding: "66 0F 28 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MOVAPD", Go: "MOVAPD", CPUID: "SSE2", Encoding: "66 0F 29 /r", Operands: "xmm2/m128, xmm1"},
+	{Intel: "MOVAPS", Go: "MOVAPS", CPUID: "SSE", Encoding: "0F 28 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MOVAPS", Go: "MOVAPS", CPUID: "SSE", Encoding: "0F 29 /r", Operands: "xmm2/m128, xmm1"},
+	{Intel: "MOVBE", Go: "MOVBEWW", CPUID: "MOVBE", Encoding: "0F 38 F1 /r", Operands: "m16, r16"},
+	{Intel: "MOVBE", Go: "MOVBELL", CPUID: "MOVBE", Encoding: "0F 38 F1 /r", Operands: "m32, r32"},
+	{Intel: "MOVBE", Go: "MOVBEQQ", CPUID: "MOVBE", Encoding: "REX.W 0F 38 F1 /r", Operands: "m64, r64"},
+	{Intel: "MOVBE", Go: "MOVBEWW", CPUID: "MOVBE", Encoding: "0F 38 F0 /r", Operands: "r16, m16"},
+	{Intel: "MOVBE", Go: "MOVBELL", CPUID: "MOVBE", Encoding: "0F 38 F0 /r", Operands: "r32, m32"},
+	{Intel: "MOVBE", Go: "MOVBEQQ", CPUID: "MOVBE", Encoding: "REX.W 0F 38 F0 /r", Operands: "r64, m64"},
+	{Intel: "MOVD", Go: "MOVD", CPUID: "MMX", Encoding: "0F 6E /r", Operands: "mm1, r/m32"},
+	{Intel: "MOVD", Go: "MOVD", CPUID: "MMX", Encoding: "0F 7E /r", Operands: "r/m32, mm1"},
+	{Intel: "MOVD", Go: "MOVD", CPUID: "SSE2", Encoding: "66 0F 7E /r", Operands: "r/m32, xmm1"},
+	{Intel: "MOVD", Go: "MOVD", CPUID: "SSE2", Encoding: "66 0F 6E /r", Operands: "xmm1, r/m32"},
+	{Intel: "MOVDDUP", Go: "MOVDDUP", CPUID: "SSE3", Encoding: "F2 0F 12 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "MOVDQ2Q", Go: "MOVQ", CPUID: "SSE2", Encoding: "F2 0F D6 /r", Operands: "mm1, xmm2"},
+	{Intel: "MOVDQA", Go: "MOVO", CPUID: "SSE2", Encoding: "66 0F 6F /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MOVDQA", Go: "MOVO", CPUID: "SSE2", Encoding: "66 0F 7F /r", Operands: "xmm2/m128, xmm1"},
+	{Intel: "MOVDQU", Go: "MOVOU", CPUID: "SSE2", Encoding: "F3 0F 6F /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MOVDQU", Go: "MOVOU", CPUID: "SSE2", Encoding: "F3 0F 7F /r", Operands: "xmm2/m128, xmm1"},
+	{Intel: "MOVHLPS", Go: "MOVHLPS", CPUID: "SSE", Encoding: "0F 12 /r", Operands: "xmm1, xmm2"},
+	{Intel: "MOVHPD", Go: "MOVHPD", CPUID: "SSE2", Encoding: "66 0F 17 /r", Operands: "m64, xmm1"},
+	{Intel: "MOVHPD", Go: "MOVHPD", CPUID: "SSE2", Encoding: "66 0F 16 /r", Operands: "xmm1, m64"},
+	{Intel: "MOVHPS", Go: "MOVHPS", CPUID: "SSE", Encoding: "0F 17 /r", Operands: "m64, xmm1"},
+	{Intel: "MOVHPS", Go: "MOVHPS", CPUID: "SSE", Encoding: "0F 16 /r", Operands: "xmm1, m64"},
+	{Intel: "MOVLHPS", Go: "MOVLHPS", CPUID: "SSE", Encoding: "0F 16 /r", Operands: "xmm1, xmm2"},
+	{Intel: "MOVLPD", Go: "MOVLPD", CPUID: "SSE2", Encoding: "66 0F 13 /r", Operands: "m64, xmm1"},
+	{Intel: "MOVLPD", Go: "MOVLPD", CPUID: "SSE2", Encoding: "66 0F 12 /r", Operands: "xmm1, m64"},
+	{Intel: "MOVLPS", Go: "MOVLPS", CPUID: "SSE", Encoding: "0F 13 /r", Operands: "m64, xmm1"},
+	{Intel: "MOVLPS", Go: "MOVLPS", CPUID: "SSE", Encoding: "0F 12 /r", Operands: "xmm1, m64"},
+	{Intel: "MOVMSKPD", Go: "MOVMSKPD", CPUID: "SSE2", Encoding: "66 0F 50 /r", Operands: "r32, xmm2"},
+	{Intel: "MOVMSKPS", Go: "MOVMSKPS", CPUID: "SSE", Encoding: "0F 50 /r", Operands: "r32, xmm2"},
+	{Intel: "MOVNTDQ", Go: "MOVNTO", CPUID: "SSE2", Encoding: "66 0F E7 /r", Operands: "m128, xmm1"},
+	{Intel: "MOVNTDQA", Go: "MOVNTDQA", CPUID: "SSE4_1", Encoding: "66 0F 38 2A /r", Operands: "xmm1, m128"},
+	{Intel: "MOVNTI", Go: "MOVNTIL", CPUID: "SSE2", Encoding: "0F C3 /r", Operands: "m32, r32"},
+	{Intel: "MOVNTI", Go: "MOVNTIQ", CPUID: "SSE2", Encoding: "REX.W 0F C3 /r", Operands: "m64, r64"},
+	{Intel: "MOVNTPD", Go: "MOVNTPD", CPUID: "SSE2", Encoding: "66 0F 2B /r", Operands: "m128, xmm1"},
+	{Intel: "MOVNTPS", Go: "MOVNTPS", CPUID: "SSE", Encoding: "0F 2B /r", Operands: "m128, xmm1"},
+	{Intel: "MOVNTQ", Go: "MOVNTQ", CPUID: "SSE", Encoding: "0F E7 /r", Operands: "m64, mm1"},
+	{Intel: "MOVNTSD", Go: "MOVNTSD", CPUID: "SSE", Encoding: "F2 0F 2B /r", Operands: "m64, xmm1"},
+	{Intel: "MOVNTSS", Go: "MOVNTSS", CPUID: "SSE", Encoding: "F3 0F 2B /r", Operands: "m32, xmm1"},
+	{Intel: "MOVQ", Go: "MOVQ", CPUID: "MMX", Encoding: "0F 6F /r", Operands: "mm1, mm2/m64"},
+	{Intel: "MOVQ", Go: "MOVQ", CPUID: "MMX", Encoding: "REX.W 0F 6E /r", Operands: "mm1, r/m64"},
+	{Intel: "MOVQ", Go: "MOVQ", CPUID: "MMX", Encoding: "0F 7F /r", Operands: "mm2/m64, mm1"},
+	{Intel: "MOVQ", Go: "MOVQ", CPUID: "MMX", Encoding: "REX.W 0F 7E /r", Operands: "r/m64, mm1"},
+	{Intel: "MOVQ", Go: "MOVQ", CPUID: "SSE2", Encoding: "66 REX.W 0F 7E /r", Operands: "r/m64, xmm1"},
+	{Intel: "MOVQ", Go: "MOVQ", CPUID: "SSE2", Encoding: "66 REX.W 0F 6E /r", Operands: "xmm1, r/m64"},
+	{Intel: "MOVQ", Go: "MOVQ", CPUID: "SSE2", Encoding: "F3 0F 7E /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "MOVQ", Go: "MOVQ", CPUID: "SSE2", Encoding: "66 0F D6 /r", Operands: "xmm2/m64, xmm1"},
+	{Intel: "MOVQ2DQ", Go: "MOVQOZX", CPUID: "SSE2", Encoding: "F3 0F D6 /r", Operands: "xmm1, mm2"},
+	{Intel: "MOVSB", Go: "MOVSB", CPUID: "", Encoding: "A4", Operands: ""},
+	{Intel: "MOVSD", Go: "MOVSL", CPUID: "", Encoding: "A5", Operands: ""},
+	{Intel: "MOVSD", Go: "MOVSD", CPUID: "SSE2", Encoding: "F2 0F 10 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "MOVSD", Go: "MOVSD", CPUID: "SSE2", Encoding: "F2 0F 11 /r", Operands: "xmm2/m64, xmm1"},
+	{Intel: "MOVSHDUP", Go: "MOVSHDUP", CPUID: "SSE3", Encoding: "F3 0F 16 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MOVSLDUP", Go: "MOVSLDUP", CPUID: "SSE3", Encoding: "F3 0F 12 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MOVSQ", Go: "MOVSQ", CPUID: "", Encoding: "REX.W A5", Operands: ""},
+	{Intel: "MOVSS", Go: "MOVSS", CPUID: "SSE", Encoding: "F3 0F 10 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "MOVSS", Go: "MOVSS", CPUID: "SSE", Encoding: "F3 0F 11 /r", Operands: "xmm2/m32, xmm1"},
+	{Intel: "MOVSW", Go: "MOVSW", CPUID: "", Encoding: "A5", Operands: ""},
+	{Intel: "MOVSX", Go: "MOVSWW", CPUID: "", Encoding: "0F BF /r", Operands: "r16, r/m16"},
+	{Intel: "MOVSX", Go: "MOVBWSX", CPUID: "", Encoding: "0F BE /r", Operands: "r16, r/m8"},
+	{Intel: "MOVSX", Go: "MOVWLSX", CPUID: "", Encoding: "0F BF /r", Operands: "r32, r/m16"},
+	{Intel: "MOVSX", Go: "MOVBLSX", CPUID: "", Encoding: "0F BE /r", Operands: "r32, r/m8"},
+	{Intel: "MOVSX", Go: "MOVWQSX", CPUID: "", Encoding: "REX.W 0F BF /r", Operands: "r64, r/m16"},
+	{Intel: "MOVSX", Go: "MOVBQSX", CPUID: "", Encoding: "REX.W 0F BE /r", Operands: "r64, r/m8"},
+	{Intel: "MOVSXD", Go: "MOVWQSX", CPUID: "", Encoding: "63 /r", Operands: "r16, r/m32"},
+	{Intel: "MOVSXD", Go: "MOVLQSX", CPUID: "", Encoding: "63 /r", Operands: "r32, r/m32"},
+	{Intel: "MOVSXD", Go: "MOVLQSX", CPUID: "", Encoding: "REX.W 63 /r", Operands: "r64, r/m32"},
+	{Intel: "MOVUPD", Go: "MOVUPD", CPUID: "SSE2", Encoding: "66 0F 10 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MOVUPD", Go: "MOVUPD", CPUID: "SSE2", Encoding: "66 0F 11 /r", Operands: "xmm2/m128, xmm1"},
+	{Intel: "MOVUPS", Go: "MOVUPS", CPUID: "SSE", Encoding: "0F 10 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MOVUPS", Go: "MOVUPS", CPUID: "SSE", Encoding: "0F 11 /r", Operands: "xmm2/m128, xmm1"},
+	{Intel: "MOVZX", Go: "MOVZWW", CPUID: "", Encoding: "0F B7 /r", Operands: "r16, r/m16"},
+	{Intel: "MOVZX", Go: "MOVBWZX", CPUID: "", Encoding: "0F B6 /r", Operands: "r16, r/m8"},
+	{Intel: "MOVZX", Go: "MOVWLZX", CPUID: "", Encoding: "0F B7 /r", Operands: "r32, r/m16"},
+	{Intel: "MOVZX", Go: "MOVBLZX", CPUID: "", Encoding: "0F B6 /r", Operands: "r32, r/m8"},
+	{Intel: "MOVZX", Go: "MOVWQZX", CPUID: "", Encoding: "REX.W 0F B7 /r", Operands: "r64, r/m16"},
+	{Intel: "MOVZX", Go: "MOVBQZX", CPUID: "", Encoding: "REX.W 0F B6 /r", Operands: "r64, r/m8"},
+	{Intel: "MPSADBW", Go: "MPSADBW", CPUID: "SSE4_1", Encoding: "66 0F 3A 42 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "MUL", Go: "MULW", CPUID: "", Encoding: "F7 /4", Operands: "r/m16"},
+	{Intel: "MUL", Go: "MULL", CPUID: "", Encoding: "F7 /4", Operands: "r/m32"},
+	{Intel: "MUL", Go: "MULQ", CPUID: "", Encoding: "REX.W F7 /4", Operands: "r/m64"},
+	{Intel: "MUL", Go: "MULB", CPUID: "", Encoding: "F6 /4", Operands: "r/m8"},
+	{Intel: "MUL", Go: "MULB", CPUID: "", Encoding: "REX F6 /4", Operands: "r/m8"},
+	{Intel: "MULPD", Go: "MULPD", CPUID: "SSE2", Encoding: "66 0F 59 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MULPS", Go: "MULPS", CPUID: "SSE", Encoding: "0F 59 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "MULSD", Go: "MULSD", CPUID: "SSE2", Encoding: "F2 0F 59 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "MULSS", Go: "MULSS", CPUID: "SSE", Encoding: "F3 0F 59 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "MULX", Go: "MULXL", CPUID: "BMI2", Encoding: "VEX.NDD.LZ.F2.0F38.W0 F6 /r", Operands: "r32, r32V, r/m32"},
+	{Intel: "MULX", Go: "MULXQ", CPUID: "BMI2", Encoding: "VEX.NDD.LZ.F2.0F38.W1 F6 /r", Operands: "r64, r64V, r/m64"},
+	{Intel: "MWAIT", Go: "MWAIT", CPUID: "MONITOR", Encoding: "0F 01 C9", Operands: ""},
+	{Intel: "NEG", Go: "NEGW", CPUID: "", Encoding: "F7 /3", Operands: "r/m16"},
+	{Intel: "NEG", Go: "NEGL", CPUID: "", Encoding: "F7 /3", Operands: "r/m32"},
+	{Intel: "NEG", Go: "NEGQ", CPUID: "", Encoding: "REX.W F7 /3", Operands: "r/m64"},
+	{Intel: "NEG", Go: "NEGB", CPUID: "", Encoding: "F6 /3", Operands: "r/m8"},
+	{Intel: "NEG", Go: "NEGB", CPUID: "", Encoding: "REX F6 /3", Operands: "r/m8"},
+	{Intel: "NOP", Go: "NOP", CPUID: "", Encoding: "90", Operands: ""},
+	{Intel: "NOP", Go: "NOPW", CPUID: "", Encoding: "0F 1F /0", Operands: "r/m16"},
+	{Intel: "NOP", Go: "NOPL", CPUID: "", Encoding: "0F 1F /0", Operands: "r/m32"},
+	{Intel: "NOT", Go: "NOTW", CPUID: "", Encoding: "F7 /2", Operands: "r/m16"},
+	{Intel: "NOT", Go: "NOTL", CPUID: "", Encoding: "F7 /2", Operands: "r/m32"},
+	{Intel: "NOT", Go: "NOTQ", CPUID: "", Encoding: "REX.W F7 /2", Operands: "r/m64"},
+	{Intel: "NOT", Go: "NOTB", CPUID: "", Encoding: "F6 /2", Operands: "r/m8"},
+	{Intel: "NOT", Go: "NOTB", CPUID: "", Encoding: "REX F6 /2", Operands: "r/m8"},
+	{Intel: "OR", Go: "ORB", CPUID: "", Encoding: "0C ib", Operands: "AL, imm8"},
+	{Intel: "OR", Go: "ORW", CPUID: "", Encoding: "0D iw", Operands: "AX, imm16"},
+	{Intel: "OR", Go: "ORL", CPUID: "", Encoding: "0D id", Operands: "EAX, imm32"},
+	{Intel: "OR", Go: "ORQ", CPUID: "", Encoding: "REX.W 0D id", Operands: "RAX, imm32"},
+	{Intel: "OR", Go: "ORW", CPUID: "", Encoding: "81 /1 iw", Operands: "r/m16, imm16"},
+	{Intel: "OR", Go: "ORW", CPUID: "", Encoding: "83 /1 ib", Operands: "r/m16, imm8"},
+	{Intel: "OR", Go: "ORW", CPUID: "", Encoding: "09 /r", Operands: "r/m16, r16"},
+	{Intel: "OR", Go: "ORL", CPUID: "", Encoding: "81 /1 id", Operands: "r/m32, imm32"},
+	{Intel: "OR", Go: "ORL", CPUID: "", Encoding: "83 /1 ib", Operands: "r/m32, imm8"},
+	{Intel: "OR", Go: "ORL", CPUID: "", Encoding: "09 /r", Operands: "r/m32, r32"},
+	{Intel: "OR", Go: "ORQ", CPUID: "", Encoding: "REX.W 81 /1 id", Operands: "r/m64, imm32"},
+	{Intel: "OR", Go: "ORQ", CPUID: "", Encoding: "REX.W 83 /1 ib", Operands: "r/m64, imm8"},
+	{Intel: "OR", Go: "ORQ", CPUID: "", Encoding: "REX.W 09 /r", Operands: "r/m64, r64"},
+	{Intel: "OR", Go: "ORB", CPUID: "", Encoding: "80 /1 ib", Operands: "r/m8, imm8"},
+	{Intel: "OR", Go: "ORB", CPUID: "", Encoding: "REX 80 /1 ib", Operands: "r/m8, imm8"},
+	{Intel: "OR", Go: "ORB", CPUID: "", Encoding: "08 /r", Operands: "r/m8, r8"},
+	{Intel: "OR", Go: "ORB", CPUID: "", Encoding: "REX 08 /r", Operands: "r/m8, r8"},
+	{Intel: "OR", Go: "ORW", CPUID: "", Encoding: "0B /r", Operands: "r16, r/m16"},
+	{Intel: "OR", Go: "ORL", CPUID: "", Encoding: "0B /r", Operands: "r32, r/m32"},
+	{Intel: "OR", Go: "ORQ", CPUID: "", Encoding: "REX.W 0B /r", Operands: "r64, r/m64"},
+	{Intel: "OR", Go: "ORB", CPUID: "", Encoding: "0A /r", Operands: "r8, r/m8"},
+	{Intel: "OR", Go: "ORB", CPUID: "", Encoding: "REX 0A /r", Operands: "r8, r/m8"},
+	{Intel: "ORPD", Go: "ORPD", CPUID: "SSE2", Encoding: "66 0F 56 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "ORPS", Go: "ORPS", CPUID: "SSE", Encoding: "0F 56 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "OUT", Go: "OUTB", CPUID: "", Encoding: "EE", Operands: "DX, AL"},
+	{Intel: "OUT", Go: "OUTW", CPUID: "", Encoding: "EF", Operands: "DX, AX"},
+	{Intel: "OUT", Go: "OUTL", CPUID: "", Encoding: "EF", Operands: "DX, EAX"},
+	{Intel: "OUT", Go: "OUTB", CPUID: "", Encoding: "E6 ib", Operands: "imm8u, AL"},
+	{Intel: "OUT", Go: "OUTW", CPUID: "", Encoding: "E7 ib", Operands: "imm8u, AX"},
+	{Intel: "OUT", Go: "OUTL", CPUID: "", Encoding: "E7 ib", Operands: "imm8u, EAX"},
+	{Intel: "OUTSB", Go: "OUTSB", CPUID: "", Encoding: "6E", Operands: ""},
+	{Intel: "OUTSD", Go: "OUTSL", CPUID: "", Encoding: "6F", Operands: ""},
+	{Intel: "OUTSW", Go: "OUTSW", CPUID: "", Encoding: "6F", Operands: ""},
+	{Intel: "PABSB", Go: "PABSB", CPUID: "SSSE3", Encoding: "0F 38 1C /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PABSB", Go: "PABSB", CPUID: "SSSE3", Encoding: "66 0F 38 1C /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PABSD", Go: "PABSD", CPUID: "SSSE3", Encoding: "0F 38 1E /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PABSD", Go: "PABSD", CPUID: "SSSE3", Encoding: "66 0F 38 1E /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PABSW", Go: "PABSW", CPUID: "SSSE3", Encoding: "0F 38 1D /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PABSW", Go: "PABSW", CPUID: "SSSE3", Encoding: "66 0F 38 1D /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PACKSSDW", Go: "PACKSSLW", CPUID: "MMX", Encoding: "0F 6B /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PACKSSDW", Go: "PACKSSLW", CPUID: "SSE2", Encoding: "66 0F 6B /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PACKSSWB", Go: "PACKSSWB", CPUID: "MMX", Encoding: "0F 63 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PACKSSWB", Go: "PACKSSWB", CPUID: "SSE2", Encoding: "66 0F 63 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PACKUSDW", Go: "PACKUSDW", CPUID: "SSE4_1", Encoding: "66 0F 38 2B /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PACKUSWB", Go: "PACKUSWB", CPUID: "MMX", Encoding: "0F 67 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PACKUSWB", Go: "PACKUSWB", CPUID: "SSE2", Encoding: "66 0F 67 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PADDB", Go: "PADDB", CPUID: "MMX", Encoding: "0F FC /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PADDB", Go: "PADDB", CPUID: "SSE2", Encoding: "66 0F FC /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PADDD", Go: "PADDL", CPUID: "MMX", Encoding: "0F FE /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PADDD", Go: "PADDL", CPUID: "SSE2", Encoding: "66 0F FE /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PADDQ", Go: "PADDQ", CPUID: "SSE2", Encoding: "0F D4 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PADDQ", Go: "PADDQ", CPUID: "SSE2", Encoding: "66 0F D4 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PADDSB", Go: "PADDSB", CPUID: "MMX", Encoding: "0F EC /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PADDSB", Go: "PADDSB", CPUID: "SSE2", Encoding: "66 0F EC /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PADDSW", Go: "PADDSW", CPUID: "MMX", Encoding: "0F ED /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PADDSW", Go: "PADDSW", CPUID: "SSE2", Encoding: "66 0F ED /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PADDUSB", Go: "PADDUSB", CPUID: "MMX", Encoding: "0F DC /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PADDUSB", Go: "PADDUSB", CPUID: "SSE2", Encoding: "66 0F DC /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PADDUSW", Go: "PADDUSW", CPUID: "MMX", Encoding: "0F DD /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PADDUSW", Go: "PADDUSW", CPUID: "SSE2", Encoding: "66 0F DD /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PADDW", Go: "PADDW", CPUID: "MMX", Encoding: "0F FD /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PADDW", Go: "PADDW", CPUID: "SSE2", Encoding: "66 0F FD /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PALIGNR", Go: "PALIGNR", CPUID: "SSSE3", Encoding: "0F 3A 0F /r ib", Operands: "mm1, mm2/m64, imm8"},
+	{Intel: "PALIGNR", Go: "PALIGNR", CPUID: "SSSE3", Encoding: "66 0F 3A 0F /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "PAND", Go: "PAND", CPUID: "MMX", Encoding: "0F DB /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PAND", Go: "PAND", CPUID: "SSE2", Encoding: "66 0F DB /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PANDN", Go: "PANDN", CPUID: "MMX", Encoding: "0F DF /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PANDN", Go: "PANDN", CPUID: "SSE2", Encoding: "66 0F DF /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PAUSE", Go: "PAUSE", CPUID: "", Encoding: "F3 90", Operands: ""},
+	{Intel: "PAVGB", Go: "PAVGB", CPUID: "SSE", Encoding: "0F E0 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PAVGB", Go: "PAVGB", CPUID: "SSE2", Encoding: "66 0F E0 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PAVGW", Go: "PAVGW", CPUID: "SSE", Encoding: "0F E3 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PAVGW", Go: "PAVGW", CPUID: "SSE2", Encoding: "66 0F E3 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PBLENDVB", Go: "PBLENDVB", CPUID: "SSE4_1", Encoding: "66 0F 38 10 /r", Operands: "xmm1, xmm2/m128, <XMM0>"},
+	{Intel: "PBLENDW", Go: "PBLENDW", CPUID: "SSE4_1", Encoding: "66 0F 3A 0E /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "PCLMULQDQ", Go: "PCLMULQDQ", CPUID: "PCLMULQDQ", Encoding: "66 0F 3A 44 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "PCMPEQB", Go: "PCMPEQB", CPUID: "MMX", Encoding: "0F 74 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PCMPEQB", Go: "PCMPEQB", CPUID: "SSE2", Encoding: "66 0F 74 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PCMPEQD", Go: "PCMPEQL", CPUID: "MMX", Encoding: "0F 76 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PCMPEQD", Go: "PCMPEQL", CPUID: "SSE2", Encoding: "66 0F 76 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PCMPEQQ", Go: "PCMPEQQ", CPUID: "SSE4_1", Encoding: "66 0F 38 29 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PCMPEQW", Go: "PCMPEQW", CPUID: "MMX", Encoding: "0F 75 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PCMPEQW", Go: "PCMPEQW", CPUID: "SSE2", Encoding: "66 0F 75 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PCMPESTRI", Go: "PCMPESTRI", CPUID: "SSE4_2", Encoding: "66 0F 3A 61 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "PCMPESTRM", Go: "PCMPESTRM", CPUID: "SSE4_2", Encoding: "66 0F 3A 60 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "PCMPGTB", Go: "PCMPGTB", CPUID: "MMX", Encoding: "0F 64 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PCMPGTB", Go: "PCMPGTB", CPUID: "SSE2", Encoding: "66 0F 64 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PCMPGTD", Go: "PCMPGTL", CPUID: "MMX", Encoding: "0F 66 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PCMPGTD", Go: "PCMPGTL", CPUID: "SSE2", Encoding: "66 0F 66 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PCMPGTQ", Go: "PCMPGTQ", CPUID: "SSE4_2", Encoding: "66 0F 38 37 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PCMPGTW", Go: "PCMPGTW", CPUID: "MMX", Encoding: "0F 65 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PCMPGTW", Go: "PCMPGTW", CPUID: "SSE2", Encoding: "66 0F 65 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PCMPISTRI", Go: "PCMPISTRI", CPUID: "SSE4_2", Encoding: "66 0F 3A 63 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "PCMPISTRM", Go: "PCMPISTRM", CPUID: "SSE4_2", Encoding: "66 0F 3A 62 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "PDEP", Go: "PDEPL", CPUID: "BMI2", Encoding: "VEX.NDS.LZ.F2.0F38.W0 F5 /r", Operands: "r32, r32V, r/m32"},
+	{Intel: "PDEP", Go: "PDEPQ", CPUID: "BMI2", Encoding: "VEX.NDS.LZ.F2.0F38.W1 F5 /r", Operands: "r64, r64V, r/m64"},
+	{Intel: "PEXT", Go: "PEXTL", CPUID: "BMI2", Encoding: "VEX.NDS.LZ.F3.0F38.W0 F5 /r", Operands: "r32, r32V, r/m32"},
+	{Intel: "PEXT", Go: "PEXTQ", CPUID: "BMI2", Encoding: "VEX.NDS.LZ.F3.0F38.W1 F5 /r", Operands: "r64, r64V, r/m64"},
+	{Intel: "PEXTRB", Go: "PEXTRB", CPUID: "SSE4_1", Encoding: "66 0F 3A 14 /r ib", Operands: "r32/m8, xmm1, imm8"},
+	{Intel: "PEXTRD", Go: "PEXTRD", CPUID: "SSE4_1", Encoding: "66 0F 3A 16 /r ib", Operands: "r/m32, xmm1, imm8"},
+	{Intel: "PEXTRQ", Go: "PEXTRQ", CPUID: "SSE4_1", Encoding: "66 REX.W 0F 3A 16 /r ib", Operands: "r/m64, xmm1, imm8"},
+	{Intel: "PEXTRW", Go: "PEXTRW", CPUID: "SSE", Encoding: "0F C5 /r ib", Operands: "r32, mm2, imm8"},
+	{Intel: "PEXTRW", Go: "PEXTRW", CPUID: "SSE2", Encoding: "66 0F C5 /r ib", Operands: "r32, xmm2, imm8"},
+	{Intel: "PEXTRW", Go: "PEXTRW", CPUID: "SSE4_1", Encoding: "66 0F 3A 15 /r ib", Operands: "r32/m16, xmm1, imm8"},
+	{Intel: "PHADDD", Go: "PHADDD", CPUID: "SSSE3", Encoding: "0F 38 02 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PHADDD", Go: "PHADDD", CPUID: "SSSE3", Encoding: "66 0F 38 02 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PHADDSW", Go: "PHADDSW", CPUID: "SSSE3", Encoding: "0F 38 03 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PHADDSW", Go: "PHADDSW", CPUID: "SSSE3", Encoding: "66 0F 38 03 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PHADDW", Go: "PHADDW", CPUID: "SSSE3", Encoding: "0F 38 01 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PHADDW", Go: "PHADDW", CPUID: "SSSE3", Encoding: "66 0F 38 01 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PHMINPOSUW", Go: "PHMINPOSUW", CPUID: "SSE4_1", Encoding: "66 0F 38 41 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PHSUBD", Go: "PHSUBD", CPUID: "SSSE3", Encoding: "0F 38 06 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PHSUBD", Go: "PHSUBD", CPUID: "SSSE3", Encoding: "66 0F 38 06 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PHSUBSW", Go: "PHSUBSW", CPUID: "SSSE3", Encoding: "0F 38 07 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PHSUBSW", Go: "PHSUBSW", CPUID: "SSSE3", Encoding: "66 0F 38 07 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PHSUBW", Go: "PHSUBW", CPUID: "SSSE3", Encoding: "0F 38 05 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PHSUBW", Go: "PHSUBW", CPUID: "SSSE3", Encoding: "66 0F 38 05 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PINSRB", Go: "PINSRB", CPUID: "SSE4_1", Encoding: "66 0F 3A 20 /r ib", Operands: "xmm1, r32/m8, imm8"},
+	{Intel: "PINSRD", Go: "PINSRD", CPUID: "SSE4_1", Encoding: "66 0F 3A 22 /r ib", Operands: "xmm1, r/m32, imm8"},
+	{Intel: "PINSRQ", Go: "PINSRQ", CPUID: "SSE4_1", Encoding: "66 REX.W 0F 3A 22 /r ib", Operands: "xmm1, r/m64, imm8"},
+	{Intel: "PINSRW", Go: "PINSRW", CPUID: "SSE", Encoding: "0F C4 /r ib", Operands: "mm1, r32/m16, imm8"},
+	{Intel: "PINSRW", Go: "PINSRW", CPUID: "SSE2", Encoding: "66 0F C4 /r ib", Operands: "xmm1, r32/m16, imm8"},
+	{Intel: "PMADDUBSW", Go: "PMADDUBSW", CPUID: "SSSE3", Encoding: "0F 38 04 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PMADDUBSW", Go: "PMADDUBSW", CPUID: "SSSE3", Encoding: "66 0F 38 04 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMADDWD", Go: "PMADDWL", CPUID: "MMX", Encoding: "0F F5 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PMADDWD", Go: "PMADDWL", CPUID: "SSE2", Encoding: "66 0F F5 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMAXSB", Go: "PMAXSB", CPUID: "SSE4_1", Encoding: "66 0F 38 3C /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMAXSD", Go: "PMAXSD", CPUID: "SSE4_1", Encoding: "66 0F 38 3D /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMAXSW", Go: "PMAXSW", CPUID: "SSE", Encoding: "0F EE /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PMAXSW", Go: "PMAXSW", CPUID: "SSE2", Encoding: "66 0F EE /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMAXUB", Go: "PMAXUB", CPUID: "SSE", Encoding: "0F DE /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PMAXUB", Go: "PMAXUB", CPUID: "SSE2", Encoding: "66 0F DE /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMAXUD", Go: "PMAXUD", CPUID: "SSE4_1", Encoding: "66 0F 38 3F /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMAXUW", Go: "PMAXUW", CPUID: "SSE4_1", Encoding: "66 0F 38 3E /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMINSB", Go: "PMINSB", CPUID: "SSE4_1", Encoding: "66 0F 38 38 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMINSD", Go: "PMINSD", CPUID: "SSE4_1", Encoding: "66 0F 38 39 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMINSW", Go: "PMINSW", CPUID: "SSE", Encoding: "0F EA /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PMINSW", Go: "PMINSW", CPUID: "SSE2", Encoding: "66 0F EA /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMINUB", Go: "PMINUB", CPUID: "SSE", Encoding: "0F DA /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PMINUB", Go: "PMINUB", CPUID: "SSE2", Encoding: "66 0F DA /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMINUD", Go: "PMINUD", CPUID: "SSE4_1", Encoding: "66 0F 38 3B /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMINUW", Go: "PMINUW", CPUID: "SSE4_1", Encoding: "66 0F 38 3A /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMOVMSKB", Go: "PMOVMSKB", CPUID: "SSE", Encoding: "0F D7 /r", Operands: "r32, mm2"},
+	{Intel: "PMOVMSKB", Go: "PMOVMSKB", CPUID: "SSE2", Encoding: "66 0F D7 /r", Operands: "r32, xmm2"},
+	{Intel: "PMOVSXBD", Go: "PMOVSXBD", CPUID: "SSE4_1", Encoding: "66 0F 38 21 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "PMOVSXBQ", Go: "PMOVSXBQ", CPUID: "SSE4_1", Encoding: "66 0F 38 22 /r", Operands: "xmm1, xmm2/m16"},
+	{Intel: "PMOVSXBW", Go: "PMOVSXBW", CPUID: "SSE4_1", Encoding: "66 0F 38 20 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "PMOVSXDQ", Go: "PMOVSXDQ", CPUID: "SSE4_1", Encoding: "66 0F 38 25 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "PMOVSXWD", Go: "PMOVSXWD", CPUID: "SSE4_1", Encoding: "66 0F 38 23 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "PMOVSXWQ", Go: "PMOVSXWQ", CPUID: "SSE4_1", Encoding: "66 0F 38 24 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "PMOVZXBD", Go: "PMOVZXBD", CPUID: "SSE4_1", Encoding: "66 0F 38 31 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "PMOVZXBQ", Go: "PMOVZXBQ", CPUID: "SSE4_1", Encoding: "66 0F 38 32 /r", Operands: "xmm1, xmm2/m16"},
+	{Intel: "PMOVZXBW", Go: "PMOVZXBW", CPUID: "SSE4_1", Encoding: "66 0F 38 30 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "PMOVZXDQ", Go: "PMOVZXDQ", CPUID: "SSE4_1", Encoding: "66 0F 38 35 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "PMOVZXWD", Go: "PMOVZXWD", CPUID: "SSE4_1", Encoding: "66 0F 38 33 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "PMOVZXWQ", Go: "PMOVZXWQ", CPUID: "SSE4_1", Encoding: "66 0F 38 34 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "PMULDQ", Go: "PMULDQ", CPUID: "SSE4_1", Encoding: "66 0F 38 28 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMULHRSW", Go: "PMULHRSW", CPUID: "SSSE3", Encoding: "0F 38 0B /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PMULHRSW", Go: "PMULHRSW", CPUID: "SSSE3", Encoding: "66 0F 38 0B /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMULHUW", Go: "PMULHUW", CPUID: "SSE", Encoding: "0F E4 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PMULHUW", Go: "PMULHUW", CPUID: "SSE2", Encoding: "66 0F E4 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMULHW", Go: "PMULHW", CPUID: "MMX", Encoding: "0F E5 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PMULHW", Go: "PMULHW", CPUID: "SSE2", Encoding: "66 0F E5 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMULLD", Go: "PMULLD", CPUID: "SSE4_1", Encoding: "66 0F 38 40 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMULLW", Go: "PMULLW", CPUID: "MMX", Encoding: "0F D5 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PMULLW", Go: "PMULLW", CPUID: "SSE2", Encoding: "66 0F D5 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PMULUDQ", Go: "PMULULQ", CPUID: "SSE2", Encoding: "0F F4 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PMULUDQ", Go: "PMULULQ", CPUID: "SSE2", Encoding: "66 0F F4 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "POP", Go: "POPW/POPL/POPQ", CPUID: "", Encoding: "1F", Operands: "DS"},
+	{Intel: "POP", Go: "POPW/POPL/POPQ", CPUID: "", Encoding: "07", Operands: "ES"},
+	{Intel: "POP", Go: "POPW/POPL/POPQ", CPUID: "", Encoding: "0F A1", Operands: "FS"},
+	{Intel: "POP", Go: "POPW/POPL/POPQ", CPUID: "", Encoding: "0F A9", Operands: "GS"},
+	{Intel: "POP", Go: "POPW/POPL/POPQ", CPUID: "", Encoding: "17", Operands: "SS"},
+	{Intel: "POP", Go: "POPW", CPUID: "", Encoding: "8F /0", Operands: "r/m16"},
+	{Intel: "POP", Go: "POPL", CPUID: "", Encoding: "8F /0", Operands: "r/m32"},
+	{Intel: "POP", Go: "POPQ", CPUID: "", Encoding: "8F /0", Operands: "r/m64"},
+	{Intel: "POP", Go: "POPW", CPUID: "", Encoding: "58+rw", Operands: "r16op"},
+	{Intel: "POP", Go: "POPL", CPUID: "", Encoding: "58+rd", Operands: "r32op"},
+	{Intel: "POP", Go: "POPQ", CPUID: "", Encoding: "58+rd", Operands: "r64op"},
+	{Intel: "POPA", Go: "POPAW", CPUID: "", Encoding: "61", Operands: ""},
+	{Intel: "POPAD", Go: "POPAL", CPUID: "", Encoding: "61", Operands: ""},
+	{Intel: "POPCNT", Go: "POPCNTW", CPUID: "POPCNT", Encoding: "F3 0F B8 /r", Operands: "r16, r/m16"},
+	{Intel: "POPCNT", Go: "POPCNTL", CPUID: "POPCNT", Encoding: "F3 0F B8 /r", Operands: "r32, r/m32"},
+	{Intel: "POPCNT", Go: "POPCNTQ", CPUID: "POPCNT", Encoding: "F3 REX.W 0F B8 /r", Operands: "r64, r/m64"},
+	{Intel: "POPF", Go: "POPFW", CPUID: "", Encoding: "9D", Operands: ""},
+	{Intel: "POPFD", Go: "POPFL", CPUID: "", Encoding: "9D", Operands: ""},
+	{Intel: "POPFQ", Go: "POPFQ", CPUID: "", Encoding: "9D", Operands: ""},
+	{Intel: "POR", Go: "POR", CPUID: "MMX", Encoding: "0F EB /r", Operands: "mm1, mm2/m64"},
+	{Intel: "POR", Go: "POR", CPUID: "SSE2", Encoding: "66 0F EB /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PREFETCHNTA", Go: "PREFETCHNTA", CPUID: "SSE", Encoding: "0F 18 /0", Operands: "m8"},
+	{Intel: "PREFETCHT0", Go: "PREFETCHT0", CPUID: "SSE", Encoding: "0F 18 /1", Operands: "m8"},
+	{Intel: "PREFETCHT1", Go: "PREFETCHT1", CPUID: "SSE", Encoding: "0F 18 /2", Operands: "m8"},
+	{Intel: "PREFETCHT2", Go: "PREFETCHT2", CPUID: "SSE", Encoding: "0F 18 /3", Operands: "m8"},
+	{Intel: "PREFETCHW", Go: "PREFETCHW", CPUID: "PRFCHW", Encoding: "0F 0D /1", Operands: "m8"},
+	{Intel: "PREFETCHWT1", Go: "PREFETCHWT1", CPUID: "PREFETCHWT1", Encoding: "0F 0D /2", Operands: "m8"},
+	{Intel: "PSADBW", Go: "PSADBW", CPUID: "SSE", Encoding: "0F F6 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSADBW", Go: "PSADBW", CPUID: "SSE2", Encoding: "66 0F F6 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSHUFB", Go: "PSHUFB", CPUID: "SSSE3", Encoding: "0F 38 00 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSHUFB", Go: "PSHUFB", CPUID: "SSSE3", Encoding: "66 0F 38 00 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSHUFD", Go: "PSHUFD", CPUID: "SSE2", Encoding: "66 0F 70 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "PSHUFHW", Go: "PSHUFHW", CPUID: "SSE2", Encoding: "F3 0F 70 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "PSHUFLW", Go: "PSHUFLW", CPUID: "SSE2", Encoding: "F2 0F 70 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "PSHUFW", Go: "PSHUFW", CPUID: "SSE", Encoding: "0F 70 /r ib", Operands: "mm1, mm2/m64, imm8"},
+	{Intel: "PSIGNB", Go: "PSIGNB", CPUID: "SSSE3", Encoding: "0F 38 08 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSIGNB", Go: "PSIGNB", CPUID: "SSSE3", Encoding: "66 0F 38 08 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSIGND", Go: "PSIGND", CPUID: "SSSE3", Encoding: "0F 38 0A /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSIGND", Go: "PSIGND", CPUID: "SSSE3", Encoding: "66 0F 38 0A /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSIGNW", Go: "PSIGNW", CPUID: "SSSE3", Encoding: "0F 38 09 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSIGNW", Go: "PSIGNW", CPUID: "SSSE3", Encoding: "66 0F 38 09 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSLLD", Go: "PSLLL", CPUID: "MMX", Encoding: "0F F2 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSLLD", Go: "PSLLL", CPUID: "MMX", Encoding: "0F 72 /6 ib", Operands: "mm2, imm8"},
+	{Intel: "PSLLD", Go: "PSLLL", CPUID: "SSE2", Encoding: "66 0F F2 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSLLD", Go: "PSLLL", CPUID: "SSE2", Encoding: "66 0F 72 /6 ib", Operands: "xmm2, imm8"},
+	{Intel: "PSLLDQ", Go: "PSLLO", CPUID: "SSE2", Encoding: "66 0F 73 /7 ib", Operands: "xmm2, imm8"},
+	{Intel: "PSLLQ", Go: "PSLLQ", CPUID: "MMX", Encoding: "0F F3 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSLLQ", Go: "PSLLQ", CPUID: "MMX", Encoding: "0F 73 /6 ib", Operands: "mm2, imm8"},
+	{Intel: "PSLLQ", Go: "PSLLQ", CPUID: "SSE2", Encoding: "66 0F F3 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSLLQ", Go: "PSLLQ", CPUID: "SSE2", Encoding: "66 0F 73 /6 ib", Operands: "xmm2, imm8"},
+	{Intel: "PSLLW", Go: "PSLLW", CPUID: "MMX", Encoding: "0F F1 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSLLW", Go: "PSLLW", CPUID: "MMX", Encoding: "0F 71 /6 ib", Operands: "mm2, imm8"},
+	{Intel: "PSLLW", Go: "PSLLW", CPUID: "SSE2", Encoding: "66 0F F1 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSLLW", Go: "PSLLW", CPUID: "SSE2", Encoding: "66 0F 71 /6 ib", Operands: "xmm2, imm8"},
+	{Intel: "PSRAD", Go: "PSRAL", CPUID: "MMX", Encoding: "0F E2 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSRAD", Go: "PSRAL", CPUID: "MMX", Encoding: "0F 72 /4 ib", Operands: "mm2, imm8"},
+	{Intel: "PSRAD", Go: "PSRAL", CPUID: "SSE2", Encoding: "66 0F E2 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSRAD", Go: "PSRAL", CPUID: "SSE2", Encoding: "66 0F 72 /4 ib", Operands: "xmm2, imm8"},
+	{Intel: "PSRAW", Go: "PSRAW", CPUID: "MMX", Encoding: "0F E1 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSRAW", Go: "PSRAW", CPUID: "MMX", Encoding: "0F 71 /4 ib", Operands: "mm2, imm8"},
+	{Intel: "PSRAW", Go: "PSRAW", CPUID: "SSE2", Encoding: "66 0F E1 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSRAW", Go: "PSRAW", CPUID: "SSE2", Encoding: "66 0F 71 /4 ib", Operands: "xmm2, imm8"},
+	{Intel: "PSRLD", Go: "PSRLL", CPUID: "MMX", Encoding: "0F D2 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSRLD", Go: "PSRLL", CPUID: "MMX", Encoding: "0F 72 /2 ib", Operands: "mm2, imm8"},
+	{Intel: "PSRLD", Go: "PSRLL", CPUID: "SSE2", Encoding: "66 0F D2 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSRLD", Go: "PSRLL", CPUID: "SSE2", Encoding: "66 0F 72 /2 ib", Operands: "xmm2, imm8"},
+	{Intel: "PSRLDQ", Go: "PSRLO", CPUID: "SSE2", Encoding: "66 0F 73 /3 ib", Operands: "xmm2, imm8"},
+	{Intel: "PSRLQ", Go: "PSRLQ", CPUID: "MMX", Encoding: "0F D3 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSRLQ", Go: "PSRLQ", CPUID: "MMX", Encoding: "0F 73 /2 ib", Operands: "mm2, imm8"},
+	{Intel: "PSRLQ", Go: "PSRLQ", CPUID: "SSE2", Encoding: "66 0F D3 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSRLQ", Go: "PSRLQ", CPUID: "SSE2", Encoding: "66 0F 73 /2 ib", Operands: "xmm2, imm8"},
+	{Intel: "PSRLW", Go: "PSRLW", CPUID: "MMX", Encoding: "0F D1 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSRLW", Go: "PSRLW", CPUID: "MMX", Encoding: "0F 71 /2 ib", Operands: "mm2, imm8"},
+	{Intel: "PSRLW", Go: "PSRLW", CPUID: "SSE2", Encoding: "66 0F D1 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSRLW", Go: "PSRLW", CPUID: "SSE2", Encoding: "66 0F 71 /2 ib", Operands: "xmm2, imm8"},
+	{Intel: "PSUBB", Go: "PSUBB", CPUID: "MMX", Encoding: "0F F8 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSUBB", Go: "PSUBB", CPUID: "SSE2", Encoding: "66 0F F8 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSUBD", Go: "PSUBL", CPUID: "MMX", Encoding: "0F FA /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSUBD", Go: "PSUBL", CPUID: "SSE2", Encoding: "66 0F FA /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSUBQ", Go: "PSUBQ", CPUID: "SSE2", Encoding: "0F FB /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSUBQ", Go: "PSUBQ", CPUID: "SSE2", Encoding: "66 0F FB /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSUBSB", Go: "PSUBSB", CPUID: "MMX", Encoding: "0F E8 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSUBSB", Go: "PSUBSB", CPUID: "SSE2", Encoding: "66 0F E8 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSUBSW", Go: "PSUBSW", CPUID: "MMX", Encoding: "0F E9 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSUBSW", Go: "PSUBSW", CPUID: "SSE2", Encoding: "66 0F E9 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSUBUSB", Go: "PSUBUSB", CPUID: "MMX", Encoding: "0F D8 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSUBUSB", Go: "PSUBUSB", CPUID: "SSE2", Encoding: "66 0F D8 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSUBUSW", Go: "PSUBUSW", CPUID: "MMX", Encoding: "0F D9 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSUBUSW", Go: "PSUBUSW", CPUID: "SSE2", Encoding: "66 0F D9 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PSUBW", Go: "PSUBW", CPUID: "MMX", Encoding: "0F F9 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PSUBW", Go: "PSUBW", CPUID: "SSE2", Encoding: "66 0F F9 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PTEST", Go: "PTEST", CPUID: "SSE4_1", Encoding: "66 0F 38 17 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PUNPCKHBW", Go: "PUNPCKHBW", CPUID: "MMX", Encoding: "0F 68 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PUNPCKHBW", Go: "PUNPCKHBW", CPUID: "SSE2", Encoding: "66 0F 68 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PUNPCKHDQ", Go: "PUNPCKHLQ", CPUID: "MMX", Encoding: "0F 6A /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PUNPCKHDQ", Go: "PUNPCKHLQ", CPUID: "SSE2", Encoding: "66 0F 6A /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PUNPCKHQDQ", Go: "PUNPCKHQDQ", CPUID: "SSE2", Encoding: "66 0F 6D /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PUNPCKHWD", Go: "PUNPCKHWL", CPUID: "MMX", Encoding: "0F 69 /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PUNPCKHWD", Go: "PUNPCKHWL", CPUID: "SSE2", Encoding: "66 0F 69 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PUNPCKLBW", Go: "PUNPCKLBW", CPUID: "MMX", Encoding: "0F 60 /r", Operands: "mm1, mm2/m32"},
+	{Intel: "PUNPCKLBW", Go: "PUNPCKLBW", CPUID: "SSE2", Encoding: "66 0F 60 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PUNPCKLDQ", Go: "PUNPCKLLQ", CPUID: "MMX", Encoding: "0F 62 /r", Operands: "mm1, mm2/m32"},
+	{Intel: "PUNPCKLDQ", Go: "PUNPCKLLQ", CPUID: "SSE2", Encoding: "66 0F 62 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PUNPCKLQDQ", Go: "PUNPCKLQDQ", CPUID: "SSE2", Encoding: "66 0F 6C /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PUNPCKLWD", Go: "PUNPCKLWL", CPUID: "MMX", Encoding: "0F 61 /r", Operands: "mm1, mm2/m32"},
+	{Intel: "PUNPCKLWD", Go: "PUNPCKLWL", CPUID: "SSE2", Encoding: "66 0F 61 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "PUSH", Go: "PUSHW/PUSHL/PUSHQ", CPUID: "", Encoding: "0E", Operands: "CS"},
+	{Intel: "PUSH", Go: "PUSHW/PUSHL/PUSHQ", CPUID: "", Encoding: "1E", Operands: "DS"},
+	{Intel: "PUSH", Go: "PUSHW/PUSHL/PUSHQ", CPUID: "", Encoding: "06", Operands: "ES"},
+	{Intel: "PUSH", Go: "PUSHW/PUSHL/PUSHQ", CPUID: "", Encoding: "0F A0", Operands: "FS"},
+	{Intel: "PUSH", Go: "PUSHW/PUSHL/PUSHQ", CPUID: "", Encoding: "0F A8", Operands: "GS"},
+	{Intel: "PUSH", Go: "PUSHW/PUSHL/PUSHQ", CPUID: "", Encoding: "16", Operands: "SS"},
+	{Intel: "PUSH", Go: "PUSHW", CPUID: "", Encoding: "68 iw", Operands: "imm16"},
+	{Intel: "PUSH", Go: "PUSHL/PUSHQ", CPUID: "", Encoding: "68 id", Operands: "imm32"},
+	{Intel: "PUSH", Go: "PUSHW/PUSHL/PUSHQ", CPUID: "", Encoding: "6A ib", Operands: "imm8"},
+	{Intel: "PUSH", Go: "PUSHW", CPUID: "", Encoding: "FF /6", Operands: "r/m16"},
+	{Intel: "PUSH", Go: "PUSHL", CPUID: "", Encoding: "FF /6", Operands: "r/m32"},
+	{Intel: "PUSH", Go: "PUSHQ", CPUID: "", Encoding: "FF /6", Operands: "r/m64"},
+	{Intel: "PUSH", Go: "PUSHW", CPUID: "", Encoding: "50+rw", Operands: "r16op"},
+	{Intel: "PUSH", Go: "PUSHL", CPUID: "", Encoding: "50+rd", Operands: "r32op"},
+	{Intel: "PUSH", Go: "PUSHQ", CPUID: "", Encoding: "50+rd", Operands: "r64op"},
+	{Intel: "PUSHA", Go: "PUSHAW", CPUID: "", Encoding: "60", Operands: ""},
+	{Intel: "PUSHAD", Go: "PUSHAL", CPUID: "", Encoding: "60", Operands: ""},
+	{Intel: "PUSHF", Go: "PUSHFW", CPUID: "", Encoding: "9C", Operands: ""},
+	{Intel: "PUSHFD", Go: "PUSHFL", CPUID: "", Encoding: "9C", Operands: ""},
+	{Intel: "PUSHFQ", Go: "PUSHFQ", CPUID: "", Encoding: "9C", Operands: ""},
+	{Intel: "PXOR", Go: "PXOR", CPUID: "MMX", Encoding: "0F EF /r", Operands: "mm1, mm2/m64"},
+	{Intel: "PXOR", Go: "PXOR", CPUID: "SSE2", Encoding: "66 0F EF /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "RCL", Go: "RCLW", CPUID: "", Encoding: "D1 /2", Operands: "r/m16, 1"},
+	{Intel: "RCL", Go: "RCLW", CPUID: "", Encoding: "D3 /2", Operands: "r/m16, CL"},
+	{Intel: "RCL", Go: "RCLW", CPUID: "", Encoding: "C1 /2 ib", Operands: "r/m16, imm8"},
+	{Intel: "RCL", Go: "RCLL", CPUID: "", Encoding: "D1 /2", Operands: "r/m32, 1"},
+	{Intel: "RCL", Go: "RCLL", CPUID: "", Encoding: "D3 /2", Operands: "r/m32, CL"},
+	{Intel: "RCL", Go: "RCLL", CPUID: "", Encoding: "C1 /2 ib", Operands: "r/m32, imm8"},
+	{Intel: "RCL", Go: "RCLQ", CPUID: "", Encoding: "REX.W D1 /2", Operands: "r/m64, 1"},
+	{Intel: "RCL", Go: "RCLQ", CPUID: "", Encoding: "REX.W D3 /2", Operands: "r/m64, CL"},
+	{Intel: "RCL", Go: "RCLQ", CPUID: "", Encoding: "REX.W C1 /2 ib", Operands: "r/m64, imm8"},
+	{Intel: "RCL", Go: "RCLB", CPUID: "", Encoding: "D0 /2", Operands: "r/m8, 1"},
+	{Intel: "RCL", Go: "RCLB", CPUID: "", Encoding: "REX D0 /2", Operands: "r/m8, 1"},
+	{Intel: "RCL", Go: "RCLB", CPUID: "", Encoding: "D2 /2", Operands: "r/m8, CL"},
+	{Intel: "RCL", Go: "RCLB", CPUID: "", Encoding: "REX D2 /2", Operands: "r/m8, CL"},
+	{Intel: "RCL", Go: "RCLB", CPUID: "", Encoding: "C0 /2 ib", Operands: "r/m8, imm8"},
+	{Intel: "RCL", Go: "RCLB", CPUID: "", Encoding: "REX C0 /2 ib", Operands: "r/m8, imm8"},
+	{Intel: "RCPPS", Go: "RCPPS", CPUID: "SSE", Encoding: "0F 53 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "RCPSS", Go: "RCPSS", CPUID: "SSE", Encoding: "F3 0F 53 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "RCR", Go: "RCRW", CPUID: "", Encoding: "D1 /3", Operands: "r/m16, 1"},
+	{Intel: "RCR", Go: "RCRW", CPUID: "", Encoding: "D3 /3", Operands: "r/m16, CL"},
+	{Intel: "RCR", Go: "RCRW", CPUID: "", Encoding: "C1 /3 ib", Operands: "r/m16, imm8"},
+	{Intel: "RCR", Go: "RCRL", CPUID: "", Encoding: "D1 /3", Operands: "r/m32, 1"},
+	{Intel: "RCR", Go: "RCRL", CPUID: "", Encoding: "D3 /3", Operands: "r/m32, CL"},
+	{Intel: "RCR", Go: "RCRL", CPUID: "", Encoding: "C1 /3 ib", Operands: "r/m32, imm8"},
+	{Intel: "RCR", Go: "RCRQ", CPUID: "", Encoding: "REX.W D1 /3", Operands: "r/m64, 1"},
+	{Intel: "RCR", Go: "RCRQ", CPUID: "", Encoding: "REX.W D3 /3", Operands: "r/m64, CL"},
+	{Intel: "RCR", Go: "RCRQ", CPUID: "", Encoding: "REX.W C1 /3 ib", Operands: "r/m64, imm8"},
+	{Intel: "RCR", Go: "RCRB", CPUID: "", Encoding: "D0 /3", Operands: "r/m8, 1"},
+	{Intel: "RCR", Go: "RCRB", CPUID: "", Encoding: "REX D0 /3", Operands: "r/m8, 1"},
+	{Intel: "RCR", Go: "RCRB", CPUID: "", Encoding: "D2 /3", Operands: "r/m8, CL"},
+	{Intel: "RCR", Go: "RCRB", CPUID: "", Encoding: "REX D2 /3", Operands: "r/m8, CL"},
+	{Intel: "RCR", Go: "RCRB", CPUID: "", Encoding: "C0 /3 ib", Operands: "r/m8, imm8"},
+	{Intel: "RCR", Go: "RCRB", CPUID: "", Encoding: "REX C0 /3 ib", Operands: "r/m8, imm8"},
+	{Intel: "RDFSBASE", Go: "RDFSBASE", CPUID: "FSGSBASE", Encoding: "F3 0F AE /0", Operands: "rmr32"},
+	{Intel: "RDFSBASE", Go: "RDFSBASE", CPUID: "FSGSBASE", Encoding: "F3 REX.W 0F AE /0", Operands: "rmr64"},
+	{Intel: "RDGSBASE", Go: "RDGSBASE", CPUID: "FSGSBASE", Encoding: "F3 0F AE /1", Operands: "rmr32"},
+	{Intel: "RDGSBASE", Go: "RDGSBASE", CPUID: "FSGSBASE", Encoding: "F3 REX.W 0F AE /1", Operands: "rmr64"},
+	{Intel: "RDMSR", Go: "RDMSR", CPUID: "", Encoding: "0F 32", Operands: ""},
+	{Intel: "RDPKRU", Go: "RDPKRU", CPUID: "OSPKE", Encoding: "0F 01 EE", Operands: ""},
+	{Intel: "RDPMC", Go: "RDPMC", CPUID: "", Encoding: "0F 33", Operands: ""},
+	{Intel: "RDRAND", Go: "RDRAND", CPUID: "RDRAND", Encoding: "0F C7 /6", Operands: "rmr16"},
+	{Intel: "RDRAND", Go: "RDRAND", CPUID: "RDRAND", Encoding: "0F C7 /6", Operands: "rmr32"},
+	{Intel: "RDRAND", Go: "RDRAND", CPUID: "RDRAND", Encoding: "REX.W 0F C7 /6", Operands: "rmr64"},
+	{Intel: "RDSEED", Go: "RDSEED", CPUID: "RDSEED", Encoding: "0F C7 /7", Operands: "rmr16"},
+	{Intel: "RDSEED", Go: "RDSEED", CPUID: "RDSEED", Encoding: "0F C7 /7", Operands: "rmr32"},
+	{Intel: "RDSEED", Go: "RDSEED", CPUID: "RDSEED", Encoding: "REX.W 0F C7 /7", Operands: "rmr64"},
+	{Intel: "RDTSC", Go: "RDTSC", CPUID: "", Encoding: "0F 31", Operands: ""},
+	{Intel: "RDTSCP", Go: "RDTSCP", CPUID: "RDTSCP", Encoding: "0F 01 F9", Operands: ""},
+	{Intel: "RET", Go: "RETW/RETL/RETQ", CPUID: "", Encoding: "C3", Operands: ""},
+	{Intel: "RET", Go: "RETW/RETL/RETQ", CPUID: "", Encoding: "C2 iw", Operands: "imm16u"},
+	{Intel: "RET_FAR", Go: "RETFW/RETFL/RETFQ", CPUID: "", Encoding: "CB", Operands: ""},
+	{Intel: "RET_FAR", Go: "RETFW/RETFL/RETFQ", CPUID: "", Encoding: "CA iw", Operands: "imm16u"},
+	{Intel: "ROL", Go: "ROLW", CPUID: "", Encoding: "D1 /0", Operands: "r/m16, 1"},
+	{Intel: "ROL", Go: "ROLW", CPUID: "", Encoding: "D3 /0", Operands: "r/m16, CL"},
+	{Intel: "ROL", Go: "ROLW", CPUID: "", Encoding: "C1 /0 ib", Operands: "r/m16, imm8"},
+	{Intel: "ROL", Go: "ROLL", CPUID: "", Encoding: "D1 /0", Operands: "r/m32, 1"},
+	{Intel: "ROL", Go: "ROLL", CPUID: "", Encoding: "D3 /0", Operands: "r/m32, CL"},
+	{Intel: "ROL", Go: "ROLL", CPUID: "", Encoding: "C1 /0 ib", Operands: "r/m32, imm8"},
+	{Intel: "ROL", Go: "ROLQ", CPUID: "", Encoding: "REX.W D1 /0", Operands: "r/m64, 1"},
+	{Intel: "ROL", Go: "ROLQ", CPUID: "", Encoding: "REX.W D3 /0", Operands: "r/m64, CL"},
+	{Intel: "ROL", Go: "ROLQ", CPUID: "", Encoding: "REX.W C1 /0 ib", Operands: "r/m64, imm8"},
+	{Intel: "ROL", Go: "ROLB", CPUID: "", Encoding: "D0 /0", Operands: "r/m8, 1"},
+	{Intel: "ROL", Go: "ROLB", CPUID: "", Encoding: "REX D0 /0", Operands: "r/m8, 1"},
+	{Intel: "ROL", Go: "ROLB", CPUID: "", Encoding: "D2 /0", Operands: "r/m8, CL"},
+	{Intel: "ROL", Go: "ROLB", CPUID: "", Encoding: "REX D2 /0", Operands: "r/m8, CL"},
+	{Intel: "ROL", Go: "ROLB", CPUID: "", Encoding: "C0 /0 ib", Operands: "r/m8, imm8"},
+	{Intel: "ROL", Go: "ROLB", CPUID: "", Encoding: "REX C0 /0 ib", Operands: "r/m8, imm8"},
+	{Intel: "ROR", Go: "RORW", CPUID: "", Encoding: "D1 /1", Operands: "r/m16, 1"},
+	{Intel: "ROR", Go: "RORW", CPUID: "", Encoding: "D3 /1", Operands: "r/m16, CL"},
+	{Intel: "ROR", Go: "RORW", CPUID: "", Encoding: "C1 /1 ib", Operands: "r/m16, imm8"},
+	{Intel: "ROR", Go: "RORL", CPUID: "", Encoding: "D1 /1", Operands: "r/m32, 1"},
+	{Intel: "ROR", Go: "RORL", CPUID: "", Encoding: "D3 /1", Operands: "r/m32, CL"},
+	{Intel: "ROR", Go: "RORL", CPUID: "", Encoding: "C1 /1 ib", Operands: "r/m32, imm8"},
+	{Intel: "ROR", Go: "RORQ", CPUID: "", Encoding: "REX.W D1 /1", Operands: "r/m64, 1"},
+	{Intel: "ROR", Go: "RORQ", CPUID: "", Encoding: "REX.W D3 /1", Operands: "r/m64, CL"},
+	{Intel: "ROR", Go: "RORQ", CPUID: "", Encoding: "REX.W C1 /1 ib", Operands: "r/m64, imm8"},
+	{Intel: "ROR", Go: "RORB", CPUID: "", Encoding: "D0 /1", Operands: "r/m8, 1"},
+	{Intel: "ROR", Go: "RORB", CPUID: "", Encoding: "REX D0 /1", Operands: "r/m8, 1"},
+	{Intel: "ROR", Go: "RORB", CPUID: "", Encoding: "D2 /1", Operands: "r/m8, CL"},
+	{Intel: "ROR", Go: "RORB", CPUID: "", Encoding: "REX D2 /1", Operands: "r/m8, CL"},
+	{Intel: "ROR", Go: "RORB", CPUID: "", Encoding: "C0 /1 ib", Operands: "r/m8, imm8"},
+	{Intel: "ROR", Go: "RORB", CPUID: "", Encoding: "REX C0 /1 ib", Operands: "r/m8, imm8"},
+	{Intel: "RORX", Go: "RORXL", CPUID: "BMI2", Encoding: "VEX.LZ.F2.0F3A.W0 F0 /r ib", Operands: "r32, r/m32, imm8"},
+	{Intel: "RORX", Go: "RORXQ", CPUID: "BMI2", Encoding: "VEX.LZ.F2.0F3A.W1 F0 /r ib", Operands: "r64, r/m64, imm8"},
+	{Intel: "ROUNDPD", Go: "ROUNDPD", CPUID: "SSE4_1", Encoding: "66 0F 3A 09 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "ROUNDPS", Go: "ROUNDPS", CPUID: "SSE4_1", Encoding: "66 0F 3A 08 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "ROUNDSD", Go: "ROUNDSD", CPUID: "SSE4_1", Encoding: "66 0F 3A 0B /r ib", Operands: "xmm1, xmm2/m64, imm8"},
+	{Intel: "ROUNDSS", Go: "ROUNDSS", CPUID: "SSE4_1", Encoding: "66 0F 3A 0A /r ib", Operands: "xmm1, xmm2/m32, imm8"},
+	{Intel: "RSM", Go: "RSM", CPUID: "", Encoding: "0F AA", Operands: ""},
+	{Intel: "RSQRTPS", Go: "RSQRTPS", CPUID: "SSE", Encoding: "0F 52 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "RSQRTSS", Go: "RSQRTSS", CPUID: "SSE", Encoding: "F3 0F 52 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "SAHF", Go: "SAHF", CPUID: "LAHF-SAHF", Encoding: "9E", Operands: ""},
+	{Intel: "SAL", Go: "SALW", CPUID: "", Encoding: "D1 /4", Operands: "r/m16, 1"},
+	{Intel: "SAL", Go: "SALW", CPUID: "", Encoding: "D3 /4", Operands: "r/m16, CL"},
+	{Intel: "SAL", Go: "SALW", CPUID: "", Encoding: "C1 /4 ib", Operands: "r/m16, imm8"},
+	{Intel: "SAL", Go: "SALL", CPUID: "", Encoding: "D1 /4", Operands: "r/m32, 1"},
+	{Intel: "SAL", Go: "SALL", CPUID: "", Encoding: "D3 /4", Operands: "r/m32, CL"},
+	{Intel: "SAL", Go: "SALL", CPUID: "", Encoding: "C1 /4 ib", Operands: "r/m32, imm8"},
+	{Intel: "SAL", Go: "SALQ", CPUID: "", Encoding: "REX.W D1 /4", Operands: "r/m64, 1"},
+	{Intel: "SAL", Go: "SALQ", CPUID: "", Encoding: "REX.W D3 /4", Operands: "r/m64, CL"},
+	{Intel: "SAL", Go: "SALQ", CPUID: "", Encoding: "REX.W C1 /4 ib", Operands: "r/m64, imm8"},
+	{Intel: "SAL", Go: "SALB", CPUID: "", Encoding: "D0 /4", Operands: "r/m8, 1"},
+	{Intel: "SAL", Go: "SALB", CPUID: "", Encoding: "REX D0 /4", Operands: "r/m8, 1"},
+	{Intel: "SAL", Go: "SALB", CPUID: "", Encoding: "D2 /4", Operands: "r/m8, CL"},
+	{Intel: "SAL", Go: "SALB", CPUID: "", Encoding: "REX D2 /4", Operands: "r/m8, CL"},
+	{Intel: "SAL", Go: "SALB", CPUID: "", Encoding: "C0 /4 ib", Operands: "r/m8, imm8"},
+	{Intel: "SAL", Go: "SALB", CPUID: "", Encoding: "REX C0 /4 ib", Operands: "r/m8, imm8"},
+	{Intel: "SAR", Go: "SARW", CPUID: "", Encoding: "D1 /7", Operands: "r/m16, 1"},
+	{Intel: "SAR", Go: "SARW", CPUID: "", Encoding: "D3 /7", Operands: "r/m16, CL"},
+	{Intel: "SAR", Go: "SARW", CPUID: "", Encoding: "C1 /7 ib", Operands: "r/m16, imm8"},
+	{Intel: "SAR", Go: "SARL", CPUID: "", Encoding: "D1 /7", Operands: "r/m32, 1"},
+	{Intel: "SAR", Go: "SARL", CPUID: "", Encoding: "D3 /7", Operands: "r/m32, CL"},
+	{Intel: "SAR", Go: "SARL", CPUID: "", Encoding: "C1 /7 ib", Operands: "r/m32, imm8"},
+	{Intel: "SAR", Go: "SARQ", CPUID: "", Encoding: "REX.W D1 /7", Operands: "r/m64, 1"},
+	{Intel: "SAR", Go: "SARQ", CPUID: "", Encoding: "REX.W D3 /7", Operands: "r/m64, CL"},
+	{Intel: "SAR", Go: "SARQ", CPUID: "", Encoding: "REX.W C1 /7 ib", Operands: "r/m64, imm8"},
+	{Intel: "SAR", Go: "SARB", CPUID: "", Encoding: "D0 /7", Operands: "r/m8, 1"},
+	{Intel: "SAR", Go: "SARB", CPUID: "", Encoding: "REX D0 /7", Operands: "r/m8, 1"},
+	{Intel: "SAR", Go: "SARB", CPUID: "", Encoding: "D2 /7", Operands: "r/m8, CL"},
+	{Intel: "SAR", Go: "SARB", CPUID: "", Encoding: "REX D2 /7", Operands: "r/m8, CL"},
+	{Intel: "SAR", Go: "SARB", CPUID: "", Encoding: "C0 /7 ib", Operands: "r/m8, imm8"},
+	{Intel: "SAR", Go: "SARB", CPUID: "", Encoding: "REX C0 /7 ib", Operands: "r/m8, imm8"},
+	{Intel: "SARX", Go: "SARXL", CPUID: "BMI2", Encoding: "VEX.NDS.LZ.F3.0F38.W0 F7 /r", Operands: "r32, r/m32, r32V"},
+	{Intel: "SARX", Go: "SARXQ", CPUID: "BMI2", Encoding: "VEX.NDS.LZ.F3.0F38.W1 F7 /r", Operands: "r64, r/m64, r64V"},
+	{Intel: "SBB", Go: "SBBB", CPUID: "", Encoding: "1C ib", Operands: "AL, imm8"},
+	{Intel: "SBB", Go: "SBBW", CPUID: "", Encoding: "1D iw", Operands: "AX, imm16"},
+	{Intel: "SBB", Go: "SBBL", CPUID: "", Encoding: "1D id", Operands: "EAX, imm32"},
+	{Intel: "SBB", Go: "SBBQ", CPUID: "", Encoding: "REX.W 1D id", Operands: "RAX, imm32"},
+	{Intel: "SBB", Go: "SBBW", CPUID: "", Encoding: "81 /3 iw", Operands: "r/m16, imm16"},
+	{Intel: "SBB", Go: "SBBW", CPUID: "", Encoding: "83 /3 ib", Operands: "r/m16, imm8"},
+	{Intel: "SBB", Go: "SBBW", CPUID: "", Encoding: "19 /r", Operands: "r/m16, r16"},
+	{Intel: "SBB", Go: "SBBL", CPUID: "", Encoding: "81 /3 id", Operands: "r/m32, imm32"},
+	{Intel: "SBB", Go: "SBBL", CPUID: "", Encoding: "83 /3 ib", Operands: "r/m32, imm8"},
+	{Intel: "SBB", Go: "SBBL", CPUID: "", Encoding: "19 /r", Operands: "r/m32, r32"},
+	{Intel: "SBB", Go: "SBBQ", CPUID: "", Encoding: "REX.W 81 /3 id", Operands: "r/m64, imm32"},
+	{Intel: "SBB", Go: "SBBQ", CPUID: "", Encoding: "REX.W 83 /3 ib", Operands: "r/m64, imm8"},
+	{Intel: "SBB", Go: "SBBQ", CPUID: "", Encoding: "REX.W 19 /r", Operands: "r/m64, r64"},
+	{Intel: "SBB", Go: "SBBB", CPUID: "", Encoding: "80 /3 ib", Operands: "r/m8, imm8"},
+	{Intel: "SBB", Go: "SBBB", CPUID: "", Encoding: "REX 80 /3 ib", Operands: "r/m8, imm8"},
+	{Intel: "SBB", Go: "SBBB", CPUID: "", Encoding: "18 /r", Operands: "r/m8, r8"},
+	{Intel: "SBB", Go: "SBBB", CPUID: "", Encoding: "REX 18 /r", Operands: "r/m8, r8"},
+	{Intel: "SBB", Go: "SBBW", CPUID: "", Encoding: "1B /r", Operands: "r16, r/m16"},
+	{Intel: "SBB", Go: "SBBL", CPUID: "", Encoding: "1B /r", Operands: "r32, r/m32"},
+	{Intel: "SBB", Go: "SBBQ", CPUID: "", Encoding: "REX.W 1B /r", Operands: "r64, r/m64"},
+	{Intel: "SBB", Go: "SBBB", CPUID: "", Encoding: "1A /r", Operands: "r8, r/m8"},
+	{Intel: "SBB", Go: "SBBB", CPUID: "", Encoding: "REX 1A /r", Operands: "r8, r/m8"},
+	{Intel: "SCASB", Go: "SCASB", CPUID: "", Encoding: "AE", Operands: ""},
+	{Intel: "SCASD", Go: "SCASL", CPUID: "", Encoding: "AF", Operands: ""},
+	{Intel: "SCASQ", Go: "SCASQ", CPUID: "", Encoding: "REX.W AF", Operands: ""},
+	{Intel: "SCASW", Go: "SCASW", CPUID: "", Encoding: "AF", Operands: ""},
+	{Intel: "SETA", Go: "SETHI", CPUID: "", Encoding: "0F 97 /r", Operands: "r/m8"},
+	{Intel: "SETA", Go: "SETHI", CPUID: "", Encoding: "REX 0F 97 /r", Operands: "r/m8"},
+	{Intel: "SETAE", Go: "SETCC", CPUID: "", Encoding: "0F 93 /r", Operands: "r/m8"},
+	{Intel: "SETAE", Go: "SETCC", CPUID: "", Encoding: "REX 0F 93 /r", Operands: "r/m8"},
+	{Intel: "SETB", Go: "SETCS", CPUID: "", Encoding: "0F 92 /r", Operands: "r/m8"},
+	{Intel: "SETB", Go: "SETCS", CPUID: "", Encoding: "REX 0F 92 /r", Operands: "r/m8"},
+	{Intel: "SETBE", Go: "SETLS", CPUID: "", Encoding: "0F 96 /r", Operands: "r/m8"},
+	{Intel: "SETBE", Go: "SETLS", CPUID: "", Encoding: "REX 0F 96 /r", Operands: "r/m8"},
+	{Intel: "SETC", Go: "SETCS", CPUID: "", Encoding: "0F 92 /r", Operands: "r/m8"},
+	{Intel: "SETC", Go: "SETCS", CPUID: "", Encoding: "REX 0F 92 /r", Operands: "r/m8"},
+	{Intel: "SETE", Go: "SETEQ", CPUID: "", Encoding: "0F 94 /r", Operands: "r/m8"},
+	{Intel: "SETE", Go: "SETEQ", CPUID: "", Encoding: "REX 0F 94 /r", Operands: "r/m8"},
+	{Intel: "SETG", Go: "SETGT", CPUID: "", Encoding: "0F 9F /r", Operands: "r/m8"},
+	{Intel: "SETG", Go: "SETGT", CPUID: "", Encoding: "REX 0F 9F /r", Operands: "r/m8"},
+	{Intel: "SETGE", Go: "SETGE", CPUID: "", Encoding: "0F 9D /r", Operands: "r/m8"},
+	{Intel: "SETGE", Go: "SETGE", CPUID: "", Encoding: "REX 0F 9D /r", Operands: "r/m8"},
+	{Intel: "SETL", Go: "SETLT", CPUID: "", Encoding: "0F 9C /r", Operands: "r/m8"},
+	{Intel: "SETL", Go: "SETLT", CPUID: "", Encoding: "REX 0F 9C /r", Operands: "r/m8"},
+	{Intel: "SETLE", Go: "SETLE", CPUID: "", Encoding: "0F 9E /r", Operands: "r/m8"},
+	{Intel: "SETLE", Go: "SETLE", CPUID: "", Encoding: "REX 0F 9E /r", Operands: "r/m8"},
+	{Intel: "SETNA", Go: "SETLS", CPUID: "", Encoding: "0F 96 /r", Operands: "r/m8"},
+	{Intel: "SETNA", Go: "SETLS", CPUID: "", Encoding: "REX 0F 96 /r", Operands: "r/m8"},
+	{Intel: "SETNAE", Go: "SETCS", CPUID: "", Encoding: "0F 92 /r", Operands: "r/m8"},
+	{Intel: "SETNAE", Go: "SETCS", CPUID: "", Encoding: "REX 0F 92 /r", Operands: "r/m8"},
+	{Intel: "SETNB", Go: "SETCC", CPUID: "", Encoding: "0F 93 /r", Operands: "r/m8"},
+	{Intel: "SETNB", Go: "SETCC", CPUID: "", Encoding: "REX 0F 93 /r", Operands: "r/m8"},
+	{Intel: "SETNBE", Go: "SETHI", CPUID: "", Encoding: "0F 97 /r", Operands: "r/m8"},
+	{Intel: "SETNBE", Go: "SETHI", CPUID: "", Encoding: "REX 0F 97 /r", Operands: "r/m8"},
+	{Intel: "SETNC", Go: "SETCC", CPUID: "", Encoding: "0F 93 /r", Operands: "r/m8"},
+	{Intel: "SETNC", Go: "SETCC", CPUID: "", Encoding: "REX 0F 93 /r", Operands: "r/m8"},
+	{Intel: "SETNE", Go: "SETNE", CPUID: "", Encoding: "0F 95 /r", Operands: "r/m8"},
+	{Intel: "SETNE", Go: "SETNE", CPUID: "", Encoding: "REX 0F 95 /r", Operands: "r/m8"},
+	{Intel: "SETNG", Go: "SETLE", CPUID: "", Encoding: "0F 9E /r", Operands: "r/m8"},
+	{Intel: "SETNG", Go: "SETLE", CPUID: "", Encoding: "REX 0F 9E /r", Operands: "r/m8"},
+	{Intel: "SETNGE", Go: "SETLT", CPUID: "", Encoding: "0F 9C /r", Operands: "r/m8"},
+	{Intel: "SETNGE", Go: "SETLT", CPUID: "", Encoding: "REX 0F 9C /r", Operands: "r/m8"},
+	{Intel: "SETNL", Go: "SETGE", CPUID: "", Encoding: "0F 9D /r", Operands: "r/m8"},
+	{Intel: "SETNL", Go: "SETGE", CPUID: "", Encoding: "REX 0F 9D /r", Operands: "r/m8"},
+	{Intel: "SETNLE", Go: "SETGT", CPUID: "", Encoding: "0F 9F /r", Operands: "r/m8"},
+	{Intel: "SETNLE", Go: "SETGT", CPUID: "", Encoding: "REX 0F 9F /r", Operands: "r/m8"},
+	{Intel: "SETNO", Go: "SETOC", CPUID: "", Encoding: "0F 91 /r", Operands: "r/m8"},
+	{Intel: "SETNO", Go: "SETOC", CPUID: "", Encoding: "REX 0F 91 /r", Operands: "r/m8"},
+	{Intel: "SETNP", Go: "SETPC", CPUID: "", Encoding: "0F 9B /r", Operands: "r/m8"},
+	{Intel: "SETNP", Go: "SETPC", CPUID: "", Encoding: "REX 0F 9B /r", Operands: "r/m8"},
+	{Intel: "SETNS", Go: "SETPL", CPUID: "", Encoding: "0F 99 /r", Operands: "r/m8"},
+	{Intel: "SETNS", Go: "SETPL", CPUID: "", Encoding: "REX 0F 99 /r", Operands: "r/m8"},
+	{Intel: "SETNZ", Go: "SETNE", CPUID: "", Encoding: "0F 95 /r", Operands: "r/m8"},
+	{Intel: "SETNZ", Go: "SETNE", CPUID: "", Encoding: "REX 0F 95 /r", Operands: "r/m8"},
+	{Intel: "SETO", Go: "SETOS", CPUID: "", Encoding: "0F 90 /r", Operands: "r/m8"},
+	{Intel: "SETO", Go: "SETOS", CPUID: "", Encoding: "REX 0F 90 /r", Operands: "r/m8"},
+	{Intel: "SETP", Go: "SETPS", CPUID: "", Encoding: "0F 9A /r", Operands: "r/m8"},
+	{Intel: "SETP", Go: "SETPS", CPUID: "", Encoding: "REX 0F 9A /r", Operands: "r/m8"},
+	{Intel: "SETPE", Go: "SETPS", CPUID: "", Encoding: "0F 9A /r", Operands: "r/m8"},
+	{Intel: "SETPE", Go: "SETPS", CPUID: "", Encoding: "REX 0F 9A /r", Operands: "r/m8"},
+	{Intel: "SETPO", Go: "SETPC", CPUID: "", Encoding: "0F 9B /r", Operands: "r/m8"},
+	{Intel: "SETPO", Go: "SETPC", CPUID: "", Encoding: "REX 0F 9B /r", Operands: "r/m8"},
+	{Intel: "SETS", Go: "SETMI", CPUID: "", Encoding: "0F 98 /r", Operands: "r/m8"},
+	{Intel: "SETS", Go: "SETMI", CPUID: "", Encoding: "REX 0F 98 /r", Operands: "r/m8"},
+	{Intel: "SETZ", Go: "SETEQ", CPUID: "", Encoding: "0F 94 /r", Operands: "r/m8"},
+	{Intel: "SETZ", Go: "SETEQ", CPUID: "", Encoding: "REX 0F 94 /r", Operands: "r/m8"},
+	{Intel: "SFENCE", Go: "SFENCE", CPUID: "SSE", Encoding: "0F AE F8", Operands: ""},
+	{Intel: "SGDT", Go: "SGDTW/SGDTL/SGDT", CPUID: "", Encoding: "0F 01 /0", Operands: "m"},
+	{Intel: "SHL", Go: "SHLW", CPUID: "", Encoding: "D1 /4", Operands: "r/m16, 1"},
+	{Intel: "SHL", Go: "SHLW", CPUID: "", Encoding: "D3 /4", Operands: "r/m16, CL"},
+	{Intel: "SHL", Go: "SHLW", CPUID: "", Encoding: "C1 /4 ib", Operands: "r/m16, imm8"},
+	{Intel: "SHL", Go: "SHLL", CPUID: "", Encoding: "D1 /4", Operands: "r/m32, 1"},
+	{Intel: "SHL", Go: "SHLL", CPUID: "", Encoding: "D3 /4", Operands: "r/m32, CL"},
+	{Intel: "SHL", Go: "SHLL", CPUID: "", Encoding: "C1 /4 ib", Operands: "r/m32, imm8"},
+	{Intel: "SHL", Go: "SHLQ", CPUID: "", Encoding: "REX.W D1 /4", Operands: "r/m64, 1"},
+	{Intel: "SHL", Go: "SHLQ", CPUID: "", Encoding: "REX.W D3 /4", Operands: "r/m64, CL"},
+	{Intel: "SHL", Go: "SHLQ", CPUID: "", Encoding: "REX.W C1 /4 ib", Operands: "r/m64, imm8"},
+	{Intel: "SHL", Go: "SHLB", CPUID: "", Encoding: "D0 /4", Operands: "r/m8, 1"},
+	{Intel: "SHL", Go: "SHLB", CPUID: "", Encoding: "REX D0 /4", Operands: "r/m8, 1"},
+	{Intel: "SHL", Go: "SHLB", CPUID: "", Encoding: "D2 /4", Operands: "r/m8, CL"},
+	{Intel: "SHL", Go: "SHLB", CPUID: "", Encoding: "REX D2 /4", Operands: "r/m8, CL"},
+	{Intel: "SHL", Go: "SHLB", CPUID: "", Encoding: "C0 /4 ib", Operands: "r/m8, imm8"},
+	{Intel: "SHL", Go: "SHLB", CPUID: "", Encoding: "REX C0 /4 ib", Operands: "r/m8, imm8"},
+	{Intel: "SHLD", Go: "SHLW", CPUID: "", Encoding: "0F A5 /r", Operands: "r/m16, r16, CL"},
+	{Intel: "SHLD", Go: "SHLW", CPUID: "", Encoding: "0F A4 /r ib", Operands: "r/m16, r16, imm8"},
+	{Intel: "SHLD", Go: "SHLL", CPUID: "", Encoding: "0F A5 /r", Operands: "r/m32, r32, CL"},
+	{Intel: "SHLD", Go: "SHLL", CPUID: "", Encoding: "0F A4 /r ib", Operands: "r/m32, r32, imm8"},
+	{Intel: "SHLD", Go: "SHLQ", CPUID: "", Encoding: "REX.W 0F A5 /r", Operands: "r/m64, r64, CL"},
+	{Intel: "SHLD", Go: "SHLQ", CPUID: "", Encoding: "REX.W 0F A4 /r ib", Operands: "r/m64, r64, imm8"},
+	{Intel: "SHLX", Go: "SHLXL", CPUID: "BMI2", Encoding: "VEX.NDS.LZ.66.0F38.W0 F7 /r", Operands: "r32, r/m32, r32V"},
+	{Intel: "SHLX", Go: "SHLXQ", CPUID: "BMI2", Encoding: "VEX.NDS.LZ.66.0F38.W1 F7 /r", Operands: "r64, r/m64, r64V"},
+	{Intel: "SHR", Go: "SHRW", CPUID: "", Encoding: "D1 /5", Operands: "r/m16, 1"},
+	{Intel: "SHR", Go: "SHRW", CPUID: "", Encoding: "D3 /5", Operands: "r/m16, CL"},
+	{Intel: "SHR", Go: "SHRW", CPUID: "", Encoding: "C1 /5 ib", Operands: "r/m16, imm8"},
+	{Intel: "SHR", Go: "SHRL", CPUID: "", Encoding: "D1 /5", Operands: "r/m32, 1"},
+	{Intel: "SHR", Go: "SHRL", CPUID: "", Encoding: "D3 /5", Operands: "r/m32, CL"},
+	{Intel: "SHR", Go: "SHRL", CPUID: "", Encoding: "C1 /5 ib", Operands: "r/m32, imm8"},
+	{Intel: "SHR", Go: "SHRQ", CPUID: "", Encoding: "REX.W D1 /5", Operands: "r/m64, 1"},
+	{Intel: "SHR", Go: "SHRQ", CPUID: "", Encoding: "REX.W D3 /5", Operands: "r/m64, CL"},
+	{Intel: "SHR", Go: "SHRQ", CPUID: "", Encoding: "REX.W C1 /5 ib", Operands: "r/m64, imm8"},
+	{Intel: "SHR", Go: "SHRB", CPUID: "", Encoding: "D0 /5", Operands: "r/m8, 1"},
+	{Intel: "SHR", Go: "SHRB", CPUID: "", Encoding: "REX D0 /5", Operands: "r/m8, 1"},
+	{Intel: "SHR", Go: "SHRB", CPUID: "", Encoding: "D2 /5", Operands: "r/m8, CL"},
+	{Intel: "SHR", Go: "SHRB", CPUID: "", Encoding: "REX D2 /5", Operands: "r/m8, CL"},
+	{Intel: "SHR", Go: "SHRB", CPUID: "", Encoding: "C0 /5 ib", Operands: "r/m8, imm8"},
+	{Intel: "SHR", Go: "SHRB", CPUID: "", Encoding: "REX C0 /5 ib", Operands: "r/m8, imm8"},
+	{Intel: "SHRD", Go: "SHRW", CPUID: "", Encoding: "0F AD /r", Operands: "r/m16, r16, CL"},
+	{Intel: "SHRD", Go: "SHRW", CPUID: "", Encoding: "0F AC /r ib", Operands: "r/m16, r16, imm8"},
+	{Intel: "SHRD", Go: "SHRL", CPUID: "", Encoding: "0F AD /r", Operands: "r/m32, r32, CL"},
+	{Intel: "SHRD", Go: "SHRL", CPUID: "", Encoding: "0F AC /r ib", Operands: "r/m32, r32, imm8"},
+	{Intel: "SHRD", Go: "SHRQ", CPUID: "", Encoding: "REX.W 0F AD /r", Operands: "r/m64, r64, CL"},
+	{Intel: "SHRD", Go: "SHRQ", CPUID: "", Encoding: "REX.W 0F AC /r ib", Operands: "r/m64, r64, imm8"},
+	{Intel: "SHRX", Go: "SHRXL", CPUID: "BMI2", Encoding: "VEX.NDS.LZ.F2.0F38.W0 F7 /r", Operands: "r32, r/m32, r32V"},
+	{Intel: "SHRX", Go: "SHRXQ", CPUID: "BMI2", Encoding: "VEX.NDS.LZ.F2.0F38.W1 F7 /r", Operands: "r64, r/m64, r64V"},
+	{Intel: "SHUFPD", Go: "SHUFPD", CPUID: "SSE2", Encoding: "66 0F C6 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "SHUFPS", Go: "SHUFPS", CPUID: "SSE", Encoding: "0F C6 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "SIDT", Go: "SIDTW/SIDTL/SIDT", CPUID: "", Encoding: "0F 01 /1", Operands: "m"},
+	{Intel: "SLDT", Go: "SLDTW", CPUID: "", Encoding: "0F 00 /0", Operands: "r/m16"},
+	{Intel: "SLDT", Go: "SLDT{L/W}", CPUID: "", Encoding: "0F 00 /0", Operands: "r32/m16"},
+	{Intel: "SLDT", Go: "SLDT{Q/W}", CPUID: "", Encoding: "REX.W 0F 00 /0", Operands: "r64/m16"},
+	{Intel: "SMSW", Go: "SMSWW", CPUID: "", Encoding: "0F 01 /4", Operands: "r/m16"},
+	{Intel: "SMSW", Go: "SMSW{L/W}", CPUID: "", Encoding: "0F 01 /4", Operands: "r32/m16"},
+	{Intel: "SMSW", Go: "SMSW{Q/W}", CPUID: "", Encoding: "REX.W 0F 01 /4", Operands: "r64/m16"},
+	{Intel: "SQRTPD", Go: "SQRTPD", CPUID: "SSE2", Encoding: "66 0F 51 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "SQRTPS", Go: "SQRTPS", CPUID: "SSE", Encoding: "0F 51 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "SQRTSD", Go: "SQRTSD", CPUID: "SSE2", Encoding: "F2 0F 51 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "SQRTSS", Go: "SQRTSS", CPUID: "SSE", Encoding: "F3 0F 51 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "STAC", Go: "STAC", CPUID: "SMAP", Encoding: "0F 01 CB", Operands: ""},
+	{Intel: "STC", Go: "STC", CPUID: "", Encoding: "F9", Operands: ""},
+	{Intel: "STD", Go: "STD", CPUID: "", Encoding: "FD", Operands: ""},
+	{Intel: "STI", Go: "STI", CPUID: "", Encoding: "FB", Operands: ""},
+	{Intel: "STMXCSR", Go: "STMXCSR", CPUID: "SSE", Encoding: "0F AE /3", Operands: "m32"},
+	{Intel: "STOSB", Go: "STOSB", CPUID: "", Encoding: "AA", Operands: ""},
+	{Intel: "STOSD", Go: "STOSL", CPUID: "", Encoding: "AB", Operands: ""},
+	{Intel: "STOSQ", Go: "STOSQ", CPUID: "", Encoding: "REX.W AB", Operands: ""},
+	{Intel: "STOSW", Go: "STOSW", CPUID: "", Encoding: "AB", Operands: ""},
+	{Intel: "STR", Go: "STRW", CPUID: "", Encoding: "0F 00 /1", Operands: "r/m16"},
+	{Intel: "STR", Go: "STR{L/W}", CPUID: "", Encoding: "0F 00 /1", Operands: "r32/m16"},
+	{Intel: "STR", Go: "STR{Q/W}", CPUID: "", Encoding: "REX.W 0F 00 /1", Operands: "r64/m16"},
+	{Intel: "SUB", Go: "SUBB", CPUID: "", Encoding: "2C ib", Operands: "AL, imm8"},
+	{Intel: "SUB", Go: "SUBW", CPUID: "", Encoding: "2D iw", Operands: "AX, imm16"},
+	{Intel: "SUB", Go: "SUBL", CPUID: "", Encoding: "2D id", Operands: "EAX, imm32"},
+	{Intel: "SUB", Go: "SUBQ", CPUID: "", Encoding: "REX.W 2D id", Operands: "RAX, imm32"},
+	{Intel: "SUB", Go: "SUBW", CPUID: "", Encoding: "81 /5 iw", Operands: "r/m16, imm16"},
+	{Intel: "SUB", Go: "SUBW", CPUID: "", Encoding: "83 /5 ib", Operands: "r/m16, imm8"},
+	{Intel: "SUB", Go: "SUBW", CPUID: "", Encoding: "29 /r", Operands: "r/m16, r16"},
+	{Intel: "SUB", Go: "SUBL", CPUID: "", Encoding: "81 /5 id", Operands: "r/m32, imm32"},
+	{Intel: "SUB", Go: "SUBL", CPUID: "", Encoding: "83 /5 ib", Operands: "r/m32, imm8"},
+	{Intel: "SUB", Go: "SUBL", CPUID: "", Encoding: "29 /r", Operands: "r/m32, r32"},
+	{Intel: "SUB", Go: "SUBQ", CPUID: "", Encoding: "REX.W 81 /5 id", Operands: "r/m64, imm32"},
+	{Intel: "SUB", Go: "SUBQ", CPUID: "", Encoding: "REX.W 83 /5 ib", Operands: "r/m64, imm8"},
+	{Intel: "SUB", Go: "SUBQ", CPUID: "", Encoding: "REX.W 29 /r", Operands: "r/m64, r64"},
+	{Intel: "SUB", Go: "SUBB", CPUID: "", Encoding: "80 /5 ib", Operands: "r/m8, imm8"},
+	{Intel: "SUB", Go: "SUBB", CPUID: "", Encoding: "REX 80 /5 ib", Operands: "r/m8, imm8"},
+	{Intel: "SUB", Go: "SUBB", CPUID: "", Encoding: "28 /r", Operands: "r/m8, r8"},
+	{Intel: "SUB", Go: "SUBB", CPUID: "", Encoding: "REX 28 /r", Operands: "r/m8, r8"},
+	{Intel: "SUB", Go: "SUBW", CPUID: "", Encoding: "2B /r", Operands: "r16, r/m16"},
+	{Intel: "SUB", Go: "SUBL", CPUID: "", Encoding: "2B /r", Operands: "r32, r/m32"},
+	{Intel: "SUB", Go: "SUBQ", CPUID: "", Encoding: "REX.W 2B /r", Operands: "r64, r/m64"},
+	{Intel: "SUB", Go: "SUBB", CPUID: "", Encoding: "2A /r", Operands: "r8, r/m8"},
+	{Intel: "SUB", Go: "SUBB", CPUID: "", Encoding: "REX 2A /r", Operands: "r8, r/m8"},
+	{Intel: "SUBPD", Go: "SUBPD", CPUID: "SSE2", Encoding: "66 0F 5C /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "SUBPS", Go: "SUBPS", CPUID: "SSE", Encoding: "0F 5C /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "SUBSD", Go: "SUBSD", CPUID: "SSE2", Encoding: "F2 0F 5C /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "SUBSS", Go: "SUBSS", CPUID: "SSE", Encoding: "F3 0F 5C /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "SWAPGS", Go: "SWAPGS", CPUID: "", Encoding: "0F 01 F8", Operands: ""},
+	{Intel: "SYSCALL", Go: "SYSCALL", CPUID: "SCE", Encoding: "0F 05", Operands: ""},
+	{Intel: "SYSENTER", Go: "SYSENTER", CPUID: "", Encoding: "0F 34", Operands: ""},
+	{Intel: "SYSEXIT", Go: "SYSEXIT", CPUID: "", Encoding: "0F 35", Operands: ""},
+	{Intel: "SYSEXIT", Go: "SYSEXIT", CPUID: "", Encoding: "REX.W 0F 35", Operands: ""},
+	{Intel: "SYSRET", Go: "SYSRET", CPUID: "SCE", Encoding: "0F 07", Operands: ""},
+	{Intel: "SYSRET", Go: "SYSRET", CPUID: "SCE", Encoding: "REX.W 0F 07", Operands: ""},
+	{Intel: "TEST", Go: "TESTB", CPUID: "", Encoding: "A8 ib", Operands: "AL, imm8"},
+	{Intel: "TEST", Go: "TESTW", CPUID: "", Encoding: "A9 iw", Operands: "AX, imm16"},
+	{Intel: "TEST", Go: "TESTL", CPUID: "", Encoding: "A9 id", Operands: "EAX, imm32"},
+	{Intel: "TEST", Go: "TESTQ", CPUID: "", Encoding: "REX.W A9 id", Operands: "RAX, imm32"},
+	{Intel: "TEST", Go: "TESTW", CPUID: "", Encoding: "F7 /0 iw", Operands: "r/m16, imm16"},
+	{Intel: "TEST", Go: "TESTW", CPUID: "", Encoding: "85 /r", Operands: "r/m16, r16"},
+	{Intel: "TEST", Go: "TESTL", CPUID: "", Encoding: "F7 /0 id", Operands: "r/m32, imm32"},
+	{Intel: "TEST", Go: "TESTL", CPUID: "", Encoding: "85 /r", Operands: "r/m32, r32"},
+	{Intel: "TEST", Go: "TESTQ", CPUID: "", Encoding: "REX.W F7 /0 id", Operands: "r/m64, imm32"},
+	{Intel: "TEST", Go: "TESTQ", CPUID: "", Encoding: "REX.W 85 /r", Operands: "r/m64, r64"},
+	{Intel: "TEST", Go: "TESTB", CPUID: "", Encoding: "F6 /0 ib", Operands: "r/m8, imm8"},
+	{Intel: "TEST", Go: "TESTB", CPUID: "", Encoding: "REX F6 /0 ib", Operands: "r/m8, imm8"},
+	{Intel: "TEST", Go: "TESTB", CPUID: "", Encoding: "84 /r", Operands: "r/m8, r8"},
+	{Intel: "TEST", Go: "TESTB", CPUID: "", Encoding: "REX 84 /r", Operands: "r/m8, r8"},
+	{Intel: "TZCNT", Go: "TZCNTW", CPUID: "BMI1", Encoding: "F3 0F BC /r", Operands: "r16, r/m16"},
+	{Intel: "TZCNT", Go: "TZCNTL", CPUID: "BMI1", Encoding: "F3 0F BC /r", Operands: "r32, r/m32"},
+	{Intel: "TZCNT", Go: "TZCNTQ", CPUID: "BMI1", Encoding: "F3 REX.W 0F BC /r", Operands: "r64, r/m64"},
+	{Intel: "UCOMISD", Go: "UCOMISD", CPUID: "SSE2", Encoding: "66 0F 2E /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "UCOMISS", Go: "UCOMISS", CPUID: "SSE", Encoding: "0F 2E /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "UD1", Go: "UD1", CPUID: "", Encoding: "0F B9", Operands: ""},
+	{Intel: "UD2", Go: "UD2", CPUID: "", Encoding: "0F 0B", Operands: ""},
+	{Intel: "UNPCKHPD", Go: "UNPCKHPD", CPUID: "SSE2", Encoding: "66 0F 15 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "UNPCKHPS", Go: "UNPCKHPS", CPUID: "SSE", Encoding: "0F 15 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "UNPCKLPD", Go: "UNPCKLPD", CPUID: "SSE2", Encoding: "66 0F 14 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "UNPCKLPS", Go: "UNPCKLPS", CPUID: "SSE", Encoding: "0F 14 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VADDPD", Go: "VADDPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 58 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VADDPD", Go: "VADDPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 58 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VADDPS", Go: "VADDPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 58 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VADDPS", Go: "VADDPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG 58 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VADDSD", Go: "VADDSD", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F2.0F.WIG 58 /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VADDSS", Go: "VADDSS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.WIG 58 /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VADDSUBPD", Go: "VADDSUBPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG D0 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VADDSUBPD", Go: "VADDSUBPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG D0 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VADDSUBPS", Go: "VADDSUBPS", CPUID: "AVX", Encoding: "VEX.NDS.128.F2.0F.WIG D0 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VADDSUBPS", Go: "VADDSUBPS", CPUID: "AVX", Encoding: "VEX.NDS.256.F2.0F.WIG D0 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VAESDEC", Go: "VAESDEC", CPUID: "AES,AVX", Encoding: "VEX.NDS.128.66.0F38.WIG DE /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VAESDECLAST", Go: "VAESDECLAST", CPUID: "AES,AVX", Encoding: "VEX.NDS.128.66.0F38.WIG DF /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VAESENC", Go: "VAESENC", CPUID: "AES,AVX", Encoding: "VEX.NDS.128.66.0F38.WIG DC /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VAESENCLAST", Go: "VAESENCLAST", CPUID: "AES,AVX", Encoding: "VEX.NDS.128.66.0F38.WIG DD /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VAESIMC", Go: "VAESIMC", CPUID: "AES,AVX", Encoding: "VEX.128.66.0F38.WIG DB /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VAESKEYGENASSIST", Go: "VAESKEYGENASSIST", CPUID: "AES,AVX", Encoding: "VEX.128.66.0F3A.WIG DF /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "VANDNPD", Go: "VANDNPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 55 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VANDNPD", Go: "VANDNPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 55 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VANDNPS", Go: "VANDNPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 55 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VANDNPS", Go: "VANDNPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG 55 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VANDPD", Go: "VANDPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 54 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VANDPD", Go: "VANDPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 54 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VANDPS", Go: "VANDPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 54 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VANDPS", Go: "VANDPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG 54 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VBLENDPD", Go: "VBLENDPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.WIG 0D /r ib", Operands: "xmm1, xmmV, xmm2/m128, imm8"},
+	{Intel: "VBLENDPD", Go: "VBLENDPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F3A.WIG 0D /r ib", Operands: "ymm1, ymmV, ymm2/m256, imm8"},
+	{Intel: "VBLENDPS", Go: "VBLENDPS", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.WIG 0C /r ib", Operands: "xmm1, xmmV, xmm2/m128, imm8"},
+	{Intel: "VBLENDPS", Go: "VBLENDPS", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F3A.WIG 0C /r ib", Operands: "ymm1, ymmV, ymm2/m256, imm8"},
+	{Intel: "VBLENDVPD", Go: "VBLENDVPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.W0 4B /r /is4", Operands: "xmm1, xmmV, xmm2/m128, xmmIH"},
+	{Intel: "VBLENDVPD", Go: "VBLENDVPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F3A.W0 4B /r /is4", Operands: "ymm1, ymmV, ymm2/m256, ymmIH"},
+	{Intel: "VBLENDVPS", Go: "VBLENDVPS", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.W0 4A /r /is4", Operands: "xmm1, xmmV, xmm2/m128, xmmIH"},
+	{Intel: "VBLENDVPS", Go: "VBLENDVPS", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F3A.W0 4A /r /is4", Operands: "ymm1, ymmV, ymm2/m256, ymmIH"},
+	{Intel: "VBROADCASTF128", Go: "VBROADCASTF128", CPUID: "AVX", Encoding: "VEX.256.66.0F38.W0 1A /r", Operands: "ymm1, m128"},
+	{Intel: "VBROADCASTI128", Go: "VBROADCASTI128", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.W0 5A /r", Operands: "ymm1, m128"},
+	{Intel: "VBROADCASTSD", Go: "VBROADCASTSD", CPUID: "AVX", Encoding: "VEX.256.66.0F38.W0 19 /r", Operands: "ymm1, m64"},
+	{Intel: "VBROADCASTSD", Go: "VBROADCASTSD", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.W0 19 /r", Operands: "ymm1, xmm2"},
+	{Intel: "VBROADCASTSS", Go: "VBROADCASTSS", CPUID: "AVX", Encoding: "VEX.128.66.0F38.W0 18 /r", Operands: "xmm1, m32"},
+	{Intel: "VBROADCASTSS", Go: "VBROADCASTSS", CPUID: "AVX2", Encoding: "VEX.128.66.0F38.W0 18 /r", Operands: "xmm1, xmm2"},
+	{Intel: "VBROADCASTSS", Go: "VBROADCASTSS", CPUID: "AVX", Encoding: "VEX.256.66.0F38.W0 18 /r", Operands: "ymm1, m32"},
+	{Intel: "VBROADCASTSS", Go: "VBROADCASTSS", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.W0 18 /r", Operands: "ymm1, xmm2"},
+	{Intel: "VCMPPD", Go: "VCMPPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG C2 /r ib", Operands: "xmm1, xmmV, xmm2/m128, imm8"},
+	{Intel: "VCMPPD", Go: "VCMPPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG C2 /r ib", Operands: "ymm1, ymmV, ymm2/m256, imm8"},
+	{Intel: "VCMPPS", Go: "VCMPPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG C2 /r ib", Operands: "xmm1, xmmV, xmm2/m128, imm8"},
+	{Intel: "VCMPPS", Go: "VCMPPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG C2 /r ib", Operands: "ymm1, ymmV, ymm2/m256, imm8"},
+	{Intel: "VCMPSD", Go: "VCMPSD", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F2.0F.WIG C2 /r ib", Operands: "xmm1, xmmV, xmm2/m64, imm8"},
+	{Intel: "VCMPSS", Go: "VCMPSS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.WIG C2 /r ib", Operands: "xmm1, xmmV, xmm2/m32, imm8"},
+	{Intel: "VCOMISD", Go: "VCOMISD", CPUID: "AVX", Encoding: "VEX.LIG.66.0F.WIG 2F /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "VCOMISS", Go: "VCOMISS", CPUID: "AVX", Encoding: "VEX.LIG.0F.WIG 2F /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "VCVTDQ2PD", Go: "VCVTDQ2PD", CPUID: "AVX", Encoding: "VEX.128.F3.0F.WIG E6 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "VCVTDQ2PD", Go: "VCVTDQ2PD", CPUID: "AVX", Encoding: "VEX.256.F3.0F.WIG E6 /r", Operands: "ymm1, xmm2/m128"},
+	{Intel: "VCVTDQ2PS", Go: "VCVTDQ2PS", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 5B /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VCVTDQ2PS", Go: "VCVTDQ2PS", CPUID: "AVX", Encoding: "VEX.256.0F.WIG 5B /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VCVTPD2DQ", Go: "VCVTPD2DQX", CPUID: "AVX", Encoding: "VEX.128.F2.0F.WIG E6 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VCVTPD2DQ", Go: "VCVTPD2DQY", CPUID: "AVX", Encoding: "VEX.256.F2.0F.WIG E6 /r", Operands: "xmm1, ymm2/m256"},
+	{Intel: "VCVTPD2PS", Go: "VCVTPD2PSX", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 5A /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VCVTPD2PS", Go: "VCVTPD2PSY", CPUID: "AVX", Encoding: "VEX.256.66.0F.WIG 5A /r", Operands: "xmm1, ymm2/m256"},
+	{Intel: "VCVTPH2PS", Go: "VCVTPH2PS", CPUID: "F16C", Encoding: "VEX.128.66.0F38.W0 13 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "VCVTPH2PS", Go: "VCVTPH2PS", CPUID: "F16C", Encoding: "VEX.256.66.0F38.W0 13 /r", Operands: "ymm1, xmm2/m128"},
+	{Intel: "VCVTPS2DQ", Go: "VCVTPS2DQ", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 5B /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VCVTPS2DQ", Go: "VCVTPS2DQ", CPUID: "AVX", Encoding: "VEX.256.66.0F.WIG 5B /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VCVTPS2PD", Go: "VCVTPS2PD", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 5A /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "VCVTPS2PD", Go: "VCVTPS2PD", CPUID: "AVX", Encoding: "VEX.256.0F.WIG 5A /r", Operands: "ymm1, xmm2/m128"},
+	{Intel: "VCVTPS2PH", Go: "VCVTPS2PH", CPUID: "F16C", Encoding: "VEX.256.66.0F3A.W0 1D /r ib", Operands: "xmm2/m128, ymm1, imm8"},
+	{Intel: "VCVTPS2PH", Go: "VCVTPS2PH", CPUID: "F16C", Encoding: "VEX.128.66.0F3A.W0 1D /r ib", Operands: "xmm2/m64, xmm1, imm8"},
+	{Intel: "VCVTSD2SI", Go: "VCVTSD2SI", CPUID: "AVX", Encoding: "VEX.LIG.F2.0F.W0 2D /r", Operands: "r32, xmm2/m64"},
+	{Intel: "VCVTSD2SI", Go: "VCVTSD2SIQ", CPUID: "AVX", Encoding: "VEX.LIG.F2.0F.W1 2D /r", Operands: "r64, xmm2/m64"},
+	{Intel: "VCVTSD2SS", Go: "VCVTSD2SS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F2.0F.WIG 5A /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VCVTSI2SD", Go: "VCVTSI2SDL", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F2.0F.W0 2A /r", Operands: "xmm1, xmmV, r/m32"},
+	{Intel: "VCVTSI2SD", Go: "VCVTSI2SDQ", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F2.0F.W1 2A /r", Operands: "xmm1, xmmV, r/m64"},
+	{Intel: "VCVTSI2SS", Go: "VCVTSI2SSL", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.W0 2A /r", Operands: "xmm1, xmmV, r/m32"},
+	{Intel: "VCVTSI2SS", Go: "VCVTSI2SSQ", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.W1 2A /r", Operands: "xmm1, xmmV, r/m64"},
+	{Intel: "VCVTSS2SD", Go: "VCVTSS2SD", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.WIG 5A /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VCVTSS2SI", Go: "VCVTSS2SI", CPUID: "AVX", Encoding: "VEX.LIG.F3.0F.W0 2D /r", Operands: "r32, xmm2/m32"},
+	{Intel: "VCVTSS2SI", Go: "VCVTSS2SIQ", CPUID: "AVX", Encoding: "VEX.LIG.F3.0F.W1 2D /r", Operands: "r64, xmm2/m32"},
+	{Intel: "VCVTTPD2DQ", Go: "VCVTTPD2DQX", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG E6 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VCVTTPD2DQ", Go: "VCVTTPD2DQY", CPUID: "AVX", Encoding: "VEX.256.66.0F.WIG E6 /r", Operands: "xmm1, ymm2/m256"},
+	{Intel: "VCVTTPS2DQ", Go: "VCVTTPS2DQ", CPUID: "AVX", Encoding: "VEX.128.F3.0F.WIG 5B /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VCVTTPS2DQ", Go: "VCVTTPS2DQ", CPUID: "AVX", Encoding: "VEX.256.F3.0F.WIG 5B /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VCVTTSD2SI", Go: "VCVTTSD2SI", CPUID: "AVX", Encoding: "VEX.LIG.F2.0F.W0 2C /r", Operands: "r32, xmm2/m64"},
+	{Intel: "VCVTTSD2SI", Go: "VCVTTSD2SIQ", CPUID: "AVX", Encoding: "VEX.LIG.F2.0F.W1 2C /r", Operands: "r64, xmm2/m64"},
+	{Intel: "VCVTTSS2SI", Go: "VCVTTSS2SI", CPUID: "AVX", Encoding: "VEX.LIG.F3.0F.W0 2C /r", Operands: "r32, xmm2/m32"},
+	{Intel: "VCVTTSS2SI", Go: "VCVTTSS2SIQ", CPUID: "AVX", Encoding: "VEX.LIG.F3.0F.W1 2C /r", Operands: "r64, xmm2/m32"},
+	{Intel: "VDIVPD", Go: "VDIVPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 5E /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VDIVPD", Go: "VDIVPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 5E /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VDIVPS", Go: "VDIVPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 5E /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VDIVPS", Go: "VDIVPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG 5E /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VDIVSD", Go: "VDIVSD", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F2.0F.WIG 5E /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VDIVSS", Go: "VDIVSS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.WIG 5E /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VDPPD", Go: "VDPPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.WIG 41 /r ib", Operands: "xmm1, xmmV, xmm2/m128, imm8"},
+	{Intel: "VDPPS", Go: "VDPPS", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.WIG 40 /r ib", Operands: "xmm1, xmmV, xmm2/m128, imm8"},
+	{Intel: "VDPPS", Go: "VDPPS", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F3A.WIG 40 /r ib", Operands: "ymm1, ymmV, ymm2/m256, imm8"},
+	{Intel: "VERR", Go: "VERR", CPUID: "", Encoding: "0F 00 /4", Operands: "r/m16"},
+	{Intel: "VERW", Go: "VERW", CPUID: "", Encoding: "0F 00 /5", Operands: "r/m16"},
+	{Intel: "VEXTRACTF128", Go: "VEXTRACTF128", CPUID: "AVX", Encoding: "VEX.256.66.0F3A.W0 19 /r ib", Operands: "xmm2/m128, ymm1, imm8"},
+	{Intel: "VEXTRACTI128", Go: "VEXTRACTI128", CPUID: "AVX2", Encoding: "VEX.256.66.0F3A.W0 39 /r ib", Operands: "xmm2/m128, ymm1, imm8"},
+	{Intel: "VEXTRACTPS", Go: "VEXTRACTPS", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.WIG 17 /r ib", Operands: "r/m32, xmm1, imm8"},
+	{Intel: "VFMADD132PD", Go: "VFMADD132PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 98 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMADD132PD", Go: "VFMADD132PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 98 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMADD132PS", Go: "VFMADD132PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 98 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMADD132PS", Go: "VFMADD132PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 98 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMADD132SD", Go: "VFMADD132SD", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W1 99 /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VFMADD132SS", Go: "VFMADD132SS", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W0 99 /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VFMADD213PD", Go: "VFMADD213PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 A8 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMADD213PD", Go: "VFMADD213PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 A8 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMADD213PS", Go: "VFMADD213PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 A8 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMADD213PS", Go: "VFMADD213PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 A8 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMADD213SD", Go: "VFMADD213SD", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W1 A9 /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VFMADD213SS", Go: "VFMADD213SS", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W0 A9 /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VFMADD231PD", Go: "VFMADD231PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 B8 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMADD231PD", Go: "VFMADD231PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 B8 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMADD231PS", Go: "VFMADD231PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 B8 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMADD231PS", Go: "VFMADD231PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 B8 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMADD231SD", Go: "VFMADD231SD", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W1 B9 /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VFMADD231SS", Go: "VFMADD231SS", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W0 B9 /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VFMADDSUB132PD", Go: "VFMADDSUB132PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 96 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMADDSUB132PD", Go: "VFMADDSUB132PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 96 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMADDSUB132PS", Go: "VFMADDSUB132PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 96 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMADDSUB132PS", Go: "VFMADDSUB132PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 96 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMADDSUB213PD", Go: "VFMADDSUB213PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 A6 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMADDSUB213PD", Go: "VFMADDSUB213PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 A6 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMADDSUB213PS", Go: "VFMADDSUB213PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 A6 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMADDSUB213PS", Go: "VFMADDSUB213PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 A6 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMADDSUB231PD", Go: "VFMADDSUB231PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 B6 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMADDSUB231PD", Go: "VFMADDSUB231PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 B6 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMADDSUB231PS", Go: "VFMADDSUB231PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 B6 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMADDSUB231PS", Go: "VFMADDSUB231PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 B6 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMSUB132PD", Go: "VFMSUB132PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 9A /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMSUB132PD", Go: "VFMSUB132PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 9A /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMSUB132PS", Go: "VFMSUB132PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 9A /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMSUB132PS", Go: "VFMSUB132PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 9A /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMSUB132SD", Go: "VFMSUB132SD", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W1 9B /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VFMSUB132SS", Go: "VFMSUB132SS", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W0 9B /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VFMSUB213PD", Go: "VFMSUB213PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 AA /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMSUB213PD", Go: "VFMSUB213PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 AA /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMSUB213PS", Go: "VFMSUB213PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 AA /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMSUB213PS", Go: "VFMSUB213PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 AA /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMSUB213SD", Go: "VFMSUB213SD", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W1 AB /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VFMSUB213SS", Go: "VFMSUB213SS", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W0 AB /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VFMSUB231PD", Go: "VFMSUB231PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 BA /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMSUB231PD", Go: "VFMSUB231PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 BA /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMSUB231PS", Go: "VFMSUB231PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 BA /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMSUB231PS", Go: "VFMSUB231PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 BA /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMSUB231SD", Go: "VFMSUB231SD", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W1 BB /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VFMSUB231SS", Go: "VFMSUB231SS", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W0 BB /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VFMSUBADD132PD", Go: "VFMSUBADD132PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 97 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMSUBADD132PD", Go: "VFMSUBADD132PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 97 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMSUBADD132PS", Go: "VFMSUBADD132PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 97 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMSUBADD132PS", Go: "VFMSUBADD132PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 97 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMSUBADD213PD", Go: "VFMSUBADD213PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 A7 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMSUBADD213PD", Go: "VFMSUBADD213PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 A7 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMSUBADD213PS", Go: "VFMSUBADD213PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 A7 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMSUBADD213PS", Go: "VFMSUBADD213PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 A7 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMSUBADD231PD", Go: "VFMSUBADD231PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 B7 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMSUBADD231PD", Go: "VFMSUBADD231PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 B7 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFMSUBADD231PS", Go: "VFMSUBADD231PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 B7 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFMSUBADD231PS", Go: "VFMSUBADD231PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 B7 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFNMADD132PD", Go: "VFNMADD132PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 9C /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFNMADD132PD", Go: "VFNMADD132PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 9C /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFNMADD132PS", Go: "VFNMADD132PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 9C /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFNMADD132PS", Go: "VFNMADD132PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 9C /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFNMADD132SD", Go: "VFNMADD132SD", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W1 9D /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VFNMADD132SS", Go: "VFNMADD132SS", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W0 9D /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VFNMADD213PD", Go: "VFNMADD213PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 AC /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFNMADD213PD", Go: "VFNMADD213PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 AC /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFNMADD213PS", Go: "VFNMADD213PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 AC /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFNMADD213PS", Go: "VFNMADD213PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 AC /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFNMADD213SD", Go: "VFNMADD213SD", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W1 AD /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VFNMADD213SS", Go: "VFNMADD213SS", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W0 AD /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VFNMADD231PD", Go: "VFNMADD231PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 BC /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFNMADD231PD", Go: "VFNMADD231PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 BC /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFNMADD231PS", Go: "VFNMADD231PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 BC /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFNMADD231PS", Go: "VFNMADD231PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 BC /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFNMADD231SD", Go: "VFNMADD231SD", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W1 BD /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VFNMADD231SS", Go: "VFNMADD231SS", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W0 BD /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VFNMSUB132PD", Go: "VFNMSUB132PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 9E /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFNMSUB132PD", Go: "VFNMSUB132PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 9E /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFNMSUB132PS", Go: "VFNMSUB132PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 9E /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFNMSUB132PS", Go: "VFNMSUB132PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 9E /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFNMSUB132SD", Go: "VFNMSUB132SD", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W1 9F /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VFNMSUB132SS", Go: "VFNMSUB132SS", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W0 9F /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VFNMSUB213PD", Go: "VFNMSUB213PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 AE /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFNMSUB213PD", Go: "VFNMSUB213PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 AE /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFNMSUB213PS", Go: "VFNMSUB213PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 AE /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFNMSUB213PS", Go: "VFNMSUB213PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 AE /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFNMSUB213SD", Go: "VFNMSUB213SD", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W1 AF /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VFNMSUB213SS", Go: "VFNMSUB213SS", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W0 AF /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VFNMSUB231PD", Go: "VFNMSUB231PD", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W1 BE /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFNMSUB231PD", Go: "VFNMSUB231PD", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W1 BE /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFNMSUB231PS", Go: "VFNMSUB231PS", CPUID: "FMA", Encoding: "VEX.DDS.128.66.0F38.W0 BE /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VFNMSUB231PS", Go: "VFNMSUB231PS", CPUID: "FMA", Encoding: "VEX.DDS.256.66.0F38.W0 BE /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VFNMSUB231SD", Go: "VFNMSUB231SD", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W1 BF /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VFNMSUB231SS", Go: "VFNMSUB231SS", CPUID: "FMA", Encoding: "VEX.DDS.LIG.66.0F38.W0 BF /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VHADDPD", Go: "VHADDPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 7C /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VHADDPD", Go: "VHADDPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 7C /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VHADDPS", Go: "VHADDPS", CPUID: "AVX", Encoding: "VEX.NDS.128.F2.0F.WIG 7C /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VHADDPS", Go: "VHADDPS", CPUID: "AVX", Encoding: "VEX.NDS.256.F2.0F.WIG 7C /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VHSUBPD", Go: "VHSUBPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 7D /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VHSUBPD", Go: "VHSUBPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 7D /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VHSUBPS", Go: "VHSUBPS", CPUID: "AVX", Encoding: "VEX.NDS.128.F2.0F.WIG 7D /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VHSUBPS", Go: "VHSUBPS", CPUID: "AVX", Encoding: "VEX.NDS.256.F2.0F.WIG 7D /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VINSERTF128", Go: "VINSERTF128", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F3A.W0 18 /r ib", Operands: "ymm1, ymmV, xmm2/m128, imm8"},
+	{Intel: "VINSERTI128", Go: "VINSERTI128", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F3A.W0 38 /r ib", Operands: "ymm1, ymmV, xmm2/m128, imm8"},
+	{Intel: "VINSERTPS", Go: "VINSERTPS", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.WIG 21 /r ib", Operands: "xmm1, xmmV, xmm2/m32, imm8"},
+	{Intel: "VLDDQU", Go: "VLDDQU", CPUID: "AVX", Encoding: "VEX.128.F2.0F.WIG F0 /r", Operands: "xmm1, m128"},
+	{Intel: "VLDDQU", Go: "VLDDQU", CPUID: "AVX", Encoding: "VEX.256.F2.0F.WIG F0 /r", Operands: "ymm1, m256"},
+	{Intel: "VLDMXCSR", Go: "VLDMXCSR", CPUID: "AVX", Encoding: "VEX.LZ.0F.WIG AE /2", Operands: "m32"},
+	{Intel: "VMASKMOVDQU", Go: "VMASKMOVDQU", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG F7 /r", Operands: "xmm1, xmm2"},
+	{Intel: "VMASKMOVPD", Go: "VMASKMOVPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.W0 2F /r", Operands: "m128, xmmV, xmm1"},
+	{Intel: "VMASKMOVPD", Go: "VMASKMOVPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F38.W0 2F /r", Operands: "m256, ymmV, ymm1"},
+	{Intel: "VMASKMOVPD", Go: "VMASKMOVPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.W0 2D /r", Operands: "xmm1, xmmV, m128"},
+	{Intel: "VMASKMOVPD", Go: "VMASKMOVPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F38.W0 2D /r", Operands: "ymm1, ymmV, m256"},
+	{Intel: "VMASKMOVPS", Go: "VMASKMOVPS", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.W0 2E /r", Operands: "m128, xmmV, xmm1"},
+	{Intel: "VMASKMOVPS", Go: "VMASKMOVPS", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F38.W0 2E /r", Operands: "m256, ymmV, ymm1"},
+	{Intel: "VMASKMOVPS", Go: "VMASKMOVPS", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.W0 2C /r", Operands: "xmm1, xmmV, m128"},
+	{Intel: "VMASKMOVPS", Go: "VMASKMOVPS", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F38.W0 2C /r", Operands: "ymm1, ymmV, m256"},
+	{Intel: "VMAXPD", Go: "VMAXPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 5F /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VMAXPD", Go: "VMAXPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 5F /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VMAXPS", Go: "VMAXPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 5F /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VMAXPS", Go: "VMAXPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG 5F /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VMAXSD", Go: "VMAXSD", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F2.0F.WIG 5F /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VMAXSS", Go: "VMAXSS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.WIG 5F /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VMINPD", Go: "VMINPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 5D /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VMINPD", Go: "VMINPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 5D /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VMINPS", Go: "VMINPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 5D /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VMINPS", Go: "VMINPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG 5D /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VMINSD", Go: "VMINSD", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F2.0F.WIG 5D /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VMINSS", Go: "VMINSS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.WIG 5D /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 28 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 29 /r", Operands: "xmm2/m128, xmm1"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX", Encoding: "VEX.256.66.0F.WIG 28 /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX", Encoding: "VEX.256.66.0F.WIG 29 /r", Operands: "ymm2/m256, ymm1"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 28 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 29 /r", Operands: "xmm2/m128, xmm1"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX", Encoding: "VEX.256.0F.WIG 28 /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX", Encoding: "VEX.256.0F.WIG 29 /r", Operands: "ymm2/m256, ymm1"},
+	{Intel: "VMOVD", Go: "VMOVD", CPUID: "AVX", Encoding: "VEX.128.66.0F.W0 7E /r", Operands: "r/m32, xmm1"},
+	{Intel: "VMOVD", Go: "VMOVD", CPUID: "AVX", Encoding: "VEX.128.66.0F.W0 6E /r", Operands: "xmm1, r/m32"},
+	{Intel: "VMOVDDUP", Go: "VMOVDDUP", CPUID: "AVX", Encoding: "VEX.128.F2.0F.WIG 12 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "VMOVDDUP", Go: "VMOVDDUP", CPUID: "AVX", Encoding: "VEX.256.F2.0F.WIG 12 /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VMOVDQA", Go: "VMOVDQA", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 6F /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VMOVDQA", Go: "VMOVDQA", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 7F /r", Operands: "xmm2/m128, xmm1"},
+	{Intel: "VMOVDQA", Go: "VMOVDQA", CPUID: "AVX", Encoding: "VEX.256.66.0F.WIG 6F /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VMOVDQA", Go: "VMOVDQA", CPUID: "AVX", Encoding: "VEX.256.66.0F.WIG 7F /r", Operands: "ymm2/m256, ymm1"},
+	{Intel: "VMOVDQU", Go: "VMOVDQU", CPUID: "AVX", Encoding: "VEX.128.F3.0F.WIG 6F /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VMOVDQU", Go: "VMOVDQU", CPUID: "AVX", Encoding: "VEX.128.F3.0F.WIG 7F /r", Operands: "xmm2/m128, xmm1"},
+	{Intel: "VMOVDQU", Go: "VMOVDQU", CPUID: "AVX", Encoding: "VEX.256.F3.0F.WIG 6F /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VMOVDQU", Go: "VMOVDQU", CPUID: "AVX", Encoding: "VEX.256.F3.0F.WIG 7F /r", Operands: "ymm2/m256, ymm1"},
+	{Intel: "VMOVHLPS", Go: "VMOVHLPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 12 /r", Operands: "xmm1, xmmV, xmm2"},
+	{Intel: "VMOVHPD", Go: "VMOVHPD", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 17 /r", Operands: "m64, xmm1"},
+	{Intel: "VMOVHPD", Go: "VMOVHPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 16 /r", Operands: "xmm1, xmmV, m64"},
+	{Intel: "VMOVHPS", Go: "VMOVHPS", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 17 /r", Operands: "m64, xmm1"},
+	{Intel: "VMOVHPS", Go: "VMOVHPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 16 /r", Operands: "xmm1, xmmV, m64"},
+	{Intel: "VMOVLHPS", Go: "VMOVLHPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 16 /r", Operands: "xmm1, xmmV, xmm2"},
+	{Intel: "VMOVLPD", Go: "VMOVLPD", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 13 /r", Operands: "m64, xmm1"},
+	{Intel: "VMOVLPD", Go: "VMOVLPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 12 /r", Operands: "xmm1, xmmV, m64"},
+	{Intel: "VMOVLPS", Go: "VMOVLPS", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 13 /r", Operands: "m64, xmm1"},
+	{Intel: "VMOVLPS", Go: "VMOVLPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 12 /r", Operands: "xmm1, xmmV, m64"},
+	{Intel: "VMOVMSKPD", Go: "VMOVMSKPD", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 50 /r", Operands: "r32, xmm2"},
+	{Intel: "VMOVMSKPD", Go: "VMOVMSKPD", CPUID: "AVX", Encoding: "VEX.256.66.0F.WIG 50 /r", Operands: "r32, ymm2"},
+	{Intel: "VMOVMSKPS", Go: "VMOVMSKPS", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 50 /r", Operands: "r32, xmm2"},
+	{Intel: "VMOVMSKPS", Go: "VMOVMSKPS", CPUID: "AVX", Encoding: "VEX.256.0F.WIG 50 /r", Operands: "r32, ymm2"},
+	{Intel: "VMOVNTDQ", Go: "VMOVNTDQ", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG E7 /r", Operands: "m128, xmm1"},
+	{Intel: "VMOVNTDQ", Go: "VMOVNTDQ", CPUID: "AVX", Encoding: "VEX.256.66.0F.WIG E7 /r", Operands: "m256, ymm1"},
+	{Intel: "VMOVNTDQA", Go: "VMOVNTDQA", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 2A /r", Operands: "xmm1, m128"},
+	{Intel: "VMOVNTDQA", Go: "VMOVNTDQA", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 2A /r", Operands: "ymm1, m256"},
+	{Intel: "VMOVNTPD", Go: "VMOVNTPD", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 2B /r", Operands: "m128, xmm1"},
+	{Intel: "VMOVNTPD", Go: "VMOVNTPD", CPUID: "AVX", Encoding: "VEX.256.66.0F.WIG 2B /r", Operands: "m256, ymm1"},
+	{Intel: "VMOVNTPS", Go: "VMOVNTPS", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 2B /r", Operands: "m128, xmm1"},
+	{Intel: "VMOVNTPS", Go: "VMOVNTPS", CPUID: "AVX", Encoding: "VEX.256.0F.WIG 2B /r", Operands: "m256, ymm1"},
+	{Intel: "VMOVQ", Go: "VMOVQ", CPUID: "AVX", Encoding: "VEX.128.66.0F.W1 7E /r", Operands: "r/m64, xmm1"},
+	{Intel: "VMOVQ", Go: "VMOVQ", CPUID: "AVX", Encoding: "VEX.128.F3.0F.WIG 7E /r", Operands: "xmm1, m64"},
+	{Intel: "VMOVQ", Go: "VMOVQ", CPUID: "AVX", Encoding: "VEX.128.66.0F.W1 6E /r", Operands: "xmm1, r/m64"},
+	{Intel: "VMOVQ", Go: "VMOVQ", CPUID: "AVX", Encoding: "VEX.128.F3.0F.WIG 7E /r", Operands: "xmm1, xmm2"},
+	{Intel: "VMOVQ", Go: "VMOVQ", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG D6 /r", Operands: "xmm2/m64, xmm1"},
+	{Intel: "VMOVSD", Go: "VMOVSD", CPUID: "AVX", Encoding: "VEX.LIG.F2.0F.WIG 11 /r", Operands: "m64, xmm1"},
+	{Intel: "VMOVSD", Go: "VMOVSD", CPUID: "AVX", Encoding: "VEX.LIG.F2.0F.WIG 10 /r", Operands: "xmm1, m64"},
+	{Intel: "VMOVSD", Go: "VMOVSD", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F2.0F.WIG 10 /r", Operands: "xmm1, xmmV, xmm2"},
+	{Intel: "VMOVSD", Go: "VMOVSD", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F2.0F.WIG 11 /r", Operands: "xmm2, xmmV, xmm1"},
+	{Intel: "VMOVSHDUP", Go: "VMOVSHDUP", CPUID: "AVX", Encoding: "VEX.128.F3.0F.WIG 16 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VMOVSHDUP", Go: "VMOVSHDUP", CPUID: "AVX", Encoding: "VEX.256.F3.0F.WIG 16 /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VMOVSLDUP", Go: "VMOVSLDUP", CPUID: "AVX", Encoding: "VEX.128.F3.0F.WIG 12 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VMOVSLDUP", Go: "VMOVSLDUP", CPUID: "AVX", Encoding: "VEX.256.F3.0F.WIG 12 /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VMOVSS", Go: "VMOVSS", CPUID: "AVX", Encoding: "VEX.LIG.F3.0F.WIG 11 /r", Operands: "m32, xmm1"},
+	{Intel: "VMOVSS", Go: "VMOVSS", CPUID: "AVX", Encoding: "VEX.LIG.F3.0F.WIG 10 /r", Operands: "xmm1, m32"},
+	{Intel: "VMOVSS", Go: "VMOVSS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.WIG 10 /r", Operands: "xmm1, xmmV, xmm2"},
+	{Intel: "VMOVSS", Go: "VMOVSS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.WIG 11 /r", Operands: "xmm2, xmmV, xmm1"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 10 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 11 /r", Operands: "xmm2/m128, xmm1"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX", Encoding: "VEX.256.66.0F.WIG 10 /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX", Encoding: "VEX.256.66.0F.WIG 11 /r", Operands: "ymm2/m256, ymm1"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 10 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 11 /r", Operands: "xmm2/m128, xmm1"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX", Encoding: "VEX.256.0F.WIG 10 /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX", Encoding: "VEX.256.0F.WIG 11 /r", Operands: "ymm2/m256, ymm1"},
+	{Intel: "VMPSADBW", Go: "VMPSADBW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.WIG 42 /r ib", Operands: "xmm1, xmmV, xmm2/m128, imm8"},
+	{Intel: "VMPSADBW", Go: "VMPSADBW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F3A.WIG 42 /r ib", Operands: "ymm1, ymmV, ymm2/m256, imm8"},
+	{Intel: "VMULPD", Go: "VMULPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 59 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VMULPD", Go: "VMULPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 59 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VMULPS", Go: "VMULPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 59 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VMULPS", Go: "VMULPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG 59 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VMULSD", Go: "VMULSD", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F2.0F.WIG 59 /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VMULSS", Go: "VMULSS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.WIG 59 /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VORPD", Go: "VORPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 56 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VORPD", Go: "VORPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 56 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VORPS", Go: "VORPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 56 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VORPS", Go: "VORPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG 56 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPABSB", Go: "VPABSB", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 1C /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VPABSB", Go: "VPABSB", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 1C /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VPABSD", Go: "VPABSD", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 1E /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VPABSD", Go: "VPABSD", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 1E /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VPABSW", Go: "VPABSW", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 1D /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VPABSW", Go: "VPABSW", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 1D /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VPACKSSDW", Go: "VPACKSSDW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 6B /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPACKSSDW", Go: "VPACKSSDW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 6B /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPACKSSWB", Go: "VPACKSSWB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 63 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPACKSSWB", Go: "VPACKSSWB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 63 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPACKUSDW", Go: "VPACKUSDW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 2B /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPACKUSDW", Go: "VPACKUSDW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 2B /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPACKUSWB", Go: "VPACKUSWB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 67 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPACKUSWB", Go: "VPACKUSWB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 67 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPADDB", Go: "VPADDB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG FC /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPADDB", Go: "VPADDB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG FC /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPADDD", Go: "VPADDD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG FE /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPADDD", Go: "VPADDD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG FE /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPADDQ", Go: "VPADDQ", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG D4 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPADDQ", Go: "VPADDQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG D4 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPADDSB", Go: "VPADDSB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG EC /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPADDSB", Go: "VPADDSB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG EC /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPADDSW", Go: "VPADDSW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG ED /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPADDSW", Go: "VPADDSW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG ED /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPADDUSB", Go: "VPADDUSB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG DC /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPADDUSB", Go: "VPADDUSB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG DC /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPADDUSW", Go: "VPADDUSW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG DD /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPADDUSW", Go: "VPADDUSW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG DD /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPADDW", Go: "VPADDW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG FD /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPADDW", Go: "VPADDW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG FD /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPALIGNR", Go: "VPALIGNR", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.WIG 0F /r ib", Operands: "xmm1, xmmV, xmm2/m128, imm8"},
+	{Intel: "VPALIGNR", Go: "VPALIGNR", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F3A.WIG 0F /r ib", Operands: "ymm1, ymmV, ymm2/m256, imm8"},
+	{Intel: "VPAND", Go: "VPAND", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG DB /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPAND", Go: "VPAND", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG DB /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPANDN", Go: "VPANDN", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG DF /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPANDN", Go: "VPANDN", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG DF /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPAVGB", Go: "VPAVGB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG E0 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPAVGB", Go: "VPAVGB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG E0 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPAVGW", Go: "VPAVGW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG E3 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPAVGW", Go: "VPAVGW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG E3 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPBLENDD", Go: "VPBLENDD", CPUID: "AVX2", Encoding: "VEX.NDS.128.66.0F3A.W0 02 /r ib", Operands: "xmm1, xmmV, xmm2/m128, imm8"},
+	{Intel: "VPBLENDD", Go: "VPBLENDD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F3A.W0 02 /r ib", Operands: "ymm1, ymmV, ymm2/m256, imm8"},
+	{Intel: "VPBLENDVB", Go: "VPBLENDVB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.W0 4C /r /is4", Operands: "xmm1, xmmV, xmm2/m128, xmmIH"},
+	{Intel: "VPBLENDVB", Go: "VPBLENDVB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F3A.W0 4C /r /is4", Operands: "ymm1, ymmV, ymm2/m256, ymmIH"},
+	{Intel: "VPBLENDW", Go: "VPBLENDW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.WIG 0E /r ib", Operands: "xmm1, xmmV, xmm2/m128, imm8"},
+	{Intel: "VPBLENDW", Go: "VPBLENDW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F3A.WIG 0E /r ib", Operands: "ymm1, ymmV, ymm2/m256, imm8"},
+	{Intel: "VPBROADCASTB", Go: "VPBROADCASTB", CPUID: "AVX2", Encoding: "VEX.128.66.0F38.W0 78 /r", Operands: "xmm1, xmm2/m8"},
+	{Intel: "VPBROADCASTB", Go: "VPBROADCASTB", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.W0 78 /r", Operands: "ymm1, xmm2/m8"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX2", Encoding: "VEX.128.66.0F38.W0 58 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.W0 58 /r", Operands: "ymm1, xmm2/m32"},
+	{Intel: "VPBROADCASTQ", Go: "VPBROADCASTQ", CPUID: "AVX2", Encoding: "VEX.128.66.0F38.W0 59 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "VPBROADCASTQ", Go: "VPBROADCASTQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.W0 59 /r", Operands: "ymm1, xmm2/m64"},
+	{Intel: "VPBROADCASTW", Go: "VPBROADCASTW", CPUID: "AVX2", Encoding: "VEX.128.66.0F38.W0 79 /r", Operands: "xmm1, xmm2/m16"},
+	{Intel: "VPBROADCASTW", Go: "VPBROADCASTW", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.W0 79 /r", Operands: "ymm1, xmm2/m16"},
+	{Intel: "VPCLMULQDQ", Go: "VPCLMULQDQ", CPUID: "PCLMULQDQ,AVX", Encoding: "VEX.NDS.128.66.0F3A.WIG 44 /r ib", Operands: "xmm1, xmmV, xmm2/m128, imm8"},
+	{Intel: "VPCMPEQB", Go: "VPCMPEQB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 74 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPCMPEQB", Go: "VPCMPEQB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 74 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPCMPEQD", Go: "VPCMPEQD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 76 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPCMPEQD", Go: "VPCMPEQD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 76 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPCMPEQQ", Go: "VPCMPEQQ", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 29 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPCMPEQQ", Go: "VPCMPEQQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 29 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPCMPEQW", Go: "VPCMPEQW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 75 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPCMPEQW", Go: "VPCMPEQW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 75 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPCMPESTRI", Go: "VPCMPESTRI", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.WIG 61 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "VPCMPESTRM", Go: "VPCMPESTRM", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.WIG 60 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "VPCMPGTB", Go: "VPCMPGTB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 64 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPCMPGTB", Go: "VPCMPGTB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 64 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPCMPGTD", Go: "VPCMPGTD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 66 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPCMPGTD", Go: "VPCMPGTD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 66 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPCMPGTQ", Go: "VPCMPGTQ", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 37 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPCMPGTQ", Go: "VPCMPGTQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 37 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPCMPGTW", Go: "VPCMPGTW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 65 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPCMPGTW", Go: "VPCMPGTW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 65 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPCMPISTRI", Go: "VPCMPISTRI", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.WIG 63 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "VPCMPISTRM", Go: "VPCMPISTRM", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.WIG 62 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "VPERM2F128", Go: "VPERM2F128", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F3A.W0 06 /r ib", Operands: "ymm1, ymmV, ymm2/m256, imm8"},
+	{Intel: "VPERM2I128", Go: "VPERM2I128", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F3A.W0 46 /r ib", Operands: "ymm1, ymmV, ymm2/m256, imm8"},
+	{Intel: "VPERMD", Go: "VPERMD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.W0 36 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.W0 05 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.W0 0D /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX", Encoding: "VEX.256.66.0F3A.W0 05 /r ib", Operands: "ymm1, ymm2/m256, imm8"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F38.W0 0D /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.W0 04 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.W0 0C /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX", Encoding: "VEX.256.66.0F3A.W0 04 /r ib", Operands: "ymm1, ymm2/m256, imm8"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F38.W0 0C /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPERMPD", Go: "VPERMPD", CPUID: "AVX2", Encoding: "VEX.256.66.0F3A.W1 01 /r ib", Operands: "ymm1, ymm2/m256, imm8"},
+	{Intel: "VPERMPS", Go: "VPERMPS", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.W0 16 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPERMQ", Go: "VPERMQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F3A.W1 00 /r ib", Operands: "ymm1, ymm2/m256, imm8"},
+	{Intel: "VPEXTRB", Go: "VPEXTRB", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.W0 14 /r ib", Operands: "r32/m8, xmm1, imm8"},
+	{Intel: "VPEXTRD", Go: "VPEXTRD", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.W0 16 /r ib", Operands: "r/m32, xmm1, imm8"},
+	{Intel: "VPEXTRQ", Go: "VPEXTRQ", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.W1 16 /r ib", Operands: "r/m64, xmm1, imm8"},
+	{Intel: "VPEXTRW", Go: "VPEXTRW", CPUID: "AVX", Encoding: "VEX.128.66.0F.W0 C5 /r ib", Operands: "r32, xmm2, imm8"},
+	{Intel: "VPEXTRW", Go: "VPEXTRW", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.W0 15 /r ib", Operands: "r32/m16, xmm1, imm8"},
+	{Intel: "VPHADDD", Go: "VPHADDD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 02 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPHADDD", Go: "VPHADDD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 02 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPHADDSW", Go: "VPHADDSW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 03 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPHADDSW", Go: "VPHADDSW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 03 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPHADDW", Go: "VPHADDW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 01 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPHADDW", Go: "VPHADDW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 01 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPHMINPOSUW", Go: "VPHMINPOSUW", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 41 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VPHSUBD", Go: "VPHSUBD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 06 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPHSUBD", Go: "VPHSUBD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 06 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPHSUBSW", Go: "VPHSUBSW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 07 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPHSUBSW", Go: "VPHSUBSW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 07 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPHSUBW", Go: "VPHSUBW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 05 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPHSUBW", Go: "VPHSUBW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 05 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPINSRB", Go: "VPINSRB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.W0 20 /r ib", Operands: "xmm1, xmmV, r32/m8, imm8"},
+	{Intel: "VPINSRD", Go: "VPINSRD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.W0 22 /r ib", Operands: "xmm1, xmmV, r/m32, imm8"},
+	{Intel: "VPINSRQ", Go: "VPINSRQ", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F3A.W1 22 /r ib", Operands: "xmm1, xmmV, r/m64, imm8"},
+	{Intel: "VPINSRW", Go: "VPINSRW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.W0 C4 /r ib", Operands: "xmm1, xmmV, r32/m16, imm8"},
+	{Intel: "VPMADDUBSW", Go: "VPMADDUBSW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 04 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMADDUBSW", Go: "VPMADDUBSW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 04 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMADDWD", Go: "VPMADDWD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG F5 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMADDWD", Go: "VPMADDWD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG F5 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMASKMOVD", Go: "VPMASKMOVD", CPUID: "AVX2", Encoding: "VEX.NDS.128.66.0F38.W0 8E /r", Operands: "m128, xmmV, xmm1"},
+	{Intel: "VPMASKMOVD", Go: "VPMASKMOVD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.W0 8E /r", Operands: "m256, ymmV, ymm1"},
+	{Intel: "VPMASKMOVD", Go: "VPMASKMOVD", CPUID: "AVX2", Encoding: "VEX.NDS.128.66.0F38.W0 8C /r", Operands: "xmm1, xmmV, m128"},
+	{Intel: "VPMASKMOVD", Go: "VPMASKMOVD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.W0 8C /r", Operands: "ymm1, ymmV, m256"},
+	{Intel: "VPMASKMOVQ", Go: "VPMASKMOVQ", CPUID: "AVX2", Encoding: "VEX.NDS.128.66.0F38.W1 8E /r", Operands: "m128, xmmV, xmm1"},
+	{Intel: "VPMASKMOVQ", Go: "VPMASKMOVQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.W1 8E /r", Operands: "m256, ymmV, ymm1"},
+	{Intel: "VPMASKMOVQ", Go: "VPMASKMOVQ", CPUID: "AVX2", Encoding: "VEX.NDS.128.66.0F38.W1 8C /r", Operands: "xmm1, xmmV, m128"},
+	{Intel: "VPMASKMOVQ", Go: "VPMASKMOVQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.W1 8C /r", Operands: "ymm1, ymmV, m256"},
+	{Intel: "VPMAXSB", Go: "VPMAXSB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 3C /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMAXSB", Go: "VPMAXSB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 3C /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMAXSD", Go: "VPMAXSD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 3D /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMAXSD", Go: "VPMAXSD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 3D /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMAXSW", Go: "VPMAXSW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG EE /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMAXSW", Go: "VPMAXSW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG EE /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMAXUB", Go: "VPMAXUB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG DE /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMAXUB", Go: "VPMAXUB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG DE /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMAXUD", Go: "VPMAXUD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 3F /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMAXUD", Go: "VPMAXUD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 3F /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMAXUW", Go: "VPMAXUW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 3E /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMAXUW", Go: "VPMAXUW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 3E /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMINSB", Go: "VPMINSB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 38 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMINSB", Go: "VPMINSB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 38 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMINSD", Go: "VPMINSD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 39 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMINSD", Go: "VPMINSD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 39 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMINSW", Go: "VPMINSW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG EA /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMINSW", Go: "VPMINSW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG EA /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMINUB", Go: "VPMINUB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG DA /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMINUB", Go: "VPMINUB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG DA /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMINUD", Go: "VPMINUD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 3B /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMINUD", Go: "VPMINUD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 3B /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMINUW", Go: "VPMINUW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 3A /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMINUW", Go: "VPMINUW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 3A /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMOVMSKB", Go: "VPMOVMSKB", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG D7 /r", Operands: "r32, xmm2"},
+	{Intel: "VPMOVMSKB", Go: "VPMOVMSKB", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG D7 /r", Operands: "r32, ymm2"},
+	{Intel: "VPMOVSXBD", Go: "VPMOVSXBD", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 21 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "VPMOVSXBD", Go: "VPMOVSXBD", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 21 /r", Operands: "ymm1, xmm2/m64"},
+	{Intel: "VPMOVSXBQ", Go: "VPMOVSXBQ", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 22 /r", Operands: "xmm1, xmm2/m16"},
+	{Intel: "VPMOVSXBQ", Go: "VPMOVSXBQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 22 /r", Operands: "ymm1, xmm2/m32"},
+	{Intel: "VPMOVSXBW", Go: "VPMOVSXBW", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 20 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "VPMOVSXBW", Go: "VPMOVSXBW", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 20 /r", Operands: "ymm1, xmm2/m128"},
+	{Intel: "VPMOVSXDQ", Go: "VPMOVSXDQ", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 25 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "VPMOVSXDQ", Go: "VPMOVSXDQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 25 /r", Operands: "ymm1, xmm2/m128"},
+	{Intel: "VPMOVSXWD", Go: "VPMOVSXWD", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 23 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "VPMOVSXWD", Go: "VPMOVSXWD", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 23 /r", Operands: "ymm1, xmm2/m128"},
+	{Intel: "VPMOVSXWQ", Go: "VPMOVSXWQ", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 24 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "VPMOVSXWQ", Go: "VPMOVSXWQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 24 /r", Operands: "ymm1, xmm2/m64"},
+	{Intel: "VPMOVZXBD", Go: "VPMOVZXBD", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 31 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "VPMOVZXBD", Go: "VPMOVZXBD", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 31 /r", Operands: "ymm1, xmm2/m64"},
+	{Intel: "VPMOVZXBQ", Go: "VPMOVZXBQ", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 32 /r", Operands: "xmm1, xmm2/m16"},
+	{Intel: "VPMOVZXBQ", Go: "VPMOVZXBQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 32 /r", Operands: "ymm1, xmm2/m32"},
+	{Intel: "VPMOVZXBW", Go: "VPMOVZXBW", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 30 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "VPMOVZXBW", Go: "VPMOVZXBW", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 30 /r", Operands: "ymm1, xmm2/m128"},
+	{Intel: "VPMOVZXDQ", Go: "VPMOVZXDQ", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 35 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "VPMOVZXDQ", Go: "VPMOVZXDQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 35 /r", Operands: "ymm1, xmm2/m128"},
+	{Intel: "VPMOVZXWD", Go: "VPMOVZXWD", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 33 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "VPMOVZXWD", Go: "VPMOVZXWD", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 33 /r", Operands: "ymm1, xmm2/m128"},
+	{Intel: "VPMOVZXWQ", Go: "VPMOVZXWQ", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 34 /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "VPMOVZXWQ", Go: "VPMOVZXWQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F38.WIG 34 /r", Operands: "ymm1, xmm2/m64"},
+	{Intel: "VPMULDQ", Go: "VPMULDQ", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 28 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMULDQ", Go: "VPMULDQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 28 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMULHRSW", Go: "VPMULHRSW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 0B /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMULHRSW", Go: "VPMULHRSW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 0B /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMULHUW", Go: "VPMULHUW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG E4 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMULHUW", Go: "VPMULHUW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG E4 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMULHW", Go: "VPMULHW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG E5 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMULHW", Go: "VPMULHW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG E5 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMULLD", Go: "VPMULLD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 40 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMULLD", Go: "VPMULLD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 40 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMULLW", Go: "VPMULLW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG D5 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMULLW", Go: "VPMULLW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG D5 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPMULUDQ", Go: "VPMULUDQ", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG F4 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPMULUDQ", Go: "VPMULUDQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG F4 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPOR", Go: "VPOR", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG EB /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPOR", Go: "VPOR", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG EB /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSADBW", Go: "VPSADBW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG F6 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSADBW", Go: "VPSADBW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG F6 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSHUFB", Go: "VPSHUFB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 00 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSHUFB", Go: "VPSHUFB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 00 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSHUFD", Go: "VPSHUFD", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 70 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "VPSHUFD", Go: "VPSHUFD", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG 70 /r ib", Operands: "ymm1, ymm2/m256, imm8"},
+	{Intel: "VPSHUFHW", Go: "VPSHUFHW", CPUID: "AVX", Encoding: "VEX.128.F3.0F.WIG 70 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "VPSHUFHW", Go: "VPSHUFHW", CPUID: "AVX2", Encoding: "VEX.256.F3.0F.WIG 70 /r ib", Operands: "ymm1, ymm2/m256, imm8"},
+	{Intel: "VPSHUFLW", Go: "VPSHUFLW", CPUID: "AVX", Encoding: "VEX.128.F2.0F.WIG 70 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "VPSHUFLW", Go: "VPSHUFLW", CPUID: "AVX2", Encoding: "VEX.256.F2.0F.WIG 70 /r ib", Operands: "ymm1, ymm2/m256, imm8"},
+	{Intel: "VPSIGNB", Go: "VPSIGNB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 08 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSIGNB", Go: "VPSIGNB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 08 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSIGND", Go: "VPSIGND", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 0A /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSIGND", Go: "VPSIGND", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 0A /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSIGNW", Go: "VPSIGNW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F38.WIG 09 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSIGNW", Go: "VPSIGNW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.WIG 09 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG F2 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX", Encoding: "VEX.NDD.128.66.0F.WIG 72 /6 ib", Operands: "xmmV, xmm2, imm8"},
+	{Intel: "VPSLLDQ", Go: "VPSLLDQ", CPUID: "AVX", Encoding: "VEX.NDD.128.66.0F.WIG 73 /7 ib", Operands: "xmmV, xmm2, imm8"},
+	{Intel: "VPSLLDQ", Go: "VPSLLDQ", CPUID: "AVX2", Encoding: "VEX.NDD.256.66.0F.WIG 73 /7 ib", Operands: "ymmV, ymm2, imm8"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG F3 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX", Encoding: "VEX.NDD.128.66.0F.WIG 73 /6 ib", Operands: "xmmV, xmm2, imm8"},
+	{Intel: "VPSLLVD", Go: "VPSLLVD", CPUID: "AVX2", Encoding: "VEX.NDS.128.66.0F38.W0 47 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSLLVD", Go: "VPSLLVD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.W0 47 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSLLVQ", Go: "VPSLLVQ", CPUID: "AVX2", Encoding: "VEX.NDS.128.66.0F38.W1 47 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSLLVQ", Go: "VPSLLVQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.W1 47 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG F1 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX", Encoding: "VEX.NDD.128.66.0F.WIG 71 /6 ib", Operands: "xmmV, xmm2, imm8"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG F1 /r", Operands: "ymm1, ymmV, xmm2/m128"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX2", Encoding: "VEX.NDD.256.66.0F.WIG 71 /6 ib", Operands: "ymmV, ymm2, imm8"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG E2 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX", Encoding: "VEX.NDD.128.66.0F.WIG 72 /4 ib", Operands: "xmmV, xmm2, imm8"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG E2 /r", Operands: "ymm1, ymmV, xmm2/m128"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX2", Encoding: "VEX.NDD.256.66.0F.WIG 72 /4 ib", Operands: "ymmV, ymm2, imm8"},
+	{Intel: "VPSRAVD", Go: "VPSRAVD", CPUID: "AVX2", Encoding: "VEX.NDS.128.66.0F38.W0 46 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSRAVD", Go: "VPSRAVD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.W0 46 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG E1 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX", Encoding: "VEX.NDD.128.66.0F.WIG 71 /4 ib", Operands: "xmmV, xmm2, imm8"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG E1 /r", Operands: "ymm1, ymmV, xmm2/m128"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX2", Encoding: "VEX.NDD.256.66.0F.WIG 71 /4 ib", Operands: "ymmV, ymm2, imm8"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG D2 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX", Encoding: "VEX.NDD.128.66.0F.WIG 72 /2 ib", Operands: "xmmV, xmm2, imm8"},
+	{Intel: "VPSRLDQ", Go: "VPSRLDQ", CPUID: "AVX", Encoding: "VEX.NDD.128.66.0F.WIG 73 /3 ib", Operands: "xmmV, xmm2, imm8"},
+	{Intel: "VPSRLDQ", Go: "VPSRLDQ", CPUID: "AVX2", Encoding: "VEX.NDD.256.66.0F.WIG 73 /3 ib", Operands: "ymmV, ymm2, imm8"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG D3 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX", Encoding: "VEX.NDD.128.66.0F.WIG 73 /2 ib", Operands: "xmmV, xmm2, imm8"},
+	{Intel: "VPSRLVD", Go: "VPSRLVD", CPUID: "AVX2", Encoding: "VEX.NDS.128.66.0F38.W0 45 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSRLVD", Go: "VPSRLVD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.W0 45 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSRLVQ", Go: "VPSRLVQ", CPUID: "AVX2", Encoding: "VEX.NDS.128.66.0F38.W1 45 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSRLVQ", Go: "VPSRLVQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F38.W1 45 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG D1 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX", Encoding: "VEX.NDD.128.66.0F.WIG 71 /2 ib", Operands: "xmmV, xmm2, imm8"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG D1 /r", Operands: "ymm1, ymmV, xmm2/m128"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX2", Encoding: "VEX.NDD.256.66.0F.WIG 71 /2 ib", Operands: "ymmV, ymm2, imm8"},
+	{Intel: "VPSUBB", Go: "VPSUBB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG F8 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSUBB", Go: "VPSUBB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG F8 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSUBD", Go: "VPSUBD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG FA /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSUBD", Go: "VPSUBD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG FA /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSUBQ", Go: "VPSUBQ", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG FB /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSUBQ", Go: "VPSUBQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG FB /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSUBSB", Go: "VPSUBSB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG E8 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSUBSB", Go: "VPSUBSB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG E8 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSUBSW", Go: "VPSUBSW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG E9 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSUBSW", Go: "VPSUBSW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG E9 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSUBUSB", Go: "VPSUBUSB", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG D8 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSUBUSB", Go: "VPSUBUSB", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG D8 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSUBUSW", Go: "VPSUBUSW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG D9 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSUBUSW", Go: "VPSUBUSW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG D9 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPSUBW", Go: "VPSUBW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG F9 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPSUBW", Go: "VPSUBW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG F9 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPTEST", Go: "VPTEST", CPUID: "AVX", Encoding: "VEX.128.66.0F38.WIG 17 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VPTEST", Go: "VPTEST", CPUID: "AVX", Encoding: "VEX.256.66.0F38.WIG 17 /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VPUNPCKHBW", Go: "VPUNPCKHBW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 68 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPUNPCKHBW", Go: "VPUNPCKHBW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 68 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPUNPCKHDQ", Go: "VPUNPCKHDQ", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 6A /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPUNPCKHDQ", Go: "VPUNPCKHDQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 6A /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPUNPCKHQDQ", Go: "VPUNPCKHQDQ", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 6D /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPUNPCKHQDQ", Go: "VPUNPCKHQDQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 6D /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPUNPCKHWD", Go: "VPUNPCKHWD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 69 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPUNPCKHWD", Go: "VPUNPCKHWD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 69 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPUNPCKLBW", Go: "VPUNPCKLBW", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 60 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPUNPCKLBW", Go: "VPUNPCKLBW", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 60 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPUNPCKLDQ", Go: "VPUNPCKLDQ", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 62 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPUNPCKLDQ", Go: "VPUNPCKLDQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 62 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPUNPCKLQDQ", Go: "VPUNPCKLQDQ", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 6C /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPUNPCKLQDQ", Go: "VPUNPCKLQDQ", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 6C /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPUNPCKLWD", Go: "VPUNPCKLWD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 61 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPUNPCKLWD", Go: "VPUNPCKLWD", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG 61 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VPXOR", Go: "VPXOR", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG EF /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VPXOR", Go: "VPXOR", CPUID: "AVX2", Encoding: "VEX.NDS.256.66.0F.WIG EF /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VRCPPS", Go: "VRCPPS", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 53 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VRCPPS", Go: "VRCPPS", CPUID: "AVX", Encoding: "VEX.256.0F.WIG 53 /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VRCPSS", Go: "VRCPSS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.WIG 53 /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VROUNDPD", Go: "VROUNDPD", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.WIG 09 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "VROUNDPD", Go: "VROUNDPD", CPUID: "AVX", Encoding: "VEX.256.66.0F3A.WIG 09 /r ib", Operands: "ymm1, ymm2/m256, imm8"},
+	{Intel: "VROUNDPS", Go: "VROUNDPS", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.WIG 08 /r ib", Operands: "xmm1, xmm2/m128, imm8"},
+	{Intel: "VROUNDPS", Go: "VROUNDPS", CPUID: "AVX", Encoding: "VEX.256.66.0F3A.WIG 08 /r ib", Operands: "ymm1, ymm2/m256, imm8"},
+	{Intel: "VROUNDSD", Go: "VROUNDSD", CPUID: "AVX", Encoding: "VEX.NDS.LIG.66.0F3A.WIG 0B /r ib", Operands: "xmm1, xmmV, xmm2/m64, imm8"},
+	{Intel: "VROUNDSS", Go: "VROUNDSS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.66.0F3A.WIG 0A /r ib", Operands: "xmm1, xmmV, xmm2/m32, imm8"},
+	{Intel: "VRSQRTPS", Go: "VRSQRTPS", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 52 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VRSQRTPS", Go: "VRSQRTPS", CPUID: "AVX", Encoding: "VEX.256.0F.WIG 52 /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VRSQRTSS", Go: "VRSQRTSS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.WIG 52 /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VSHUFPD", Go: "VSHUFPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG C6 /r ib", Operands: "xmm1, xmmV, xmm2/m128, imm8"},
+	{Intel: "VSHUFPD", Go: "VSHUFPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG C6 /r ib", Operands: "ymm1, ymmV, ymm2/m256, imm8"},
+	{Intel: "VSHUFPS", Go: "VSHUFPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG C6 /r ib", Operands: "xmm1, xmmV, xmm2/m128, imm8"},
+	{Intel: "VSHUFPS", Go: "VSHUFPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG C6 /r ib", Operands: "ymm1, ymmV, ymm2/m256, imm8"},
+	{Intel: "VSQRTPD", Go: "VSQRTPD", CPUID: "AVX", Encoding: "VEX.128.66.0F.WIG 51 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VSQRTPD", Go: "VSQRTPD", CPUID: "AVX", Encoding: "VEX.256.66.0F.WIG 51 /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VSQRTPS", Go: "VSQRTPS", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 51 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VSQRTPS", Go: "VSQRTPS", CPUID: "AVX", Encoding: "VEX.256.0F.WIG 51 /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VSQRTSD", Go: "VSQRTSD", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F2.0F.WIG 51 /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VSQRTSS", Go: "VSQRTSS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.WIG 51 /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VSTMXCSR", Go: "VSTMXCSR", CPUID: "AVX", Encoding: "VEX.LZ.0F.WIG AE /3", Operands: "m32"},
+	{Intel: "VSUBPD", Go: "VSUBPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 5C /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VSUBPD", Go: "VSUBPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 5C /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VSUBPS", Go: "VSUBPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 5C /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VSUBPS", Go: "VSUBPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG 5C /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VSUBSD", Go: "VSUBSD", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F2.0F.WIG 5C /r", Operands: "xmm1, xmmV, xmm2/m64"},
+	{Intel: "VSUBSS", Go: "VSUBSS", CPUID: "AVX", Encoding: "VEX.NDS.LIG.F3.0F.WIG 5C /r", Operands: "xmm1, xmmV, xmm2/m32"},
+	{Intel: "VTESTPD", Go: "VTESTPD", CPUID: "AVX", Encoding: "VEX.128.66.0F38.W0 0F /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VTESTPD", Go: "VTESTPD", CPUID: "AVX", Encoding: "VEX.256.66.0F38.W0 0F /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VTESTPS", Go: "VTESTPS", CPUID: "AVX", Encoding: "VEX.128.66.0F38.W0 0E /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "VTESTPS", Go: "VTESTPS", CPUID: "AVX", Encoding: "VEX.256.66.0F38.W0 0E /r", Operands: "ymm1, ymm2/m256"},
+	{Intel: "VUCOMISD", Go: "VUCOMISD", CPUID: "AVX", Encoding: "VEX.LIG.66.0F.WIG 2E /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "VUCOMISS", Go: "VUCOMISS", CPUID: "AVX", Encoding: "VEX.LIG.0F.WIG 2E /r", Operands: "xmm1, xmm2/m32"},
+	{Intel: "VUNPCKHPD", Go: "VUNPCKHPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 15 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VUNPCKHPD", Go: "VUNPCKHPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 15 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VUNPCKHPS", Go: "VUNPCKHPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 15 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VUNPCKHPS", Go: "VUNPCKHPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG 15 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VUNPCKLPD", Go: "VUNPCKLPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 14 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VUNPCKLPD", Go: "VUNPCKLPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 14 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VUNPCKLPS", Go: "VUNPCKLPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 14 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VUNPCKLPS", Go: "VUNPCKLPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG 14 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VXORPD", Go: "VXORPD", CPUID: "AVX", Encoding: "VEX.NDS.128.66.0F.WIG 57 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VXORPD", Go: "VXORPD", CPUID: "AVX", Encoding: "VEX.NDS.256.66.0F.WIG 57 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VXORPS", Go: "VXORPS", CPUID: "AVX", Encoding: "VEX.NDS.128.0F.WIG 57 /r", Operands: "xmm1, xmmV, xmm2/m128"},
+	{Intel: "VXORPS", Go: "VXORPS", CPUID: "AVX", Encoding: "VEX.NDS.256.0F.WIG 57 /r", Operands: "ymm1, ymmV, ymm2/m256"},
+	{Intel: "VZEROALL", Go: "VZEROALL", CPUID: "AVX", Encoding: "VEX.256.0F.WIG 77", Operands: ""},
+	{Intel: "VZEROUPPER", Go: "VZEROUPPER", CPUID: "AVX", Encoding: "VEX.128.0F.WIG 77", Operands: ""},
+	{Intel: "WAIT", Go: "WAIT", CPUID: "", Encoding: "9B", Operands: ""},
+	{Intel: "WBINVD", Go: "WBINVD", CPUID: "", Encoding: "0F 09", Operands: ""},
+	{Intel: "WRFSBASE", Go: "WRFSBASE", CPUID: "FSGSBASE", Encoding: "F3 0F AE /2", Operands: "rmr32"},
+	{Intel: "WRFSBASE", Go: "WRFSBASE", CPUID: "FSGSBASE", Encoding: "F3 REX.W 0F AE /2", Operands: "rmr64"},
+	{Intel: "WRGSBASE", Go: "WRGSBASE", CPUID: "FSGSBASE", Encoding: "F3 0F AE /3", Operands: "rmr32"},
+	{Intel: "WRGSBASE", Go: "WRGSBASE", CPUID: "FSGSBASE", Encoding: "F3 REX.W 0F AE /3", Operands: "rmr64"},
+	{Intel: "WRMSR", Go: "WRMSR", CPUID: "", Encoding: "0F 30", Operands: ""},
+	{Intel: "WRPKRU", Go: "WRPKRU", CPUID: "OSPKE", Encoding: "0F 01 EF", Operands: ""},
+	{Intel: "XABORT", Go: "XABORT", CPUID: "RTM", Encoding: "C6 F8 ib", Operands: "imm8"},
+	{Intel: "XACQUIRE", Go: "XACQUIRE", CPUID: "HLE", Encoding: "F2", Operands: ""},
+	{Intel: "XADD", Go: "XADDW", CPUID: "", Encoding: "0F C1 /r", Operands: "r/m16, r16"},
+	{Intel: "XADD", Go: "XADDL", CPUID: "", Encoding: "0F C1 /r", Operands: "r/m32, r32"},
+	{Intel: "XADD", Go: "XADDQ", CPUID: "", Encoding: "REX.W 0F C1 /r", Operands: "r/m64, r64"},
+	{Intel: "XADD", Go: "XADDB", CPUID: "", Encoding: "0F C0 /r", Operands: "r/m8, r8"},
+	{Intel: "XADD", Go: "XADDB", CPUID: "", Encoding: "REX 0F C0 /r", Operands: "r/m8, r8"},
+	{Intel: "XBEGIN", Go: "XBEGIN", CPUID: "RTM", Encoding: "C7 F8 cw", Operands: "rel16"},
+	{Intel: "XBEGIN", Go: "XBEGIN", CPUID: "RTM", Encoding: "C7 F8 cd", Operands: "rel32"},
+	{Intel: "XCHG", Go: "XCHGW", CPUID: "", Encoding: "90+rw", Operands: "AX, r16op"},
+	{Intel: "XCHG", Go: "XCHGL", CPUID: "", Encoding: "90+rd", Operands: "EAX, r32op"},
+	{Intel: "XCHG", Go: "XCHGQ", CPUID: "", Encoding: "REX.W 90+rd", Operands: "RAX, r64op"},
+	{Intel: "XCHG", Go: "XCHGW", CPUID: "", Encoding: "87 /r", Operands: "r/m16, r16"},
+	{Intel: "XCHG", Go: "XCHGL", CPUID: "", Encoding: "87 /r", Operands: "r/m32, r32"},
+	{Intel: "XCHG", Go: "XCHGQ", CPUID: "", Encoding: "REX.W 87 /r", Operands: "r/m64, r64"},
+	{Intel: "XCHG", Go: "XCHGB", CPUID: "", Encoding: "86 /r", Operands: "r/m8, r8"},
+	{Intel: "XCHG", Go: "XCHGB", CPUID: "", Encoding: "REX 86 /r", Operands: "r/m8, r8"},
+	{Intel: "XCHG", Go: "XCHGW", CPUID: "", Encoding: "87 /r", Operands: "r16, r/m16"},
+	{Intel: "XCHG", Go: "XCHGW", CPUID: "", Encoding: "90+rw", Operands: "r16op, AX"},
+	{Intel: "XCHG", Go: "XCHGL", CPUID: "", Encoding: "87 /r", Operands: "r32, r/m32"},
+	{Intel: "XCHG", Go: "XCHGL", CPUID: "", Encoding: "90+rd", Operands: "r32op, EAX"},
+	{Intel: "XCHG", Go: "XCHGQ", CPUID: "", Encoding: "REX.W 87 /r", Operands: "r64, r/m64"},
+	{Intel: "XCHG", Go: "XCHGQ", CPUID: "", Encoding: "REX.W 90+rd", Operands: "r64op, RAX"},
+	{Intel: "XCHG", Go: "XCHGB", CPUID: "", Encoding: "86 /r", Operands: "r8, r/m8"},
+	{Intel: "XCHG", Go: "XCHGB", CPUID: "", Encoding: "REX 86 /r", Operands: "r8, r/m8"},
+	{Intel: "XEND", Go: "XEND", CPUID: "RTM", Encoding: "0F 01 D5", Operands: ""},
+	{Intel: "XGETBV", Go: "XGETBV", CPUID: "XSAVE", Encoding: "0F 01 D0", Operands: ""},
+	{Intel: "XLATB", Go: "XLAT", CPUID: "", Encoding: "D7", Operands: ""},
+	{Intel: "XLATB", Go: "XLAT", CPUID: "", Encoding: "REX.W D7", Operands: ""},
+	{Intel: "XOR", Go: "XORB", CPUID: "", Encoding: "34 ib", Operands: "AL, imm8"},
+	{Intel: "XOR", Go: "XORW", CPUID: "", Encoding: "35 iw", Operands: "AX, imm16"},
+	{Intel: "XOR", Go: "XORL", CPUID: "", Encoding: "35 id", Operands: "EAX, imm32"},
+	{Intel: "XOR", Go: "XORQ", CPUID: "", Encoding: "REX.W 35 id", Operands: "RAX, imm32"},
+	{Intel: "XOR", Go: "XORW", CPUID: "", Encoding: "81 /6 iw", Operands: "r/m16, imm16"},
+	{Intel: "XOR", Go: "XORW", CPUID: "", Encoding: "83 /6 ib", Operands: "r/m16, imm8"},
+	{Intel: "XOR", Go: "XORW", CPUID: "", Encoding: "31 /r", Operands: "r/m16, r16"},
+	{Intel: "XOR", Go: "XORL", CPUID: "", Encoding: "81 /6 id", Operands: "r/m32, imm32"},
+	{Intel: "XOR", Go: "XORL", CPUID: "", Encoding: "83 /6 ib", Operands: "r/m32, imm8"},
+	{Intel: "XOR", Go: "XORL", CPUID: "", Encoding: "31 /r", Operands: "r/m32, r32"},
+	{Intel: "XOR", Go: "XORQ", CPUID: "", Encoding: "REX.W 81 /6 id", Operands: "r/m64, imm32"},
+	{Intel: "XOR", Go: "XORQ", CPUID: "", Encoding: "REX.W 83 /6 ib", Operands: "r/m64, imm8"},
+	{Intel: "XOR", Go: "XORQ", CPUID: "", Encoding: "REX.W 31 /r", Operands: "r/m64, r64"},
+	{Intel: "XOR", Go: "XORB", CPUID: "", Encoding: "80 /6 ib", Operands: "r/m8, imm8"},
+	{Intel: "XOR", Go: "XORB", CPUID: "", Encoding: "REX 80 /6 ib", Operands: "r/m8, imm8"},
+	{Intel: "XOR", Go: "XORB", CPUID: "", Encoding: "30 /r", Operands: "r/m8, r8"},
+	{Intel: "XOR", Go: "XORB", CPUID: "", Encoding: "REX 30 /r", Operands: "r/m8, r8"},
+	{Intel: "XOR", Go: "XORW", CPUID: "", Encoding: "33 /r", Operands: "r16, r/m16"},
+	{Intel: "XOR", Go: "XORL", CPUID: "", Encoding: "33 /r", Operands: "r32, r/m32"},
+	{Intel: "XOR", Go: "XORQ", CPUID: "", Encoding: "REX.W 33 /r", Operands: "r64, r/m64"},
+	{Intel: "XOR", Go: "XORB", CPUID: "", Encoding: "32 /r", Operands: "r8, r/m8"},
+	{Intel: "XOR", Go: "XORB", CPUID: "", Encoding: "REX 32 /r", Operands: "r8, r/m8"},
+	{Intel: "XORPD", Go: "XORPD", CPUID: "SSE2", Encoding: "66 0F 57 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "XORPS", Go: "XORPS", CPUID: "SSE", Encoding: "0F 57 /r", Operands: "xmm1, xmm2/m128"},
+	{Intel: "XRELEASE", Go: "XRELEASE", CPUID: "HLE", Encoding: "F3", Operands: ""},
+	{Intel: "XRSTOR", Go: "XRSTOR", CPUID: "XSAVE", Encoding: "0F AE /5", Operands: "mem"},
+	{Intel: "XRSTOR64", Go: "XRSTOR64", CPUID: "XSAVE", Encoding: "REX.W 0F AE /5", Operands: "mem"},
+	{Intel: "XRSTORS", Go: "XRSTORS", CPUID: "XSAVES", Encoding: "0F C7 /3", Operands: "mem"},
+	{Intel: "XRSTORS64", Go: "XRSTORS64", CPUID: "XSAVES", Encoding: "REX.W 0F C7 /3", Operands: "mem"},
+	{Intel: "XSAVE", Go: "XSAVE", CPUID: "XSAVE", Encoding: "0F AE /4", Operands: "mem"},
+	{Intel: "XSAVE64", Go: "XSAVE64", CPUID: "XSAVE", Encoding: "REX.W 0F AE /4", Operands: "mem"},
+	{Intel: "XSAVEC", Go: "XSAVEC", CPUID: "XSAVEC", Encoding: "0F C7 /4", Operands: "mem"},
+	{Intel: "XSAVEC64", Go: "XSAVEC64", CPUID: "XSAVEC", Encoding: "REX.W 0F C7 /4", Operands: "mem"},
+	{Intel: "XSAVEOPT", Go: "XSAVEOPT", CPUID: "XSAVEOPT", Encoding: "0F AE /6", Operands: "mem"},
+	{Intel: "XSAVEOPT64", Go: "XSAVEOPT64", CPUID: "XSAVEOPT", Encoding: "REX.W 0F AE /6", Operands: "mem"},
+	{Intel: "XSAVES", Go: "XSAVES", CPUID: "XSAVES", Encoding: "0F C7 /5", Operands: "mem"},
+	{Intel: "XSAVES64", Go: "XSAVES64", CPUID: "XSAVES", Encoding: "REX.W 0F C7 /5", Operands: "mem"},
+	{Intel: "XSETBV", Go: "XSETBV", CPUID: "XSAVE", Encoding: "0F 01 D1", Operands: ""},
+	{Intel: "XTEST", Go: "XTEST", CPUID: "HLE,RTM", Encoding: "0F 01 D6", Operands: ""},
+	{Intel: "CMPS", Go: "", CPUID: "", Encoding: "A7", Operands: "m16, m16"},
+	{Intel: "CMPS", Go: "", CPUID: "", Encoding: "A7", Operands: "m32, m32"},
+	{Intel: "CMPS", Go: "", CPUID: "", Encoding: "REX.W + A7", Operands: "m64, m64"},
+	{Intel: "CMPS", Go: "", CPUID: "", Encoding: "A6", Operands: "m8, m8"},
+	{Intel: "CMPSD_XMM", Go: "", CPUID: "SSE2", Encoding: "F2 0F C2 /r ib", Operands: "xmm1, xmm2/m64, imm8u"},
+	{Intel: "FLDLN2", Go: "", CPUID: "FPU", Encoding: "D9 ED", Operands: ""},
+	{Intel: "FLDZ", Go: "", CPUID: "FPU", Encoding: "D9 EE", Operands: ""},
+	{Intel: "INS", Go: "", CPUID: "", Encoding: "6D", Operands: "m16, DX"},
+	{Intel: "INS", Go: "", CPUID: "", Encoding: "6D", Operands: "m32, DX"},
+	{Intel: "INS", Go: "", CPUID: "", Encoding: "6C", Operands: "m8, DX"},
+	{Intel: "LCALL", Go: "", CPUID: "", Encoding: "FF /3", Operands: "m16:16"},
+	{Intel: "LCALL", Go: "", CPUID: "", Encoding: "FF /3", Operands: "m16:32"},
+	{Intel: "LCALL", Go: "", CPUID: "", Encoding: "REX.W + FF /3", Operands: "m16:64"},
+	{Intel: "LCALL", Go: "", CPUID: "", Encoding: "9A cd", Operands: "ptr16:16"},
+	{Intel: "LCALL", Go: "", CPUID: "", Encoding: "9A cp", Operands: "ptr16:32"},
+	{Intel: "LJMP", Go: "", CPUID: "", Encoding: "FF /5", Operands: "m16:16"},
+	{Intel: "LJMP", Go: "", CPUID: "", Encoding: "FF /5", Operands: "m16:32"},
+	{Intel: "LJMP", Go: "", CPUID: "", Encoding: "REX.W + FF /5", Operands: "m16:64"},
+	{Intel: "LJMP", Go: "", CPUID: "", Encoding: "EA cd", Operands: "ptr16:16"},
+	{Intel: "LJMP", Go: "", CPUID: "", Encoding: "EA cp", Operands: "ptr16:32"},
+	{Intel: "LODS", Go: "", CPUID: "", Encoding: "AD", Operands: "m16"},
+	{Intel: "LODS", Go: "", CPUID: "", Encoding: "AD", Operands: "m32"},
+	{Intel: "LODS", Go: "", CPUID: "", Encoding: "REX.W + AD", Operands: "m64"},
+	{Intel: "LODS", Go: "", CPUID: "", Encoding: "AC", Operands: "m8"},
+	{Intel: "LRET", Go: "", CPUID: "", Encoding: "CA iw", Operands: "imm16u"},
+	{Intel: "LRET", Go: "", CPUID: "", Encoding: "CB", Operands: ""},
+	{Intel: "MOVS", Go: "", CPUID: "", Encoding: "A5", Operands: "m16, m16"},
+	{Intel: "MOVS", Go: "", CPUID: "", Encoding: "A5", Operands: "m32, m32"},
+	{Intel: "MOVS", Go: "", CPUID: "", Encoding: "REX.W + A5", Operands: "m64, m64"},
+	{Intel: "MOVS", Go: "", CPUID: "", Encoding: "A4", Operands: "m8, m8"},
+	{Intel: "MOVSD_XMM", Go: "", CPUID: "SSE2", Encoding: "F2 0F 10 /r", Operands: "xmm1, xmm2/m64"},
+	{Intel: "MOVSD_XMM", Go: "", CPUID: "SSE2", Encoding: "F2 0F 11 /r", Operands: "xmm2/m64, xmm1"},
+	{Intel: "OUTS", Go: "", CPUID: "", Encoding: "6F", Operands: "DX, m16"},
+	{Intel: "OUTS", Go: "", CPUID: "", Encoding: "6F", Operands: "DX, m32"},
+	{Intel: "OUTS", Go: "", CPUID: "", Encoding: "6E", Operands: "DX, m8"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 6D", Operands: "INS m16, DX"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 6D", Operands: "INS m32, DX"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 6C", Operands: "INS m8, DX"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 6D", Operands: "INS r/m32, DX"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 AC", Operands: "LODS AL"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 REX.W AC", Operands: "LODS AL"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 AD", Operands: "LODS AX"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 AD", Operands: "LODS EAX"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 REX.W AD", Operands: "LODS RAX"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 A5", Operands: "MOVS m16, m16"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 A5", Operands: "MOVS m32, m32"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 REX.W A5", Operands: "MOVS m64, m64"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 A4", Operands: "MOVS m8, m8"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 REX.W A4", Operands: "MOVS m8, m8"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 6F", Operands: "OUTS DX, r/m16"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 6F", Operands: "OUTS DX, r/m32"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 REX.W 6F", Operands: "OUTS DX, r/m32"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 6E", Operands: "OUTS DX, r/m8"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 REX.W 6E", Operands: "OUTS DX, r/m8"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 AB", Operands: "STOS m16"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 AB", Operands: "STOS m32"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 REX.W AB", Operands: "STOS m64"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 AA", Operands: "STOS m8"},
+	{Intel: "REP", Go: "", CPUID: "", Encoding: "F3 REX.W AA", Operands: "STOS m8"},
+	{Intel: "REPE", Go: "", CPUID: "", Encoding: "F3 A7", Operands: "CMPS m16, m16"},
+	{Intel: "REPE", Go: "", CPUID: "", Encoding: "F3 A7", Operands: "CMPS m32, m32"},
+	{Intel: "REPE", Go: "", CPUID: "", Encoding: "F3 REX.W A7", Operands: "CMPS m64, m64"},
+	{Intel: "REPE", Go: "", CPUID: "", Encoding: "F3 A6", Operands: "CMPS m8, m8"},
+	{Intel: "REPE", Go: "", CPUID: "", Encoding: "F3 REX.W A6", Operands: "CMPS m8, m8"},
+	{Intel: "REPE", Go: "", CPUID: "", Encoding: "F3 AF", Operands: "SCAS m16"},
+	{Intel: "REPE", Go: "", CPUID: "", Encoding: "F3 AF", Operands: "SCAS m32"},
+	{Intel: "REPE", Go: "", CPUID: "", Encoding: "F3 AE", Operands: "SCAS m8"},
+	{Intel: "REPE", Go: "", CPUID: "", Encoding: "F3 REX.W AE", Operands: "SCAS m8"},
+	{Intel: "SCAS", Go: "", CPUID: "", Encoding: "AF", Operands: "m16"},
+	{Intel: "SCAS", Go: "", CPUID: "", Encoding: "AF", Operands: "m32"},
+	{Intel: "SCAS", Go: "", CPUID: "", Encoding: "REX.W + AF", Operands: "m64"},
+	{Intel: "SCAS", Go: "", CPUID: "", Encoding: "AE", Operands: "m8"},
+	{Intel: "STOS", Go: "", CPUID: "", Encoding: "AB", Operands: "m16"},
+	{Intel: "STOS", Go: "", CPUID: "", Encoding: "AB", Operands: "m32"},
+	{Intel: "STOS", Go: "", CPUID: "", Encoding: "REX.W + AB", Operands: "m64"},
+	{Intel: "STOS", Go: "", CPUID: "", Encoding: "AA", Operands: "m8"},
+	{Intel: "UD0", Go: "", CPUID: "", Encoding: "0F FF /r", Operands: "r32, r/m32"},
+	{Intel: "VGATHERDPD", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.128.66.0F38.W1 92 /r", Operands: "xmm1, vm32x, xmm2"},
+	{Intel: "VGATHERDPD", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.256.66.0F38.W1 92 /r", Operands: "ymm1, vm32x, ymm2"},
+	{Intel: "VGATHERDPS", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.128.66.0F38.W0 92 /r", Operands: "xmm1, vm32x, xmm2"},
+	{Intel: "VGATHERDPS", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.256.66.0F38.W0 92 /r", Operands: "ymm1, vm32y, ymm2"},
+	{Intel: "VGATHERQPD", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.128.66.0F38.W1 93 /r", Operands: "xmm1, vm64x, xmm2"},
+	{Intel: "VGATHERQPD", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.256.66.0F38.W1 93 /r", Operands: "ymm1, vm64y, ymm2"},
+	{Intel: "VGATHERQPS", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.128.66.0F38.W0 93 /r", Operands: "xmm1, vm64x, xmm2"},
+	{Intel: "VGATHERQPS", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.256.66.0F38.W0 93 /r", Operands: "xmm1, vm64y, xmm2"},
+	{Intel: "VPGATHERDD", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.128.66.0F38.W0 90 /r", Operands: "xmm1, vm32x, xmm2"},
+	{Intel: "VPGATHERDD", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.256.66.0F38.W0 90 /r", Operands: "ymm1, vm32y, ymm2"},
+	{Intel: "VPGATHERDQ", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.128.66.0F38.W1 90 /r", Operands: "xmm1, vm32x, xmm2"},
+	{Intel: "VPGATHERDQ", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.256.66.0F38.W1 90 /r", Operands: "ymm1, vm32x, ymm2"},
+	{Intel: "VPGATHERQD", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.128.66.0F38.W0 91 /r", Operands: "xmm1, vm64x, xmm2"},
+	{Intel: "VPGATHERQD", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.256.66.0F38.W0 91 /r", Operands: "xmm1, vm64y, xmm2"},
+	{Intel: "VPGATHERQQ", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.128.66.0F38.W1 91 /r", Operands: "xmm1, vm64x, xmm2"},
+	{Intel: "VPGATHERQQ", Go: "", CPUID: "AVX2", Encoding: "VEX.DDS.256.66.0F38.W1 91 /r", Operands: "ymm1, vm64y, ymm2"},
+	{Intel: "XLAT", Go: "", CPUID: "", Encoding: "D7", Operands: "m8"},
+	{Intel: "CLRSSBSY", Go: "CLRSSBSY", CPUID: "CET", Encoding: "F3 0F AE /6", Operands: "m"},
+	{Intel: "ENDBR32", Go: "ENDBR32", CPUID: "CET", Encoding: "F3 0F 1E /7", Operands: ""},
+	{Intel: "ENDBR64", Go: "ENDBR64", CPUID: "CET", Encoding: "F3 0F 1E /7", Operands: ""},
+	{Intel: "INCSSPD", Go: "INCSSPD", CPUID: "CET", Encoding: "F3 0F AE /5", Operands: "r32"},
+	{Intel: "INCSSPQ", Go: "INCSSPQ", CPUID: "CET", Encoding: "F3 0F AE /5", Operands: "r64"},
+	{Intel: "RDSSPD", Go: "RDSSPD", CPUID: "CET", Encoding: "F3 0F 1E /1", Operands: "r32"},
+	{Intel: "RDSSPQ", Go: "RDSSPQ", CPUID: "CET", Encoding: "F3 0F 1E /1", Operands: "r64"},
+	{Intel: "RSTORSSP", Go: "RSTORSSP", CPUID: "CET", Encoding: "F3 0F 01 /5", Operands: "m"},
+	{Intel: "SAVESSP", Go: "SAVESSP", CPUID: "CET", Encoding: "F3 0F 01 /5", Operands: ""},
+	{Intel: "SETSSBSY", Go: "SETSSBSY", CPUID: "CET", Encoding: "F3 0F 01 /5", Operands: ""},
+	{Intel: "WRSSD", Go: "WRSSD", CPUID: "CET", Encoding: "NP 0F 38 F6 /r", Operands: "m, r32"},
+	{Intel: "WRSSQ", Go: "WRSSQ", CPUID: "CET", Encoding: "NP 0F 38 F6 /r", Operands: "m, r64"},
+	{Intel: "WRUSSD", Go: "WRUSSD", CPUID: "CET", Encoding: "66 0F 38 F5 /r", Operands: "m, r32"},
+	{Intel: "WRUSSQ", Go: "WRUSSQ", CPUID: "CET", Encoding: "66 0F 38 F5 /r", Operands: "m, r64"},
+	{Intel: "SHA1MSG1", Go: "SHA1MSG1", CPUID: "SHA", Encoding: "NP 0F 38 C9 /r", Operands: "xmm, xmm"},
+	{Intel: "SHA1MSG1", Go: "SHA1MSG1", CPUID: "SHA", Encoding: "NP 0F 38 C9 /r", Operands: "xmm, m"},
+	{Intel: "SHA1MSG2", Go: "SHA1MSG2", CPUID: "SHA", Encoding: "NP 0F 38 CA /r", Operands: "xmm, xmm"},
+	{Intel: "SHA1MSG2", Go: "SHA1MSG2", CPUID: "SHA", Encoding: "NP 0F 38 CA /r", Operands: "xmm, m"},
+	{Intel: "SHA1NEXTE", Go: "SHA1NEXTE", CPUID: "SHA", Encoding: "NP 0F 38 C8 /r", Operands: "xmm, xmm"},
+	{Intel: "SHA1NEXTE", Go: "SHA1NEXTE", CPUID: "SHA", Encoding: "NP 0F 38 C8 /r", Operands: "xmm, m"},
+	{Intel: "SHA1RNDS4", Go: "SHA1RNDS4", CPUID: "SHA", Encoding: "NP 0F 3A CC /r ib", Operands: "xmm, xmm, imm8"},
+	{Intel: "SHA1RNDS4", Go: "SHA1RNDS4", CPUID: "SHA", Encoding: "NP 0F 3A CC /r ib", Operands: "xmm, m, imm8"},
+	{Intel: "SHA256MSG1", Go: "SHA256MSG1", CPUID: "SHA", Encoding: "NP 0F 38 CC /r", Operands: "xmm, xmm"},
+	{Intel: "SHA256MSG1", Go: "SHA256MSG1", CPUID: "SHA", Encoding: "NP 0F 38 CC /r", Operands: "xmm, m"},
+	{Intel: "SHA256MSG2", Go: "SHA256MSG2", CPUID: "SHA", Encoding: "NP 0F 38 CD /r", Operands: "xmm, xmm"},
+	{Intel: "SHA256MSG2", Go: "SHA256MSG2", CPUID: "SHA", Encoding: "NP 0F 38 CD /r", Operands: "xmm, m"},
+	{Intel: "SHA256RNDS2", Go: "SHA256RNDS2", CPUID: "SHA", Encoding: "NP 0F 38 CB /r", Operands: "xmm, xmm"},
+	{Intel: "SHA256RNDS2", Go: "SHA256RNDS2", CPUID: "SHA", Encoding: "NP 0F 38 CB /r", Operands: "xmm, m"},
+	{Intel: "VPCLMULQDQ", Go: "VPCLMULQDQ", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.WIG 44 /r ib", Operands: "xmm, xmm, xmm, imm8"},
+	{Intel: "VPCLMULQDQ", Go: "VPCLMULQDQ", CPUID: "AVX", Encoding: "VEX.128.66.0F3A.WIG 44 /r ib", Operands: "xmm, xmm, m, imm8"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG F2 /r", Operands: "ymm, ymm, m"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG F2 /r", Operands: "ymm, ymm, xmm"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG F3 /r", Operands: "ymm, ymm, m"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG F3 /r", Operands: "ymm, ymm, xmm"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG D2 /r", Operands: "ymm, ymm, m"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG D2 /r", Operands: "ymm, ymm, xmm"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG D3 /r", Operands: "ymm, ymm, m"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG D3 /r", Operands: "ymm, ymm, xmm"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG 72 /6 ib", Operands: "ymm, ymm, imm8"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG 73 /6 ib", Operands: "ymm, ymm, imm8"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG 72 /2 ib", Operands: "ymm, ymm, imm8"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX2", Encoding: "VEX.256.66.0F.WIG 73 /2 ib", Operands: "ymm, ymm, imm8"},
+	{Intel: "VMFUNC", Go: "VMFUNC", CPUID: "VMFUNC", Encoding: "NP 0F 01 /2", Operands: ""},
+	{Intel: "XTEST", Go: "XTEST", CPUID: "RTM", Encoding: "NP 0F 01 /2", Operands: ""},
+	{Intel: "ENCLU", Go: "ENCLU", CPUID: "SGX", Encoding: "NP 0F 01 /2", Operands: ""},
+	{Intel: "ENCLS", Go: "ENCLS", CPUID: "SGX", Encoding: "NP 0F 01 /1", Operands: ""},
+	{Intel: "CLWB", Go: "CLWB", CPUID: "CLWB", Encoding: "66 0F AE /6", Operands: "m"},
+	{Intel: "VEXP2PD", Go: "VEXP2PD", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.512.66.0F38.W1 C8 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VEXP2PD", Go: "VEXP2PD", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.512.66.0F38.W1 C8 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VEXP2PS", Go: "VEXP2PS", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.512.66.0F38.W0 C8 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VEXP2PS", Go: "VEXP2PS", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.512.66.0F38.W0 C8 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VGATHERPF0DPD", Go: "VGATHERPF0DPD", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W1 C6 /1", Operands: "m"},
+	{Intel: "VGATHERPF0DPS", Go: "VGATHERPF0DPS", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W0 C6 /1", Operands: "m"},
+	{Intel: "VGATHERPF0QPD", Go: "VGATHERPF0QPD", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W1 C7 /1", Operands: "m"},
+	{Intel: "VGATHERPF0QPS", Go: "VGATHERPF0QPS", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W0 C7 /1", Operands: "m"},
+	{Intel: "VGATHERPF1DPD", Go: "VGATHERPF1DPD", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W1 C6 /2", Operands: "m"},
+	{Intel: "VGATHERPF1DPS", Go: "VGATHERPF1DPS", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W0 C6 /2", Operands: "m"},
+	{Intel: "VGATHERPF1QPD", Go: "VGATHERPF1QPD", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W1 C7 /2", Operands: "m"},
+	{Intel: "VGATHERPF1QPS", Go: "VGATHERPF1QPS", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W0 C7 /2", Operands: "m"},
+	{Intel: "VRCP28PD", Go: "VRCP28PD", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.512.66.0F38.W1 CA /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VRCP28PD", Go: "VRCP28PD", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.512.66.0F38.W1 CA /r", Operands: "zmm, {k}, m"},
+	{Intel: "VRCP28PS", Go: "VRCP28PS", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.512.66.0F38.W0 CA /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VRCP28PS", Go: "VRCP28PS", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.512.66.0F38.W0 CA /r", Operands: "zmm, {k}, m"},
+	{Intel: "VRCP28SD", Go: "VRCP28SD", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 CB /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VRCP28SD", Go: "VRCP28SD", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 CB /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VRCP28SS", Go: "VRCP28SS", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 CB /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VRCP28SS", Go: "VRCP28SS", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 CB /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VRSQRT28PD", Go: "VRSQRT28PD", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.512.66.0F38.W1 CC /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VRSQRT28PD", Go: "VRSQRT28PD", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.512.66.0F38.W1 CC /r", Operands: "zmm, {k}, m"},
+	{Intel: "VRSQRT28PS", Go: "VRSQRT28PS", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.512.66.0F38.W0 CC /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VRSQRT28PS", Go: "VRSQRT28PS", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.512.66.0F38.W0 CC /r", Operands: "zmm, {k}, m"},
+	{Intel: "VRSQRT28SD", Go: "VRSQRT28SD", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 CD /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VRSQRT28SD", Go: "VRSQRT28SD", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 CD /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VRSQRT28SS", Go: "VRSQRT28SS", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 CD /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VRSQRT28SS", Go: "VRSQRT28SS", CPUID: "AVX512ER,AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 CD /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VSCATTERPF0DPD", Go: "VSCATTERPF0DPD", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W1 C6 /5", Operands: "m"},
+	{Intel: "VSCATTERPF0DPS", Go: "VSCATTERPF0DPS", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W0 C6 /5", Operands: "m"},
+	{Intel: "VSCATTERPF0QPD", Go: "VSCATTERPF0QPD", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W1 C7 /5", Operands: "m"},
+	{Intel: "VSCATTERPF0QPS", Go: "VSCATTERPF0QPS", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W0 C7 /5", Operands: "m"},
+	{Intel: "VSCATTERPF1DPD", Go: "VSCATTERPF1DPD", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W1 C6 /6", Operands: "m"},
+	{Intel: "VSCATTERPF1DPS", Go: "VSCATTERPF1DPS", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W0 C6 /6", Operands: "m"},
+	{Intel: "VSCATTERPF1QPD", Go: "VSCATTERPF1QPD", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W1 C7 /6", Operands: "m"},
+	{Intel: "VSCATTERPF1QPS", Go: "VSCATTERPF1QPS", CPUID: "AVX512PF,AVX512F", Encoding: "EVEX.512.66.0F38.W0 C7 /6", Operands: "m"},
+	{Intel: "V4FMADDPS", Go: "V4FMADDPS", CPUID: "AVX5124FMAPS,AVX512F", Encoding: "EVEX.512.F2.0F38.W0 9A /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "V4FMADDSS", Go: "V4FMADDSS", CPUID: "AVX5124FMAPS,AVX512F", Encoding: "EVEX.LIG.F2.0F38.W0 9B /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "V4FNMADDPS", Go: "V4FNMADDPS", CPUID: "AVX5124FMAPS,AVX512F", Encoding: "EVEX.512.F2.0F38.W0 AA /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "V4FNMADDSS", Go: "V4FNMADDSS", CPUID: "AVX5124FMAPS,AVX512F", Encoding: "EVEX.LIG.F2.0F38.W0 AB /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VP4DPWSSD", Go: "VP4DPWSSD", CPUID: "AVX5124VNNIW,AVX512F", Encoding: "EVEX.512.F2.0F38.W0 52 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VP4DPWSSDS", Go: "VP4DPWSSDS", CPUID: "AVX5124VNNIW,AVX512F", Encoding: "EVEX.512.F2.0F38.W0 53 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPOPCNTD", Go: "VPOPCNTD", CPUID: "AVX512VPOPCNTDQ,AVX512F", Encoding: "EVEX.512.66.0F38.W0 55 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPOPCNTD", Go: "VPOPCNTD", CPUID: "AVX512VPOPCNTDQ,AVX512F", Encoding: "EVEX.512.66.0F38.W0 55 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPOPCNTQ", Go: "VPOPCNTQ", CPUID: "AVX512VPOPCNTDQ,AVX512F", Encoding: "EVEX.512.66.0F38.W1 55 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPOPCNTQ", Go: "VPOPCNTQ", CPUID: "AVX512VPOPCNTDQ,AVX512F", Encoding: "EVEX.512.66.0F38.W1 55 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VADDPD", Go: "VADDPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 58 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VADDPD", Go: "VADDPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 58 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VADDPS", Go: "VADDPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 58 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VADDPS", Go: "VADDPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 58 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VADDSD", Go: "VADDSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 58 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VADDSD", Go: "VADDSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 58 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VADDSS", Go: "VADDSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 58 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VADDSS", Go: "VADDSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 58 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VALIGND", Go: "VALIGND", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 03 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VALIGND", Go: "VALIGND", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 03 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VALIGNQ", Go: "VALIGNQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 03 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VALIGNQ", Go: "VALIGNQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 03 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VBLENDMPD", Go: "VBLENDMPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 65 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VBLENDMPD", Go: "VBLENDMPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 65 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VBLENDMPS", Go: "VBLENDMPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 65 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VBLENDMPS", Go: "VBLENDMPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 65 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VBROADCASTF32X4", Go: "VBROADCASTF32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 1A /r", Operands: "zmm, {k}, m"},
+	{Intel: "VBROADCASTF64X4", Go: "VBROADCASTF64X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 1B /r", Operands: "zmm, {k}, m"},
+	{Intel: "VBROADCASTI32X4", Go: "VBROADCASTI32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 5A /r", Operands: "zmm, {k}, m"},
+	{Intel: "VBROADCASTI64X4", Go: "VBROADCASTI64X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 5B /r", Operands: "zmm, {k}, m"},
+	{Intel: "VBROADCASTSD", Go: "VBROADCASTSD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 19 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VBROADCASTSD", Go: "VBROADCASTSD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 19 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VBROADCASTSS", Go: "VBROADCASTSS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 18 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VBROADCASTSS", Go: "VBROADCASTSS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 18 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VCMPPD", Go: "VCMPPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 C2 /r ib", Operands: "k, {k}, zmm, zmm, imm8"},
+	{Intel: "VCMPPD", Go: "VCMPPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 C2 /r ib", Operands: "k, {k}, zmm, m, imm8"},
+	{Intel: "VCMPPS", Go: "VCMPPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 C2 /r ib", Operands: "k, {k}, zmm, zmm, imm8"},
+	{Intel: "VCMPPS", Go: "VCMPPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 C2 /r ib", Operands: "k, {k}, zmm, m, imm8"},
+	{Intel: "VCMPSD", Go: "VCMPSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 C2 /r ib", Operands: "k, {k}, xmm, xmm, imm8"},
+	{Intel: "VCMPSD", Go: "VCMPSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 C2 /r ib", Operands: "k, {k}, xmm, m, imm8"},
+	{Intel: "VCMPSS", Go: "VCMPSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 C2 /r ib", Operands: "k, {k}, xmm, xmm, imm8"},
+	{Intel: "VCMPSS", Go: "VCMPSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 C2 /r ib", Operands: "k, {k}, xmm, m, imm8"},
+	{Intel: "VCOMISD", Go: "VCOMISD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F.W1 2F /r", Operands: "xmm, xmm"},
+	{Intel: "VCOMISD", Go: "VCOMISD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F.W1 2F /r", Operands: "xmm, m"},
+	{Intel: "VCOMISS", Go: "VCOMISS", CPUID: "AVX512F", Encoding: "EVEX.LIG.0F.W0 2F /r", Operands: "xmm, xmm"},
+	{Intel: "VCOMISS", Go: "VCOMISS", CPUID: "AVX512F", Encoding: "EVEX.LIG.0F.W0 2F /r", Operands: "xmm, m"},
+	{Intel: "VCOMPRESSPD", Go: "VCOMPRESSPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 8A /r", Operands: "m, {k}, zmm"},
+	{Intel: "VCOMPRESSPD", Go: "VCOMPRESSPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 8A /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCOMPRESSPS", Go: "VCOMPRESSPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 8A /r", Operands: "m, {k}, zmm"},
+	{Intel: "VCOMPRESSPS", Go: "VCOMPRESSPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 8A /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCVTDQ2PD", Go: "VCVTDQ2PD", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 E6 /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VCVTDQ2PD", Go: "VCVTDQ2PD", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 E6 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTDQ2PS", Go: "VCVTDQ2PS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 5B /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCVTDQ2PS", Go: "VCVTDQ2PS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 5B /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTPD2DQ", Go: "VCVTPD2DQ", CPUID: "AVX512F", Encoding: "EVEX.512.F2.0F.W1 E6 /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VCVTPD2DQ", Go: "VCVTPD2DQ", CPUID: "AVX512F", Encoding: "EVEX.512.F2.0F.W1 E6 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTPD2PS", Go: "VCVTPD2PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 5A /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VCVTPD2PS", Go: "VCVTPD2PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 5A /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTPD2UDQ", Go: "VCVTPD2UDQ", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W1 79 /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VCVTPD2UDQ", Go: "VCVTPD2UDQ", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W1 79 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTPH2PS", Go: "VCVTPH2PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 13 /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VCVTPH2PS", Go: "VCVTPH2PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 13 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTPS2DQ", Go: "VCVTPS2DQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 5B /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCVTPS2DQ", Go: "VCVTPS2DQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 5B /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTPS2PD", Go: "VCVTPS2PD", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 5A /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VCVTPS2PD", Go: "VCVTPS2PD", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 5A /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTPS2PH", Go: "VCVTPS2PH", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 1D /r ib", Operands: "ymm, {k}, zmm, imm8"},
+	{Intel: "VCVTPS2PH", Go: "VCVTPS2PH", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 1D /r ib", Operands: "m, {k}, zmm, imm8"},
+	{Intel: "VCVTPS2UDQ", Go: "VCVTPS2UDQ", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 79 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCVTPS2UDQ", Go: "VCVTPS2UDQ", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 79 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTSD2SI", Go: "VCVTSD2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.WIG 2D /r", Operands: "r32, xmm"},
+	{Intel: "VCVTSD2SI", Go: "VCVTSD2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W0 2D /r", Operands: "r32, xmm"},
+	{Intel: "VCVTSD2SI", Go: "VCVTSD2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.WIG 2D /r", Operands: "r32, m"},
+	{Intel: "VCVTSD2SI", Go: "VCVTSD2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W0 2D /r", Operands: "r32, m"},
+	{Intel: "VCVTSD2SI", Go: "VCVTSD2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 2D /r", Operands: "r64, xmm"},
+	{Intel: "VCVTSD2SI", Go: "VCVTSD2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 2D /r", Operands: "r64, m"},
+	{Intel: "VCVTSD2SS", Go: "VCVTSD2SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 5A /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VCVTSD2SS", Go: "VCVTSD2SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 5A /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VCVTSD2USI", Go: "VCVTSD2USI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.WIG 79 /r", Operands: "r32, xmm"},
+	{Intel: "VCVTSD2USI", Go: "VCVTSD2USIL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W0 79 /r", Operands: "r32, xmm"},
+	{Intel: "VCVTSD2USI", Go: "VCVTSD2USI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.WIG 79 /r", Operands: "r32, m"},
+	{Intel: "VCVTSD2USI", Go: "VCVTSD2USIL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W0 79 /r", Operands: "r32, m"},
+	{Intel: "VCVTSD2USI", Go: "VCVTSD2USIQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 79 /r", Operands: "r64, xmm"},
+	{Intel: "VCVTSD2USI", Go: "VCVTSD2USIQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 79 /r", Operands: "r64, m"},
+	{Intel: "VCVTSI2SD", Go: "VCVTSI2SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.WIG 2A /r", Operands: "xmm, xmm, r32"},
+	{Intel: "VCVTSI2SD", Go: "VCVTSI2SDL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W0 2A /r", Operands: "xmm, xmm, r32"},
+	{Intel: "VCVTSI2SD", Go: "VCVTSI2SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.WIG 2A /r", Operands: "xmm, xmm, m"},
+	{Intel: "VCVTSI2SD", Go: "VCVTSI2SDL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W0 2A /r", Operands: "xmm, xmm, m"},
+	{Intel: "VCVTSI2SD", Go: "VCVTSI2SDQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 2A /r", Operands: "xmm, xmm, r64"},
+	{Intel: "VCVTSI2SD", Go: "VCVTSI2SDQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 2A /r", Operands: "xmm, xmm, m"},
+	{Intel: "VCVTSI2SS", Go: "VCVTSI2SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.WIG 2A /r", Operands: "xmm, xmm, r32"},
+	{Intel: "VCVTSI2SS", Go: "VCVTSI2SSL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 2A /r", Operands: "xmm, xmm, r32"},
+	{Intel: "VCVTSI2SS", Go: "VCVTSI2SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.WIG 2A /r", Operands: "xmm, xmm, m"},
+	{Intel: "VCVTSI2SS", Go: "VCVTSI2SSL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 2A /r", Operands: "xmm, xmm, m"},
+	{Intel: "VCVTSI2SS", Go: "VCVTSI2SSQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W1 2A /r", Operands: "xmm, xmm, r64"},
+	{Intel: "VCVTSI2SS", Go: "VCVTSI2SSQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W1 2A /r", Operands: "xmm, xmm, m"},
+	{Intel: "VCVTSS2SD", Go: "VCVTSS2SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 5A /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VCVTSS2SD", Go: "VCVTSS2SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 5A /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VCVTSS2SI", Go: "VCVTSS2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.WIG 2D /r", Operands: "r32, xmm"},
+	{Intel: "VCVTSS2SI", Go: "VCVTSS2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 2D /r", Operands: "r32, xmm"},
+	{Intel: "VCVTSS2SI", Go: "VCVTSS2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.WIG 2D /r", Operands: "r32, m"},
+	{Intel: "VCVTSS2SI", Go: "VCVTSS2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 2D /r", Operands: "r32, m"},
+	{Intel: "VCVTSS2SI", Go: "VCVTSS2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W1 2D /r", Operands: "r64, xmm"},
+	{Intel: "VCVTSS2SI", Go: "VCVTSS2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W1 2D /r", Operands: "r64, m"},
+	{Intel: "VCVTSS2USI", Go: "VCVTSS2USI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.WIG 79 /r", Operands: "r32, xmm"},
+	{Intel: "VCVTSS2USI", Go: "VCVTSS2USIL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 79 /r", Operands: "r32, xmm"},
+	{Intel: "VCVTSS2USI", Go: "VCVTSS2USI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.WIG 79 /r", Operands: "r32, m"},
+	{Intel: "VCVTSS2USI", Go: "VCVTSS2USIL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 79 /r", Operands: "r32, m"},
+	{Intel: "VCVTSS2USI", Go: "VCVTSS2USIQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W1 79 /r", Operands: "r64, xmm"},
+	{Intel: "VCVTSS2USI", Go: "VCVTSS2USIQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W1 79 /r", Operands: "r64, m"},
+	{Intel: "VCVTTPD2DQ", Go: "VCVTTPD2DQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 E6 /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VCVTTPD2DQ", Go: "VCVTTPD2DQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 E6 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTTPD2UDQ", Go: "VCVTTPD2UDQ", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W1 78 /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VCVTTPD2UDQ", Go: "VCVTTPD2UDQ", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W1 78 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTTPS2DQ", Go: "VCVTTPS2DQ", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 5B /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCVTTPS2DQ", Go: "VCVTTPS2DQ", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 5B /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTTPS2UDQ", Go: "VCVTTPS2UDQ", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 78 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCVTTPS2UDQ", Go: "VCVTTPS2UDQ", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 78 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTTSD2SI", Go: "VCVTTSD2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.WIG 2C /r", Operands: "r32, xmm"},
+	{Intel: "VCVTTSD2SI", Go: "VCVTTSD2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W0 2C /r", Operands: "r32, xmm"},
+	{Intel: "VCVTTSD2SI", Go: "VCVTTSD2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.WIG 2C /r", Operands: "r32, m"},
+	{Intel: "VCVTTSD2SI", Go: "VCVTTSD2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W0 2C /r", Operands: "r32, m"},
+	{Intel: "VCVTTSD2SI", Go: "VCVTTSD2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 2C /r", Operands: "r64, xmm"},
+	{Intel: "VCVTTSD2SI", Go: "VCVTTSD2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 2C /r", Operands: "r64, m"},
+	{Intel: "VCVTTSD2USI", Go: "VCVTTSD2USI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.WIG 78 /r", Operands: "r32, xmm"},
+	{Intel: "VCVTTSD2USI", Go: "VCVTTSD2USIL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W0 78 /r", Operands: "r32, xmm"},
+	{Intel: "VCVTTSD2USI", Go: "VCVTTSD2USI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.WIG 78 /r", Operands: "r32, m"},
+	{Intel: "VCVTTSD2USI", Go: "VCVTTSD2USIL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W0 78 /r", Operands: "r32, m"},
+	{Intel: "VCVTTSD2USI", Go: "VCVTTSD2USIQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 78 /r", Operands: "r64, xmm"},
+	{Intel: "VCVTTSD2USI", Go: "VCVTTSD2USIQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 78 /r", Operands: "r64, m"},
+	{Intel: "VCVTTSS2SI", Go: "VCVTTSS2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.WIG 2C /r", Operands: "r32, xmm"},
+	{Intel: "VCVTTSS2SI", Go: "VCVTTSS2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 2C /r", Operands: "r32, xmm"},
+	{Intel: "VCVTTSS2SI", Go: "VCVTTSS2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.WIG 2C /r", Operands: "r32, m"},
+	{Intel: "VCVTTSS2SI", Go: "VCVTTSS2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 2C /r", Operands: "r32, m"},
+	{Intel: "VCVTTSS2SI", Go: "VCVTTSS2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W1 2C /r", Operands: "r64, xmm"},
+	{Intel: "VCVTTSS2SI", Go: "VCVTTSS2SI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W1 2C /r", Operands: "r64, m"},
+	{Intel: "VCVTTSS2USI", Go: "VCVTTSS2USI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.WIG 78 /r", Operands: "r32, xmm"},
+	{Intel: "VCVTTSS2USI", Go: "VCVTTSS2USIL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 78 /r", Operands: "r32, xmm"},
+	{Intel: "VCVTTSS2USI", Go: "VCVTTSS2USI", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.WIG 78 /r", Operands: "r32, m"},
+	{Intel: "VCVTTSS2USI", Go: "VCVTTSS2USIL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 78 /r", Operands: "r32, m"},
+	{Intel: "VCVTTSS2USI", Go: "VCVTTSS2USIQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W1 78 /r", Operands: "r64, xmm"},
+	{Intel: "VCVTTSS2USI", Go: "VCVTTSS2USIQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W1 78 /r", Operands: "r64, m"},
+	{Intel: "VCVTUDQ2PD", Go: "VCVTUDQ2PD", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 7A /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VCVTUDQ2PD", Go: "VCVTUDQ2PD", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 7A /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTUDQ2PS", Go: "VCVTUDQ2PS", CPUID: "AVX512F", Encoding: "EVEX.512.F2.0F.W0 7A /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCVTUDQ2PS", Go: "VCVTUDQ2PS", CPUID: "AVX512F", Encoding: "EVEX.512.F2.0F.W0 7A /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTUSI2SD", Go: "VCVTUSI2SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.WIG 7B /r", Operands: "xmm, xmm, r32"},
+	{Intel: "VCVTUSI2SD", Go: "VCVTUSI2SDL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W0 7B /r", Operands: "xmm, xmm, r32"},
+	{Intel: "VCVTUSI2SD", Go: "VCVTUSI2SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.WIG 7B /r", Operands: "xmm, xmm, m"},
+	{Intel: "VCVTUSI2SD", Go: "VCVTUSI2SDL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W0 7B /r", Operands: "xmm, xmm, m"},
+	{Intel: "VCVTUSI2SD", Go: "VCVTUSI2SDQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 7B /r", Operands: "xmm, xmm, r64"},
+	{Intel: "VCVTUSI2SD", Go: "VCVTUSI2SDQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 7B /r", Operands: "xmm, xmm, m"},
+	{Intel: "VCVTUSI2SS", Go: "VCVTUSI2SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.WIG 7B /r", Operands: "xmm, xmm, r32"},
+	{Intel: "VCVTUSI2SS", Go: "VCVTUSI2SSL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 7B /r", Operands: "xmm, xmm, r32"},
+	{Intel: "VCVTUSI2SS", Go: "VCVTUSI2SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.WIG 7B /r", Operands: "xmm, xmm, m"},
+	{Intel: "VCVTUSI2SS", Go: "VCVTUSI2SSL", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 7B /r", Operands: "xmm, xmm, m"},
+	{Intel: "VCVTUSI2SS", Go: "VCVTUSI2SSQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W1 7B /r", Operands: "xmm, xmm, r64"},
+	{Intel: "VCVTUSI2SS", Go: "VCVTUSI2SSQ", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W1 7B /r", Operands: "xmm, xmm, m"},
+	{Intel: "VDIVPD", Go: "VDIVPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 5E /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VDIVPD", Go: "VDIVPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 5E /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VDIVPS", Go: "VDIVPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 5E /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VDIVPS", Go: "VDIVPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 5E /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VDIVSD", Go: "VDIVSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 5E /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VDIVSD", Go: "VDIVSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 5E /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VDIVSS", Go: "VDIVSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 5E /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VDIVSS", Go: "VDIVSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 5E /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VEXPANDPD", Go: "VEXPANDPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 88 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VEXPANDPD", Go: "VEXPANDPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 88 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VEXPANDPS", Go: "VEXPANDPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 88 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VEXPANDPS", Go: "VEXPANDPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 88 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VEXTRACTF32X4", Go: "VEXTRACTF32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 19 /r ib", Operands: "xmm, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTF32X4", Go: "VEXTRACTF32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 19 /r ib", Operands: "m, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTF64X4", Go: "VEXTRACTF64X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 1B /r ib", Operands: "ymm, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTF64X4", Go: "VEXTRACTF64X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 1B /r ib", Operands: "m, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTI32X4", Go: "VEXTRACTI32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 39 /r ib", Operands: "xmm, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTI32X4", Go: "VEXTRACTI32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 39 /r ib", Operands: "m, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTI64X4", Go: "VEXTRACTI64X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 3B /r ib", Operands: "ymm, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTI64X4", Go: "VEXTRACTI64X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 3B /r ib", Operands: "m, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTPS", Go: "VEXTRACTPS", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F3A.WIG 17 /r ib", Operands: "r32, xmm, imm8"},
+	{Intel: "VEXTRACTPS", Go: "VEXTRACTPS", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F3A.WIG 17 /r ib", Operands: "m, xmm, imm8"},
+	{Intel: "VFIXUPIMMPD", Go: "VFIXUPIMMPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 54 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VFIXUPIMMPD", Go: "VFIXUPIMMPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 54 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VFIXUPIMMPS", Go: "VFIXUPIMMPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 54 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VFIXUPIMMPS", Go: "VFIXUPIMMPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 54 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VFIXUPIMMSD", Go: "VFIXUPIMMSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F3A.W1 55 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VFIXUPIMMSD", Go: "VFIXUPIMMSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F3A.W1 55 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VFIXUPIMMSS", Go: "VFIXUPIMMSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F3A.W0 55 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VFIXUPIMMSS", Go: "VFIXUPIMMSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F3A.W0 55 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VFMADD132PD", Go: "VFMADD132PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 98 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMADD132PD", Go: "VFMADD132PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 98 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMADD132PS", Go: "VFMADD132PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 98 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMADD132PS", Go: "VFMADD132PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 98 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMADD132SD", Go: "VFMADD132SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 99 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADD132SD", Go: "VFMADD132SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 99 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADD132SS", Go: "VFMADD132SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 99 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADD132SS", Go: "VFMADD132SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 99 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADD213PD", Go: "VFMADD213PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 A8 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMADD213PD", Go: "VFMADD213PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 A8 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMADD213PS", Go: "VFMADD213PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 A8 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMADD213PS", Go: "VFMADD213PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 A8 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMADD213SD", Go: "VFMADD213SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 A9 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADD213SD", Go: "VFMADD213SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 A9 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADD213SS", Go: "VFMADD213SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 A9 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADD213SS", Go: "VFMADD213SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 A9 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADD231PD", Go: "VFMADD231PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 B8 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMADD231PD", Go: "VFMADD231PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 B8 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMADD231PS", Go: "VFMADD231PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 B8 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMADD231PS", Go: "VFMADD231PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 B8 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMADD231SD", Go: "VFMADD231SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 B9 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADD231SD", Go: "VFMADD231SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 B9 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADD231SS", Go: "VFMADD231SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 B9 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADD231SS", Go: "VFMADD231SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 B9 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADDSUB132PD", Go: "VFMADDSUB132PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 96 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMADDSUB132PD", Go: "VFMADDSUB132PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 96 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMADDSUB132PS", Go: "VFMADDSUB132PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 96 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMADDSUB132PS", Go: "VFMADDSUB132PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 96 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMADDSUB213PD", Go: "VFMADDSUB213PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 A6 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMADDSUB213PD", Go: "VFMADDSUB213PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 A6 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMADDSUB213PS", Go: "VFMADDSUB213PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 A6 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMADDSUB213PS", Go: "VFMADDSUB213PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 A6 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMADDSUB231PD", Go: "VFMADDSUB231PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 B6 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMADDSUB231PD", Go: "VFMADDSUB231PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 B6 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMADDSUB231PS", Go: "VFMADDSUB231PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 B6 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMADDSUB231PS", Go: "VFMADDSUB231PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 B6 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMSUB132PD", Go: "VFMSUB132PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 9A /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMSUB132PD", Go: "VFMSUB132PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 9A /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMSUB132PS", Go: "VFMSUB132PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 9A /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMSUB132PS", Go: "VFMSUB132PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 9A /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMSUB132SD", Go: "VFMSUB132SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 9B /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUB132SD", Go: "VFMSUB132SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 9B /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUB132SS", Go: "VFMSUB132SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 9B /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUB132SS", Go: "VFMSUB132SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 9B /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUB213PD", Go: "VFMSUB213PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 AA /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMSUB213PD", Go: "VFMSUB213PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 AA /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMSUB213PS", Go: "VFMSUB213PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 AA /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMSUB213PS", Go: "VFMSUB213PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 AA /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMSUB213SD", Go: "VFMSUB213SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 AB /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUB213SD", Go: "VFMSUB213SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 AB /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUB213SS", Go: "VFMSUB213SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 AB /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUB213SS", Go: "VFMSUB213SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 AB /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUB231PD", Go: "VFMSUB231PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 BA /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMSUB231PD", Go: "VFMSUB231PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 BA /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMSUB231PS", Go: "VFMSUB231PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 BA /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMSUB231PS", Go: "VFMSUB231PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 BA /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMSUB231SD", Go: "VFMSUB231SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 BB /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUB231SD", Go: "VFMSUB231SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 BB /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUB231SS", Go: "VFMSUB231SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 BB /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUB231SS", Go: "VFMSUB231SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 BB /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUBADD132PD", Go: "VFMSUBADD132PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 97 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMSUBADD132PD", Go: "VFMSUBADD132PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 97 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMSUBADD132PS", Go: "VFMSUBADD132PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 97 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMSUBADD132PS", Go: "VFMSUBADD132PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 97 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMSUBADD213PD", Go: "VFMSUBADD213PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 A7 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMSUBADD213PD", Go: "VFMSUBADD213PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 A7 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMSUBADD213PS", Go: "VFMSUBADD213PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 A7 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMSUBADD213PS", Go: "VFMSUBADD213PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 A7 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMSUBADD231PD", Go: "VFMSUBADD231PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 B7 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMSUBADD231PD", Go: "VFMSUBADD231PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 B7 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFMSUBADD231PS", Go: "VFMSUBADD231PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 B7 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFMSUBADD231PS", Go: "VFMSUBADD231PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 B7 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFNMADD132PD", Go: "VFNMADD132PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 9C /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFNMADD132PD", Go: "VFNMADD132PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 9C /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFNMADD132PS", Go: "VFNMADD132PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 9C /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFNMADD132PS", Go: "VFNMADD132PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 9C /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFNMADD132SD", Go: "VFNMADD132SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 9D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMADD132SD", Go: "VFNMADD132SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 9D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMADD132SS", Go: "VFNMADD132SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 9D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMADD132SS", Go: "VFNMADD132SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 9D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMADD213PD", Go: "VFNMADD213PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 AC /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFNMADD213PD", Go: "VFNMADD213PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 AC /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFNMADD213PS", Go: "VFNMADD213PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 AC /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFNMADD213PS", Go: "VFNMADD213PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 AC /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFNMADD213SD", Go: "VFNMADD213SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 AD /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMADD213SD", Go: "VFNMADD213SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 AD /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMADD213SS", Go: "VFNMADD213SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 AD /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMADD213SS", Go: "VFNMADD213SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 AD /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMADD231PD", Go: "VFNMADD231PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 BC /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFNMADD231PD", Go: "VFNMADD231PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 BC /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFNMADD231PS", Go: "VFNMADD231PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 BC /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFNMADD231PS", Go: "VFNMADD231PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 BC /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFNMADD231SD", Go: "VFNMADD231SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 BD /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMADD231SD", Go: "VFNMADD231SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 BD /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMADD231SS", Go: "VFNMADD231SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 BD /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMADD231SS", Go: "VFNMADD231SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 BD /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMSUB132PD", Go: "VFNMSUB132PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 9E /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFNMSUB132PD", Go: "VFNMSUB132PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 9E /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFNMSUB132PS", Go: "VFNMSUB132PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 9E /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFNMSUB132PS", Go: "VFNMSUB132PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 9E /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFNMSUB132SD", Go: "VFNMSUB132SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 9F /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMSUB132SD", Go: "VFNMSUB132SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 9F /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMSUB132SS", Go: "VFNMSUB132SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 9F /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMSUB132SS", Go: "VFNMSUB132SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 9F /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMSUB213PD", Go: "VFNMSUB213PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 AE /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFNMSUB213PD", Go: "VFNMSUB213PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 AE /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFNMSUB213PS", Go: "VFNMSUB213PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 AE /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFNMSUB213PS", Go: "VFNMSUB213PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 AE /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFNMSUB213SD", Go: "VFNMSUB213SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 AF /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMSUB213SD", Go: "VFNMSUB213SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 AF /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMSUB213SS", Go: "VFNMSUB213SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 AF /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMSUB213SS", Go: "VFNMSUB213SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 AF /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMSUB231PD", Go: "VFNMSUB231PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 BE /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFNMSUB231PD", Go: "VFNMSUB231PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 BE /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFNMSUB231PS", Go: "VFNMSUB231PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 BE /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VFNMSUB231PS", Go: "VFNMSUB231PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 BE /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VFNMSUB231SD", Go: "VFNMSUB231SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 BF /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMSUB231SD", Go: "VFNMSUB231SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 BF /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMSUB231SS", Go: "VFNMSUB231SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 BF /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMSUB231SS", Go: "VFNMSUB231SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 BF /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VGATHERDPD", Go: "VGATHERDPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 92 /r", Operands: "zmm, m"},
+	{Intel: "VGATHERDPS", Go: "VGATHERDPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 92 /r", Operands: "zmm, m"},
+	{Intel: "VGATHERQPD", Go: "VGATHERQPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 93 /r", Operands: "zmm, m"},
+	{Intel: "VGATHERQPS", Go: "VGATHERQPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 93 /r", Operands: "ymm, m"},
+	{Intel: "VGETEXPPD", Go: "VGETEXPPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 42 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VGETEXPPD", Go: "VGETEXPPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 42 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VGETEXPPS", Go: "VGETEXPPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 42 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VGETEXPPS", Go: "VGETEXPPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 42 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VGETEXPSD", Go: "VGETEXPSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 43 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VGETEXPSD", Go: "VGETEXPSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 43 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VGETEXPSS", Go: "VGETEXPSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 43 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VGETEXPSS", Go: "VGETEXPSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 43 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VGETMANTPD", Go: "VGETMANTPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 26 /r ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VGETMANTPD", Go: "VGETMANTPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 26 /r ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VGETMANTPS", Go: "VGETMANTPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 26 /r ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VGETMANTPS", Go: "VGETMANTPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 26 /r ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VGETMANTSD", Go: "VGETMANTSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F3A.W1 27 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VGETMANTSD", Go: "VGETMANTSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F3A.W1 27 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VGETMANTSS", Go: "VGETMANTSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F3A.W0 27 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VGETMANTSS", Go: "VGETMANTSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F3A.W0 27 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VINSERTF32X4", Go: "VINSERTF32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 18 /r ib", Operands: "zmm, {k}, zmm, xmm, imm8"},
+	{Intel: "VINSERTF32X4", Go: "VINSERTF32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 18 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VINSERTF64X4", Go: "VINSERTF64X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 1A /r ib", Operands: "zmm, {k}, zmm, ymm, imm8"},
+	{Intel: "VINSERTF64X4", Go: "VINSERTF64X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 1A /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VINSERTI32X4", Go: "VINSERTI32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 38 /r ib", Operands: "zmm, {k}, zmm, xmm, imm8"},
+	{Intel: "VINSERTI32X4", Go: "VINSERTI32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 38 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VINSERTI64X4", Go: "VINSERTI64X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 3A /r ib", Operands: "zmm, {k}, zmm, ymm, imm8"},
+	{Intel: "VINSERTI64X4", Go: "VINSERTI64X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 3A /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VINSERTPS", Go: "VINSERTPS", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F3A.W0 21 /r ib", Operands: "xmm, xmm, xmm, imm8"},
+	{Intel: "VINSERTPS", Go: "VINSERTPS", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F3A.W0 21 /r ib", Operands: "xmm, xmm, m, imm8"},
+	{Intel: "VMAXPD", Go: "VMAXPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 5F /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VMAXPD", Go: "VMAXPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 5F /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VMAXPS", Go: "VMAXPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 5F /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VMAXPS", Go: "VMAXPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 5F /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VMAXSD", Go: "VMAXSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 5F /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMAXSD", Go: "VMAXSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 5F /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VMAXSS", Go: "VMAXSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 5F /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMAXSS", Go: "VMAXSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 5F /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VMINPD", Go: "VMINPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 5D /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VMINPD", Go: "VMINPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 5D /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VMINPS", Go: "VMINPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 5D /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VMINPS", Go: "VMINPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 5D /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VMINSD", Go: "VMINSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 5D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMINSD", Go: "VMINSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 5D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VMINSS", Go: "VMINSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 5D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMINSS", Go: "VMINSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 5D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 28 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 28 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 29 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 29 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 28 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 28 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 29 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 29 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VMOVD", Go: "VMOVD", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.WIG 6E /r", Operands: "xmm, r32"},
+	{Intel: "VMOVD", Go: "VMOVD", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W0 6E /r", Operands: "xmm, r32"},
+	{Intel: "VMOVD", Go: "VMOVD", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.WIG 6E /r", Operands: "xmm, m"},
+	{Intel: "VMOVD", Go: "VMOVD", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W0 6E /r", Operands: "xmm, m"},
+	{Intel: "VMOVD", Go: "VMOVD", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.WIG 7E /r", Operands: "r32, xmm"},
+	{Intel: "VMOVD", Go: "VMOVD", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W0 7E /r", Operands: "r32, xmm"},
+	{Intel: "VMOVD", Go: "VMOVD", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.WIG 7E /r", Operands: "m, xmm"},
+	{Intel: "VMOVD", Go: "VMOVD", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W0 7E /r", Operands: "m, xmm"},
+	{Intel: "VMOVDDUP", Go: "VMOVDDUP", CPUID: "AVX512F", Encoding: "EVEX.512.F2.0F.W1 12 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVDDUP", Go: "VMOVDDUP", CPUID: "AVX512F", Encoding: "EVEX.512.F2.0F.W1 12 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VMOVDQA32", Go: "VMOVDQA32", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 6F /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVDQA32", Go: "VMOVDQA32", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 6F /r", Operands: "zmm, {k}, m"},
+	{Intel: "VMOVDQA32", Go: "VMOVDQA32", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 7F /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVDQA32", Go: "VMOVDQA32", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 7F /r", Operands: "m, {k}, zmm"},
+	{Intel: "VMOVDQA64", Go: "VMOVDQA64", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 6F /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVDQA64", Go: "VMOVDQA64", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 6F /r", Operands: "zmm, {k}, m"},
+	{Intel: "VMOVDQA64", Go: "VMOVDQA64", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 7F /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVDQA64", Go: "VMOVDQA64", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 7F /r", Operands: "m, {k}, zmm"},
+	{Intel: "VMOVDQU32", Go: "VMOVDQU32", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 6F /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVDQU32", Go: "VMOVDQU32", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 6F /r", Operands: "zmm, {k}, m"},
+	{Intel: "VMOVDQU32", Go: "VMOVDQU32", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 7F /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVDQU32", Go: "VMOVDQU32", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 7F /r", Operands: "m, {k}, zmm"},
+	{Intel: "VMOVDQU64", Go: "VMOVDQU64", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W1 6F /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVDQU64", Go: "VMOVDQU64", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W1 6F /r", Operands: "zmm, {k}, m"},
+	{Intel: "VMOVDQU64", Go: "VMOVDQU64", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W1 7F /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVDQU64", Go: "VMOVDQU64", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W1 7F /r", Operands: "m, {k}, zmm"},
+	{Intel: "VMOVHLPS", Go: "VMOVHLPS", CPUID: "AVX512F", Encoding: "EVEX.128.0F.W0 12 /r", Operands: "xmm, xmm, xmm"},
+	{Intel: "VMOVHPD", Go: "VMOVHPD", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W1 16 /r", Operands: "xmm, xmm, m"},
+	{Intel: "VMOVHPD", Go: "VMOVHPD", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W1 17 /r", Operands: "m, xmm"},
+	{Intel: "VMOVHPS", Go: "VMOVHPS", CPUID: "AVX512F", Encoding: "EVEX.128.0F.W0 16 /r", Operands: "xmm, xmm, m"},
+	{Intel: "VMOVHPS", Go: "VMOVHPS", CPUID: "AVX512F", Encoding: "EVEX.128.0F.W0 17 /r", Operands: "m, xmm"},
+	{Intel: "VMOVLHPS", Go: "VMOVLHPS", CPUID: "AVX512F", Encoding: "EVEX.128.0F.W0 16 /r", Operands: "xmm, xmm, xmm"},
+	{Intel: "VMOVLPD", Go: "VMOVLPD", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W1 12 /r", Operands: "xmm, xmm, m"},
+	{Intel: "VMOVLPD", Go: "VMOVLPD", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W1 13 /r", Operands: "m, xmm"},
+	{Intel: "VMOVLPS", Go: "VMOVLPS", CPUID: "AVX512F", Encoding: "EVEX.128.0F.W0 12 /r", Operands: "xmm, xmm, m"},
+	{Intel: "VMOVLPS", Go: "VMOVLPS", CPUID: "AVX512F", Encoding: "EVEX.128.0F.W0 13 /r", Operands: "m, xmm"},
+	{Intel: "VMOVNTDQ", Go: "VMOVNTDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 E7 /r", Operands: "m, zmm"},
+	{Intel: "VMOVNTDQA", Go: "VMOVNTDQA", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 2A /r", Operands: "zmm, m"},
+	{Intel: "VMOVNTPD", Go: "VMOVNTPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 2B /r", Operands: "m, zmm"},
+	{Intel: "VMOVNTPS", Go: "VMOVNTPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 2B /r", Operands: "m, zmm"},
+	{Intel: "VMOVQ", Go: "VMOVQ", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W1 6E /r", Operands: "xmm, r64"},
+	{Intel: "VMOVQ", Go: "VMOVQ", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W1 6E /r", Operands: "xmm, m"},
+	{Intel: "VMOVQ", Go: "VMOVQ", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W1 7E /r", Operands: "r64, xmm"},
+	{Intel: "VMOVQ", Go: "VMOVQ", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W1 7E /r", Operands: "m, xmm"},
+	{Intel: "VMOVQ", Go: "VMOVQ", CPUID: "AVX512F", Encoding: "EVEX.128.F3.0F.W1 7E /r", Operands: "xmm, xmm"},
+	{Intel: "VMOVQ", Go: "VMOVQ", CPUID: "AVX512F", Encoding: "EVEX.128.F3.0F.W1 7E /r", Operands: "xmm, m"},
+	{Intel: "VMOVQ", Go: "VMOVQ", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W1 D6 /r", Operands: "xmm, xmm"},
+	{Intel: "VMOVQ", Go: "VMOVQ", CPUID: "AVX512F", Encoding: "EVEX.128.66.0F.W1 D6 /r", Operands: "m, xmm"},
+	{Intel: "VMOVSD", Go: "VMOVSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 10 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVSD", Go: "VMOVSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 11 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VMOVSD", Go: "VMOVSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 10 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMOVSD", Go: "VMOVSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 11 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMOVSHDUP", Go: "VMOVSHDUP", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 16 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVSHDUP", Go: "VMOVSHDUP", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 16 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VMOVSLDUP", Go: "VMOVSLDUP", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 12 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVSLDUP", Go: "VMOVSLDUP", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F.W0 12 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VMOVSS", Go: "VMOVSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 10 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVSS", Go: "VMOVSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 11 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VMOVSS", Go: "VMOVSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 10 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMOVSS", Go: "VMOVSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 11 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 10 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 10 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 11 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 11 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 10 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 10 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 11 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 11 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VMULPD", Go: "VMULPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 59 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VMULPD", Go: "VMULPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 59 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VMULPS", Go: "VMULPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 59 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VMULPS", Go: "VMULPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 59 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VMULSD", Go: "VMULSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 59 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMULSD", Go: "VMULSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 59 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VMULSS", Go: "VMULSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 59 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMULSS", Go: "VMULSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 59 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPABSD", Go: "VPABSD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 1E /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPABSD", Go: "VPABSD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 1E /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPABSQ", Go: "VPABSQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 1F /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPABSQ", Go: "VPABSQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 1F /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPADDD", Go: "VPADDD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 FE /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPADDD", Go: "VPADDD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 FE /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPADDQ", Go: "VPADDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 D4 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPADDQ", Go: "VPADDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 D4 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPANDD", Go: "VPANDD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 DB /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPANDD", Go: "VPANDD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 DB /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPANDND", Go: "VPANDND", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 DF /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPANDND", Go: "VPANDND", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 DF /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPANDNQ", Go: "VPANDNQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 DF /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPANDNQ", Go: "VPANDNQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 DF /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPANDQ", Go: "VPANDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 DB /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPANDQ", Go: "VPANDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 DB /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPBLENDMD", Go: "VPBLENDMD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 64 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPBLENDMD", Go: "VPBLENDMD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 64 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPBLENDMQ", Go: "VPBLENDMQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 64 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPBLENDMQ", Go: "VPBLENDMQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 64 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 58 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 58 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 7C /r", Operands: "zmm, {k}, r32"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 7C /r", Operands: "zmm, {k}, r32"},
+	{Intel: "VPBROADCASTQ", Go: "VPBROADCASTQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 59 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPBROADCASTQ", Go: "VPBROADCASTQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 59 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VPBROADCASTQ", Go: "VPBROADCASTQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 7C /r", Operands: "zmm, {k}, r64"},
+	{Intel: "VPCMPD", Go: "VPCMPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 1F /r ib", Operands: "k, {k}, zmm, zmm, imm8"},
+	{Intel: "VPCMPD", Go: "VPCMPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 1F /r ib", Operands: "k, {k}, zmm, m, imm8"},
+	{Intel: "VPCMPEQD", Go: "VPCMPEQD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 76 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPCMPEQD", Go: "VPCMPEQD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 76 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPCMPEQQ", Go: "VPCMPEQQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 29 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPCMPEQQ", Go: "VPCMPEQQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 29 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPCMPGTD", Go: "VPCMPGTD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 66 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPCMPGTD", Go: "VPCMPGTD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 66 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPCMPGTQ", Go: "VPCMPGTQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 37 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPCMPGTQ", Go: "VPCMPGTQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 37 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPCMPQ", Go: "VPCMPQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 1F /r ib", Operands: "k, {k}, zmm, zmm, imm8"},
+	{Intel: "VPCMPQ", Go: "VPCMPQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 1F /r ib", Operands: "k, {k}, zmm, m, imm8"},
+	{Intel: "VPCMPUD", Go: "VPCMPUD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 1E /r ib", Operands: "k, {k}, zmm, zmm, imm8"},
+	{Intel: "VPCMPUD", Go: "VPCMPUD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 1E /r ib", Operands: "k, {k}, zmm, m, imm8"},
+	{Intel: "VPCMPUQ", Go: "VPCMPUQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 1E /r ib", Operands: "k, {k}, zmm, zmm, imm8"},
+	{Intel: "VPCMPUQ", Go: "VPCMPUQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 1E /r ib", Operands: "k, {k}, zmm, m, imm8"},
+	{Intel: "VPCOMPRESSD", Go: "VPCOMPRESSD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 8B /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPCOMPRESSD", Go: "VPCOMPRESSD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 8B /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPCOMPRESSQ", Go: "VPCOMPRESSQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 8B /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPCOMPRESSQ", Go: "VPCOMPRESSQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 8B /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPERMD", Go: "VPERMD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 36 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMD", Go: "VPERMD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 36 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMI2D", Go: "VPERMI2D", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 76 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMI2D", Go: "VPERMI2D", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 76 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMI2PD", Go: "VPERMI2PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 77 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMI2PD", Go: "VPERMI2PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 77 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMI2PS", Go: "VPERMI2PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 77 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMI2PS", Go: "VPERMI2PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 77 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMI2Q", Go: "VPERMI2Q", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 76 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMI2Q", Go: "VPERMI2Q", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 76 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 05 /r ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 05 /r ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 0D /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 0D /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 04 /r ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 04 /r ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 0C /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 0C /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMPD", Go: "VPERMPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 01 /r ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPERMPD", Go: "VPERMPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 01 /r ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPERMPD", Go: "VPERMPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 16 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMPD", Go: "VPERMPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 16 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMPS", Go: "VPERMPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 16 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMPS", Go: "VPERMPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 16 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMQ", Go: "VPERMQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 00 /r ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPERMQ", Go: "VPERMQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 00 /r ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPERMQ", Go: "VPERMQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 36 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMQ", Go: "VPERMQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 36 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMT2D", Go: "VPERMT2D", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 7E /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMT2D", Go: "VPERMT2D", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 7E /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMT2PD", Go: "VPERMT2PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 7F /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMT2PD", Go: "VPERMT2PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 7F /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMT2PS", Go: "VPERMT2PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 7F /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMT2PS", Go: "VPERMT2PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 7F /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMT2Q", Go: "VPERMT2Q", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 7E /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMT2Q", Go: "VPERMT2Q", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 7E /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPEXPANDD", Go: "VPEXPANDD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 89 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPEXPANDD", Go: "VPEXPANDD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 89 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPEXPANDQ", Go: "VPEXPANDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 89 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPEXPANDQ", Go: "VPEXPANDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 89 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPGATHERDD", Go: "VPGATHERDD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 90 /r", Operands: "zmm, m"},
+	{Intel: "VPGATHERDQ", Go: "VPGATHERDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 90 /r", Operands: "zmm, m"},
+	{Intel: "VPGATHERQD", Go: "VPGATHERQD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 91 /r", Operands: "ymm, m"},
+	{Intel: "VPGATHERQQ", Go: "VPGATHERQQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 91 /r", Operands: "zmm, m"},
+	{Intel: "VPMAXSD", Go: "VPMAXSD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 3D /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMAXSD", Go: "VPMAXSD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 3D /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMAXSQ", Go: "VPMAXSQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 3D /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMAXSQ", Go: "VPMAXSQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 3D /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMAXUD", Go: "VPMAXUD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 3F /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMAXUD", Go: "VPMAXUD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 3F /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMAXUQ", Go: "VPMAXUQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 3F /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMAXUQ", Go: "VPMAXUQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 3F /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMINSD", Go: "VPMINSD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 39 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMINSD", Go: "VPMINSD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 39 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMINSQ", Go: "VPMINSQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 39 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMINSQ", Go: "VPMINSQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 39 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMINUD", Go: "VPMINUD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 3B /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMINUD", Go: "VPMINUD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 3B /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMINUQ", Go: "VPMINUQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 3B /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMINUQ", Go: "VPMINUQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 3B /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMOVDB", Go: "VPMOVDB", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 31 /r", Operands: "xmm, {k}, zmm"},
+	{Intel: "VPMOVDB", Go: "VPMOVDB", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 31 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVDW", Go: "VPMOVDW", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 33 /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VPMOVDW", Go: "VPMOVDW", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 33 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVQB", Go: "VPMOVQB", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 32 /r", Operands: "xmm, {k}, zmm"},
+	{Intel: "VPMOVQB", Go: "VPMOVQB", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 32 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVQD", Go: "VPMOVQD", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 35 /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VPMOVQD", Go: "VPMOVQD", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 35 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVQW", Go: "VPMOVQW", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 34 /r", Operands: "xmm, {k}, zmm"},
+	{Intel: "VPMOVQW", Go: "VPMOVQW", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 34 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVSDB", Go: "VPMOVSDB", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 21 /r", Operands: "xmm, {k}, zmm"},
+	{Intel: "VPMOVSDB", Go: "VPMOVSDB", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 21 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVSDW", Go: "VPMOVSDW", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 23 /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VPMOVSDW", Go: "VPMOVSDW", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 23 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVSQB", Go: "VPMOVSQB", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 22 /r", Operands: "xmm, {k}, zmm"},
+	{Intel: "VPMOVSQB", Go: "VPMOVSQB", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 22 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVSQD", Go: "VPMOVSQD", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 25 /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VPMOVSQD", Go: "VPMOVSQD", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 25 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVSQW", Go: "VPMOVSQW", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 24 /r", Operands: "xmm, {k}, zmm"},
+	{Intel: "VPMOVSQW", Go: "VPMOVSQW", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 24 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVSXBD", Go: "VPMOVSXBD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 21 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VPMOVSXBD", Go: "VPMOVSXBD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 21 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPMOVSXBQ", Go: "VPMOVSXBQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 22 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VPMOVSXBQ", Go: "VPMOVSXBQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 22 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPMOVSXDQ", Go: "VPMOVSXDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 25 /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VPMOVSXDQ", Go: "VPMOVSXDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 25 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPMOVSXWD", Go: "VPMOVSXWD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 23 /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VPMOVSXWD", Go: "VPMOVSXWD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 23 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPMOVSXWQ", Go: "VPMOVSXWQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 24 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VPMOVSXWQ", Go: "VPMOVSXWQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 24 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPMOVUSDB", Go: "VPMOVUSDB", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 11 /r", Operands: "xmm, {k}, zmm"},
+	{Intel: "VPMOVUSDB", Go: "VPMOVUSDB", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 11 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVUSDW", Go: "VPMOVUSDW", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 13 /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VPMOVUSDW", Go: "VPMOVUSDW", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 13 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVUSQB", Go: "VPMOVUSQB", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 12 /r", Operands: "xmm, {k}, zmm"},
+	{Intel: "VPMOVUSQB", Go: "VPMOVUSQB", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 12 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVUSQD", Go: "VPMOVUSQD", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 15 /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VPMOVUSQD", Go: "VPMOVUSQD", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 15 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVUSQW", Go: "VPMOVUSQW", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 14 /r", Operands: "xmm, {k}, zmm"},
+	{Intel: "VPMOVUSQW", Go: "VPMOVUSQW", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 14 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVZXBD", Go: "VPMOVZXBD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 31 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VPMOVZXBD", Go: "VPMOVZXBD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 31 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPMOVZXBQ", Go: "VPMOVZXBQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 32 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VPMOVZXBQ", Go: "VPMOVZXBQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 32 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPMOVZXDQ", Go: "VPMOVZXDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 35 /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VPMOVZXDQ", Go: "VPMOVZXDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 35 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPMOVZXWD", Go: "VPMOVZXWD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 33 /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VPMOVZXWD", Go: "VPMOVZXWD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 33 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPMOVZXWQ", Go: "VPMOVZXWQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 34 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VPMOVZXWQ", Go: "VPMOVZXWQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.WIG 34 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPMULDQ", Go: "VPMULDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 28 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMULDQ", Go: "VPMULDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 28 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMULLD", Go: "VPMULLD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 40 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMULLD", Go: "VPMULLD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 40 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMULUDQ", Go: "VPMULUDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 F4 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMULUDQ", Go: "VPMULUDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 F4 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPORD", Go: "VPORD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 EB /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPORD", Go: "VPORD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 EB /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPORQ", Go: "VPORQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 EB /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPORQ", Go: "VPORQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 EB /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPROLD", Go: "VPROLD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 72 /1 ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPROLD", Go: "VPROLD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 72 /1 ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPROLQ", Go: "VPROLQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 72 /1 ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPROLQ", Go: "VPROLQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 72 /1 ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPROLVD", Go: "VPROLVD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 15 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPROLVD", Go: "VPROLVD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 15 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPROLVQ", Go: "VPROLVQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 15 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPROLVQ", Go: "VPROLVQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 15 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPRORD", Go: "VPRORD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 72 /0 ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPRORD", Go: "VPRORD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 72 /0 ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPRORQ", Go: "VPRORQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 72 /0 ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPRORQ", Go: "VPRORQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 72 /0 ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPRORVD", Go: "VPRORVD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 14 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPRORVD", Go: "VPRORVD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 14 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPRORVQ", Go: "VPRORVQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 14 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPRORVQ", Go: "VPRORVQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 14 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSCATTERDD", Go: "VPSCATTERDD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 A0 /r", Operands: "m, zmm"},
+	{Intel: "VPSCATTERDQ", Go: "VPSCATTERDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 A0 /r", Operands: "m, zmm"},
+	{Intel: "VPSCATTERQD", Go: "VPSCATTERQD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 A1 /r", Operands: "m, ymm"},
+	{Intel: "VPSCATTERQQ", Go: "VPSCATTERQQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 A1 /r", Operands: "m, zmm"},
+	{Intel: "VPSHUFD", Go: "VPSHUFD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 70 /r ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPSHUFD", Go: "VPSHUFD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 70 /r ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 F2 /r", Operands: "zmm, {k}, zmm, xmm"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 F2 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 72 /6 ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 72 /6 ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 F3 /r", Operands: "zmm, {k}, zmm, xmm"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 F3 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 73 /6 ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 73 /6 ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPSLLVD", Go: "VPSLLVD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 47 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSLLVD", Go: "VPSLLVD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 47 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSLLVQ", Go: "VPSLLVQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 47 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSLLVQ", Go: "VPSLLVQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 47 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 E2 /r", Operands: "zmm, {k}, zmm, xmm"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 E2 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 72 /4 ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 72 /4 ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPSRAQ", Go: "VPSRAQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 E2 /r", Operands: "zmm, {k}, zmm, xmm"},
+	{Intel: "VPSRAQ", Go: "VPSRAQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 E2 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSRAQ", Go: "VPSRAQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 72 /4 ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPSRAQ", Go: "VPSRAQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 72 /4 ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPSRAVD", Go: "VPSRAVD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 46 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSRAVD", Go: "VPSRAVD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 46 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSRAVQ", Go: "VPSRAVQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 46 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSRAVQ", Go: "VPSRAVQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 46 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 D2 /r", Operands: "zmm, {k}, zmm, xmm"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 D2 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 72 /2 ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 72 /2 ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 D3 /r", Operands: "zmm, {k}, zmm, xmm"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 D3 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 73 /2 ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 73 /2 ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPSRLVD", Go: "VPSRLVD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 45 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSRLVD", Go: "VPSRLVD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 45 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSRLVQ", Go: "VPSRLVQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 45 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSRLVQ", Go: "VPSRLVQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 45 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSUBD", Go: "VPSUBD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 FA /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSUBD", Go: "VPSUBD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 FA /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSUBQ", Go: "VPSUBQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 FB /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSUBQ", Go: "VPSUBQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 FB /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPTERNLOGD", Go: "VPTERNLOGD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 25 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VPTERNLOGD", Go: "VPTERNLOGD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 25 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VPTERNLOGQ", Go: "VPTERNLOGQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 25 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VPTERNLOGQ", Go: "VPTERNLOGQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 25 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VPTESTMD", Go: "VPTESTMD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 27 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPTESTMD", Go: "VPTESTMD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 27 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPTESTMQ", Go: "VPTESTMQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 27 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPTESTMQ", Go: "VPTESTMQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 27 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPTESTNMD", Go: "VPTESTNMD", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 27 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPTESTNMD", Go: "VPTESTNMD", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W0 27 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPTESTNMQ", Go: "VPTESTNMQ", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W1 27 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPTESTNMQ", Go: "VPTESTNMQ", CPUID: "AVX512F", Encoding: "EVEX.512.F3.0F38.W1 27 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPUNPCKHDQ", Go: "VPUNPCKHDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 6A /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPUNPCKHDQ", Go: "VPUNPCKHDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 6A /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPUNPCKHQDQ", Go: "VPUNPCKHQDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 6D /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPUNPCKHQDQ", Go: "VPUNPCKHQDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 6D /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPUNPCKLDQ", Go: "VPUNPCKLDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 62 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPUNPCKLDQ", Go: "VPUNPCKLDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 62 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPUNPCKLQDQ", Go: "VPUNPCKLQDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 6C /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPUNPCKLQDQ", Go: "VPUNPCKLQDQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 6C /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPXORD", Go: "VPXORD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 EF /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPXORD", Go: "VPXORD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W0 EF /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPXORQ", Go: "VPXORQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 EF /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPXORQ", Go: "VPXORQ", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 EF /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VRCP14PD", Go: "VRCP14PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 4C /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VRCP14PD", Go: "VRCP14PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 4C /r", Operands: "zmm, {k}, m"},
+	{Intel: "VRCP14PS", Go: "VRCP14PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 4C /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VRCP14PS", Go: "VRCP14PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 4C /r", Operands: "zmm, {k}, m"},
+	{Intel: "VRCP14SD", Go: "VRCP14SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 4D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VRCP14SD", Go: "VRCP14SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 4D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VRCP14SS", Go: "VRCP14SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 4D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VRCP14SS", Go: "VRCP14SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 4D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VRNDSCALEPD", Go: "VRNDSCALEPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 09 /r ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VRNDSCALEPD", Go: "VRNDSCALEPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 09 /r ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VRNDSCALEPS", Go: "VRNDSCALEPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 08 /r ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VRNDSCALEPS", Go: "VRNDSCALEPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 08 /r ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VRNDSCALESD", Go: "VRNDSCALESD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F3A.W1 0B /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VRNDSCALESD", Go: "VRNDSCALESD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F3A.W1 0B /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VRNDSCALESS", Go: "VRNDSCALESS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F3A.W0 0A /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VRNDSCALESS", Go: "VRNDSCALESS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F3A.W0 0A /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VRSQRT14PD", Go: "VRSQRT14PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 4E /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VRSQRT14PD", Go: "VRSQRT14PD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 4E /r", Operands: "zmm, {k}, m"},
+	{Intel: "VRSQRT14PS", Go: "VRSQRT14PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 4E /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VRSQRT14PS", Go: "VRSQRT14PS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 4E /r", Operands: "zmm, {k}, m"},
+	{Intel: "VRSQRT14SD", Go: "VRSQRT14SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 4F /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VRSQRT14SD", Go: "VRSQRT14SD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 4F /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VRSQRT14SS", Go: "VRSQRT14SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 4F /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VRSQRT14SS", Go: "VRSQRT14SS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 4F /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VSCALEFPD", Go: "VSCALEFPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 2C /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VSCALEFPD", Go: "VSCALEFPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 2C /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VSCALEFPS", Go: "VSCALEFPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 2C /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VSCALEFPS", Go: "VSCALEFPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 2C /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VSCALEFSD", Go: "VSCALEFSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 2D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VSCALEFSD", Go: "VSCALEFSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W1 2D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VSCALEFSS", Go: "VSCALEFSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 2D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VSCALEFSS", Go: "VSCALEFSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F38.W0 2D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VSCATTERDPD", Go: "VSCATTERDPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 A2 /r", Operands: "m, zmm"},
+	{Intel: "VSCATTERDPS", Go: "VSCATTERDPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 A2 /r", Operands: "m, zmm"},
+	{Intel: "VSCATTERQPD", Go: "VSCATTERQPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W1 A3 /r", Operands: "m, zmm"},
+	{Intel: "VSCATTERQPS", Go: "VSCATTERQPS", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F38.W0 A3 /r", Operands: "m, ymm"},
+	{Intel: "VSHUFF32X4", Go: "VSHUFF32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 23 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VSHUFF32X4", Go: "VSHUFF32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 23 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VSHUFF64X2", Go: "VSHUFF64X2", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 23 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VSHUFF64X2", Go: "VSHUFF64X2", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 23 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VSHUFI32X4", Go: "VSHUFI32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 43 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VSHUFI32X4", Go: "VSHUFI32X4", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W0 43 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VSHUFI64X2", Go: "VSHUFI64X2", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 43 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VSHUFI64X2", Go: "VSHUFI64X2", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F3A.W1 43 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VSHUFPD", Go: "VSHUFPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 C6 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VSHUFPD", Go: "VSHUFPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 C6 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VSHUFPS", Go: "VSHUFPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 C6 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VSHUFPS", Go: "VSHUFPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 C6 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VSQRTPD", Go: "VSQRTPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 51 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VSQRTPD", Go: "VSQRTPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 51 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VSQRTPS", Go: "VSQRTPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 51 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VSQRTPS", Go: "VSQRTPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 51 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VSQRTSD", Go: "VSQRTSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 51 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VSQRTSD", Go: "VSQRTSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 51 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VSQRTSS", Go: "VSQRTSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 51 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VSQRTSS", Go: "VSQRTSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 51 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VSUBPD", Go: "VSUBPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 5C /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VSUBPD", Go: "VSUBPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 5C /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VSUBPS", Go: "VSUBPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 5C /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VSUBPS", Go: "VSUBPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 5C /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VSUBSD", Go: "VSUBSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 5C /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VSUBSD", Go: "VSUBSD", CPUID: "AVX512F", Encoding: "EVEX.LIG.F2.0F.W1 5C /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VSUBSS", Go: "VSUBSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 5C /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VSUBSS", Go: "VSUBSS", CPUID: "AVX512F", Encoding: "EVEX.LIG.F3.0F.W0 5C /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VUCOMISD", Go: "VUCOMISD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F.W1 2E /r", Operands: "xmm, xmm"},
+	{Intel: "VUCOMISD", Go: "VUCOMISD", CPUID: "AVX512F", Encoding: "EVEX.LIG.66.0F.W1 2E /r", Operands: "xmm, m"},
+	{Intel: "VUCOMISS", Go: "VUCOMISS", CPUID: "AVX512F", Encoding: "EVEX.LIG.0F.W0 2E /r", Operands: "xmm, xmm"},
+	{Intel: "VUCOMISS", Go: "VUCOMISS", CPUID: "AVX512F", Encoding: "EVEX.LIG.0F.W0 2E /r", Operands: "xmm, m"},
+	{Intel: "VUNPCKHPD", Go: "VUNPCKHPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 15 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VUNPCKHPD", Go: "VUNPCKHPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 15 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VUNPCKHPS", Go: "VUNPCKHPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 15 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VUNPCKHPS", Go: "VUNPCKHPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 15 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VUNPCKLPD", Go: "VUNPCKLPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 14 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VUNPCKLPD", Go: "VUNPCKLPD", CPUID: "AVX512F", Encoding: "EVEX.512.66.0F.W1 14 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VUNPCKLPS", Go: "VUNPCKLPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 14 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VUNPCKLPS", Go: "VUNPCKLPS", CPUID: "AVX512F", Encoding: "EVEX.512.0F.W0 14 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "KANDNW", Go: "KANDNW", CPUID: "AVX512F", Encoding: "VEX.256.0F.W0 42 /r", Operands: "k, k, k"},
+	{Intel: "KANDW", Go: "KANDW", CPUID: "AVX512F", Encoding: "VEX.256.0F.W0 41 /r", Operands: "k, k, k"},
+	{Intel: "KMOVW", Go: "KMOVW", CPUID: "AVX512F", Encoding: "VEX.128.0F.W0 90 /r", Operands: "k, k"},
+	{Intel: "KMOVW", Go: "KMOVW", CPUID: "AVX512F", Encoding: "VEX.128.0F.W0 90 /r", Operands: "k, m"},
+	{Intel: "KMOVW", Go: "KMOVW", CPUID: "AVX512F", Encoding: "VEX.128.0F.W0 91 /r", Operands: "m, k"},
+	{Intel: "KMOVW", Go: "KMOVW", CPUID: "AVX512F", Encoding: "VEX.128.0F.W0 92 /r", Operands: "k, r32"},
+	{Intel: "KMOVW", Go: "KMOVW", CPUID: "AVX512F", Encoding: "VEX.128.0F.W0 93 /r", Operands: "r32, k"},
+	{Intel: "KNOTW", Go: "KNOTW", CPUID: "AVX512F", Encoding: "VEX.128.0F.W0 44 /r", Operands: "k, k"},
+	{Intel: "KORTESTW", Go: "KORTESTW", CPUID: "AVX512F", Encoding: "VEX.128.0F.W0 98 /r", Operands: "k, k"},
+	{Intel: "KORW", Go: "KORW", CPUID: "AVX512F", Encoding: "VEX.256.0F.W0 45 /r", Operands: "k, k, k"},
+	{Intel: "KSHIFTLW", Go: "KSHIFTLW", CPUID: "AVX512F", Encoding: "VEX.128.66.0F3A.W1 32 /r ib", Operands: "k, k, imm8"},
+	{Intel: "KSHIFTRW", Go: "KSHIFTRW", CPUID: "AVX512F", Encoding: "VEX.128.66.0F3A.W1 30 /r ib", Operands: "k, k, imm8"},
+	{Intel: "KUNPCKBW", Go: "KUNPCKBW", CPUID: "AVX512F", Encoding: "VEX.256.66.0F.W0 4B /r", Operands: "k, k, k"},
+	{Intel: "KXNORW", Go: "KXNORW", CPUID: "AVX512F", Encoding: "VEX.256.0F.W0 46 /r", Operands: "k, k, k"},
+	{Intel: "KXORW", Go: "KXORW", CPUID: "AVX512F", Encoding: "VEX.256.0F.W0 47 /r", Operands: "k, k, k"},
+	{Intel: "VPBROADCASTMB2Q", Go: "VPBROADCASTMB2Q", CPUID: "AVX512CD,AVX512F", Encoding: "EVEX.512.F3.0F38.W1 2A /r", Operands: "zmm, k"},
+	{Intel: "VPBROADCASTMW2D", Go: "VPBROADCASTMW2D", CPUID: "AVX512CD,AVX512F", Encoding: "EVEX.512.F3.0F38.W0 3A /r", Operands: "zmm, k"},
+	{Intel: "VPCONFLICTD", Go: "VPCONFLICTD", CPUID: "AVX512CD,AVX512F", Encoding: "EVEX.512.66.0F38.W0 C4 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPCONFLICTD", Go: "VPCONFLICTD", CPUID: "AVX512CD,AVX512F", Encoding: "EVEX.512.66.0F38.W0 C4 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPCONFLICTQ", Go: "VPCONFLICTQ", CPUID: "AVX512CD,AVX512F", Encoding: "EVEX.512.66.0F38.W1 C4 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPCONFLICTQ", Go: "VPCONFLICTQ", CPUID: "AVX512CD,AVX512F", Encoding: "EVEX.512.66.0F38.W1 C4 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPLZCNTD", Go: "VPLZCNTD", CPUID: "AVX512CD,AVX512F", Encoding: "EVEX.512.66.0F38.W0 44 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPLZCNTD", Go: "VPLZCNTD", CPUID: "AVX512CD,AVX512F", Encoding: "EVEX.512.66.0F38.W0 44 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPLZCNTQ", Go: "VPLZCNTQ", CPUID: "AVX512CD,AVX512F", Encoding: "EVEX.512.66.0F38.W1 44 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPLZCNTQ", Go: "VPLZCNTQ", CPUID: "AVX512CD,AVX512F", Encoding: "EVEX.512.66.0F38.W1 44 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VADDPD", Go: "VADDPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 58 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VADDPD", Go: "VADDPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 58 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VADDPD", Go: "VADDPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 58 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VADDPD", Go: "VADDPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 58 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VADDPS", Go: "VADDPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 58 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VADDPS", Go: "VADDPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 58 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VADDPS", Go: "VADDPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 58 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VADDPS", Go: "VADDPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 58 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VALIGND", Go: "VALIGND", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 03 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VALIGND", Go: "VALIGND", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 03 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VALIGND", Go: "VALIGND", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 03 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VALIGND", Go: "VALIGND", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 03 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VALIGNQ", Go: "VALIGNQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 03 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VALIGNQ", Go: "VALIGNQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 03 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VALIGNQ", Go: "VALIGNQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 03 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VALIGNQ", Go: "VALIGNQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 03 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VANDNPD", Go: "VANDNPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 55 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VANDNPD", Go: "VANDNPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 55 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VANDNPD", Go: "VANDNPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 55 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VANDNPD", Go: "VANDNPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 55 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VANDNPD", Go: "VANDNPD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 55 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VANDNPD", Go: "VANDNPD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 55 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VANDNPS", Go: "VANDNPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 55 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VANDNPS", Go: "VANDNPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 55 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VANDNPS", Go: "VANDNPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 55 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VANDNPS", Go: "VANDNPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 55 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VANDNPS", Go: "VANDNPS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.0F.W0 55 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VANDNPS", Go: "VANDNPS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.0F.W0 55 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VANDPD", Go: "VANDPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 54 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VANDPD", Go: "VANDPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 54 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VANDPD", Go: "VANDPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 54 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VANDPD", Go: "VANDPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 54 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VANDPD", Go: "VANDPD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 54 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VANDPD", Go: "VANDPD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 54 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VANDPS", Go: "VANDPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 54 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VANDPS", Go: "VANDPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 54 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VANDPS", Go: "VANDPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 54 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VANDPS", Go: "VANDPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 54 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VANDPS", Go: "VANDPS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.0F.W0 54 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VANDPS", Go: "VANDPS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.0F.W0 54 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VBLENDMPD", Go: "VBLENDMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 65 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VBLENDMPD", Go: "VBLENDMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 65 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VBLENDMPD", Go: "VBLENDMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 65 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VBLENDMPD", Go: "VBLENDMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 65 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VBLENDMPS", Go: "VBLENDMPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 65 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VBLENDMPS", Go: "VBLENDMPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 65 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VBLENDMPS", Go: "VBLENDMPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 65 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VBLENDMPS", Go: "VBLENDMPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 65 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VBROADCASTF32X2", Go: "VBROADCASTF32X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 19 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VBROADCASTF32X2", Go: "VBROADCASTF32X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 19 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VBROADCASTF32X2", Go: "VBROADCASTF32X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F38.W0 19 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VBROADCASTF32X2", Go: "VBROADCASTF32X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F38.W0 19 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VBROADCASTF32X4", Go: "VBROADCASTF32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 1A /r", Operands: "ymm, {k}, m"},
+	{Intel: "VBROADCASTF32X8", Go: "VBROADCASTF32X8", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F38.W0 1B /r", Operands: "zmm, {k}, m"},
+	{Intel: "VBROADCASTF64X2", Go: "VBROADCASTF64X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 1A /r", Operands: "ymm, {k}, m"},
+	{Intel: "VBROADCASTF64X2", Go: "VBROADCASTF64X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F38.W1 1A /r", Operands: "zmm, {k}, m"},
+	{Intel: "VBROADCASTI32X2", Go: "VBROADCASTI32X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 59 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VBROADCASTI32X2", Go: "VBROADCASTI32X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 59 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VBROADCASTI32X2", Go: "VBROADCASTI32X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 59 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VBROADCASTI32X2", Go: "VBROADCASTI32X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 59 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VBROADCASTI32X2", Go: "VBROADCASTI32X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F38.W0 59 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VBROADCASTI32X2", Go: "VBROADCASTI32X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F38.W0 59 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VBROADCASTI32X4", Go: "VBROADCASTI32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 5A /r", Operands: "ymm, {k}, m"},
+	{Intel: "VBROADCASTI32X8", Go: "VBROADCASTI32X8", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F38.W0 5B /r", Operands: "zmm, {k}, m"},
+	{Intel: "VBROADCASTI64X2", Go: "VBROADCASTI64X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 5A /r", Operands: "ymm, {k}, m"},
+	{Intel: "VBROADCASTI64X2", Go: "VBROADCASTI64X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F38.W1 5A /r", Operands: "zmm, {k}, m"},
+	{Intel: "VBROADCASTSD", Go: "VBROADCASTSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 19 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VBROADCASTSD", Go: "VBROADCASTSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 19 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VBROADCASTSS", Go: "VBROADCASTSS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 18 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VBROADCASTSS", Go: "VBROADCASTSS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 18 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VBROADCASTSS", Go: "VBROADCASTSS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 18 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VBROADCASTSS", Go: "VBROADCASTSS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 18 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VCMPPD", Go: "VCMPPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 C2 /r ib", Operands: "k, {k}, xmm, xmm, imm8"},
+	{Intel: "VCMPPD", Go: "VCMPPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 C2 /r ib", Operands: "k, {k}, xmm, m, imm8"},
+	{Intel: "VCMPPD", Go: "VCMPPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 C2 /r ib", Operands: "k, {k}, ymm, ymm, imm8"},
+	{Intel: "VCMPPD", Go: "VCMPPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 C2 /r ib", Operands: "k, {k}, ymm, m, imm8"},
+	{Intel: "VCMPPS", Go: "VCMPPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 C2 /r ib", Operands: "k, {k}, xmm, xmm, imm8"},
+	{Intel: "VCMPPS", Go: "VCMPPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 C2 /r ib", Operands: "k, {k}, xmm, m, imm8"},
+	{Intel: "VCMPPS", Go: "VCMPPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 C2 /r ib", Operands: "k, {k}, ymm, ymm, imm8"},
+	{Intel: "VCMPPS", Go: "VCMPPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 C2 /r ib", Operands: "k, {k}, ymm, m, imm8"},
+	{Intel: "VCOMPRESSPD", Go: "VCOMPRESSPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 8A /r", Operands: "m, {k}, xmm"},
+	{Intel: "VCOMPRESSPD", Go: "VCOMPRESSPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 8A /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCOMPRESSPD", Go: "VCOMPRESSPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 8A /r", Operands: "m, {k}, ymm"},
+	{Intel: "VCOMPRESSPD", Go: "VCOMPRESSPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 8A /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCOMPRESSPS", Go: "VCOMPRESSPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 8A /r", Operands: "m, {k}, xmm"},
+	{Intel: "VCOMPRESSPS", Go: "VCOMPRESSPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 8A /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCOMPRESSPS", Go: "VCOMPRESSPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 8A /r", Operands: "m, {k}, ymm"},
+	{Intel: "VCOMPRESSPS", Go: "VCOMPRESSPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 8A /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCVTDQ2PD", Go: "VCVTDQ2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 E6 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTDQ2PD", Go: "VCVTDQ2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 E6 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTDQ2PD", Go: "VCVTDQ2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 E6 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VCVTDQ2PD", Go: "VCVTDQ2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 E6 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTDQ2PS", Go: "VCVTDQ2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 5B /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTDQ2PS", Go: "VCVTDQ2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 5B /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTDQ2PS", Go: "VCVTDQ2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 5B /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCVTDQ2PS", Go: "VCVTDQ2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 5B /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTPD2DQ", Go: "VCVTPD2DQX", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W1 E6 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTPD2DQ", Go: "VCVTPD2DQX", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W1 E6 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPD2DQ", Go: "VCVTPD2DQY", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W1 E6 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VCVTPD2DQ", Go: "VCVTPD2DQY", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W1 E6 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPD2PS", Go: "VCVTPD2PSX", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 5A /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTPD2PS", Go: "VCVTPD2PSX", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 5A /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPD2PS", Go: "VCVTPD2PSY", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 5A /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VCVTPD2PS", Go: "VCVTPD2PSY", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 5A /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPD2QQ", Go: "VCVTPD2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 7B /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTPD2QQ", Go: "VCVTPD2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 7B /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPD2QQ", Go: "VCVTPD2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 7B /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCVTPD2QQ", Go: "VCVTPD2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 7B /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTPD2QQ", Go: "VCVTPD2QQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 7B /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCVTPD2QQ", Go: "VCVTPD2QQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 7B /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTPD2UDQ", Go: "VCVTPD2UDQX", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W1 79 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTPD2UDQ", Go: "VCVTPD2UDQX", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W1 79 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPD2UDQ", Go: "VCVTPD2UDQY", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W1 79 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VCVTPD2UDQ", Go: "VCVTPD2UDQY", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W1 79 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPD2UQQ", Go: "VCVTPD2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 79 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTPD2UQQ", Go: "VCVTPD2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 79 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPD2UQQ", Go: "VCVTPD2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 79 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCVTPD2UQQ", Go: "VCVTPD2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 79 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTPD2UQQ", Go: "VCVTPD2UQQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 79 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCVTPD2UQQ", Go: "VCVTPD2UQQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 79 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTPH2PS", Go: "VCVTPH2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 13 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTPH2PS", Go: "VCVTPH2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 13 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPH2PS", Go: "VCVTPH2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 13 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VCVTPH2PS", Go: "VCVTPH2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 13 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTPS2DQ", Go: "VCVTPS2DQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 5B /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTPS2DQ", Go: "VCVTPS2DQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 5B /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPS2DQ", Go: "VCVTPS2DQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 5B /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCVTPS2DQ", Go: "VCVTPS2DQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 5B /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTPS2PD", Go: "VCVTPS2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 5A /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTPS2PD", Go: "VCVTPS2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 5A /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPS2PD", Go: "VCVTPS2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 5A /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VCVTPS2PD", Go: "VCVTPS2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 5A /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTPS2PH", Go: "VCVTPS2PH", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 1D /r ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VCVTPS2PH", Go: "VCVTPS2PH", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 1D /r ib", Operands: "m, {k}, xmm, imm8"},
+	{Intel: "VCVTPS2PH", Go: "VCVTPS2PH", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 1D /r ib", Operands: "xmm, {k}, ymm, imm8"},
+	{Intel: "VCVTPS2PH", Go: "VCVTPS2PH", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 1D /r ib", Operands: "m, {k}, ymm, imm8"},
+	{Intel: "VCVTPS2QQ", Go: "VCVTPS2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 7B /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTPS2QQ", Go: "VCVTPS2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 7B /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPS2QQ", Go: "VCVTPS2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 7B /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VCVTPS2QQ", Go: "VCVTPS2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 7B /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTPS2QQ", Go: "VCVTPS2QQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W0 7B /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VCVTPS2QQ", Go: "VCVTPS2QQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W0 7B /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTPS2UDQ", Go: "VCVTPS2UDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 79 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTPS2UDQ", Go: "VCVTPS2UDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 79 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPS2UDQ", Go: "VCVTPS2UDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 79 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCVTPS2UDQ", Go: "VCVTPS2UDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 79 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTPS2UQQ", Go: "VCVTPS2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 79 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTPS2UQQ", Go: "VCVTPS2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 79 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTPS2UQQ", Go: "VCVTPS2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 79 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VCVTPS2UQQ", Go: "VCVTPS2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 79 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTPS2UQQ", Go: "VCVTPS2UQQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W0 79 /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VCVTPS2UQQ", Go: "VCVTPS2UQQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W0 79 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTQQ2PD", Go: "VCVTQQ2PD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W1 E6 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTQQ2PD", Go: "VCVTQQ2PD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W1 E6 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTQQ2PD", Go: "VCVTQQ2PD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W1 E6 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCVTQQ2PD", Go: "VCVTQQ2PD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W1 E6 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTQQ2PD", Go: "VCVTQQ2PD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.F3.0F.W1 E6 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCVTQQ2PD", Go: "VCVTQQ2PD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.F3.0F.W1 E6 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTQQ2PS", Go: "VCVTQQ2PSX", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W1 5B /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTQQ2PS", Go: "VCVTQQ2PSX", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W1 5B /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTQQ2PS", Go: "VCVTQQ2PSY", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W1 5B /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VCVTQQ2PS", Go: "VCVTQQ2PSY", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W1 5B /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTQQ2PS", Go: "VCVTQQ2PS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.0F.W1 5B /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VCVTQQ2PS", Go: "VCVTQQ2PS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.0F.W1 5B /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTTPD2DQ", Go: "VCVTTPD2DQX", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 E6 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTTPD2DQ", Go: "VCVTTPD2DQX", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 E6 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTTPD2DQ", Go: "VCVTTPD2DQY", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 E6 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VCVTTPD2DQ", Go: "VCVTTPD2DQY", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 E6 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTTPD2QQ", Go: "VCVTTPD2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 7A /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTTPD2QQ", Go: "VCVTTPD2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 7A /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTTPD2QQ", Go: "VCVTTPD2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 7A /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCVTTPD2QQ", Go: "VCVTTPD2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 7A /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTTPD2QQ", Go: "VCVTTPD2QQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 7A /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCVTTPD2QQ", Go: "VCVTTPD2QQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 7A /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTTPD2UDQ", Go: "VCVTTPD2UDQX", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W1 78 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTTPD2UDQ", Go: "VCVTTPD2UDQX", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W1 78 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTTPD2UDQ", Go: "VCVTTPD2UDQY", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W1 78 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VCVTTPD2UDQ", Go: "VCVTTPD2UDQY", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W1 78 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTTPD2UQQ", Go: "VCVTTPD2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 78 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTTPD2UQQ", Go: "VCVTTPD2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 78 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTTPD2UQQ", Go: "VCVTTPD2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 78 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCVTTPD2UQQ", Go: "VCVTTPD2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 78 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTTPD2UQQ", Go: "VCVTTPD2UQQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 78 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCVTTPD2UQQ", Go: "VCVTTPD2UQQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 78 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTTPS2DQ", Go: "VCVTTPS2DQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 5B /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTTPS2DQ", Go: "VCVTTPS2DQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 5B /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTTPS2DQ", Go: "VCVTTPS2DQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 5B /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCVTTPS2DQ", Go: "VCVTTPS2DQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 5B /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTTPS2QQ", Go: "VCVTTPS2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 7A /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTTPS2QQ", Go: "VCVTTPS2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 7A /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTTPS2QQ", Go: "VCVTTPS2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 7A /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VCVTTPS2QQ", Go: "VCVTTPS2QQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 7A /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTTPS2QQ", Go: "VCVTTPS2QQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W0 7A /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VCVTTPS2QQ", Go: "VCVTTPS2QQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W0 7A /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTTPS2UDQ", Go: "VCVTTPS2UDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 78 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTTPS2UDQ", Go: "VCVTTPS2UDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 78 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTTPS2UDQ", Go: "VCVTTPS2UDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 78 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCVTTPS2UDQ", Go: "VCVTTPS2UDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 78 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTTPS2UQQ", Go: "VCVTTPS2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 78 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTTPS2UQQ", Go: "VCVTTPS2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 78 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTTPS2UQQ", Go: "VCVTTPS2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 78 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VCVTTPS2UQQ", Go: "VCVTTPS2UQQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 78 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTTPS2UQQ", Go: "VCVTTPS2UQQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W0 78 /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VCVTTPS2UQQ", Go: "VCVTTPS2UQQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W0 78 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTUDQ2PD", Go: "VCVTUDQ2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 7A /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTUDQ2PD", Go: "VCVTUDQ2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 7A /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTUDQ2PD", Go: "VCVTUDQ2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 7A /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VCVTUDQ2PD", Go: "VCVTUDQ2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 7A /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTUDQ2PS", Go: "VCVTUDQ2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W0 7A /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTUDQ2PS", Go: "VCVTUDQ2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W0 7A /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTUDQ2PS", Go: "VCVTUDQ2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W0 7A /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCVTUDQ2PS", Go: "VCVTUDQ2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W0 7A /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTUQQ2PD", Go: "VCVTUQQ2PD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W1 7A /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTUQQ2PD", Go: "VCVTUQQ2PD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W1 7A /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTUQQ2PD", Go: "VCVTUQQ2PD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W1 7A /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VCVTUQQ2PD", Go: "VCVTUQQ2PD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W1 7A /r", Operands: "ymm, {k}, m"},
+	{Intel: "VCVTUQQ2PD", Go: "VCVTUQQ2PD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.F3.0F.W1 7A /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VCVTUQQ2PD", Go: "VCVTUQQ2PD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.F3.0F.W1 7A /r", Operands: "zmm, {k}, m"},
+	{Intel: "VCVTUQQ2PS", Go: "VCVTUQQ2PSX", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W1 7A /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VCVTUQQ2PS", Go: "VCVTUQQ2PSX", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W1 7A /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTUQQ2PS", Go: "VCVTUQQ2PSY", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W1 7A /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VCVTUQQ2PS", Go: "VCVTUQQ2PSY", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W1 7A /r", Operands: "xmm, {k}, m"},
+	{Intel: "VCVTUQQ2PS", Go: "VCVTUQQ2PS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.F2.0F.W1 7A /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VCVTUQQ2PS", Go: "VCVTUQQ2PS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.F2.0F.W1 7A /r", Operands: "ymm, {k}, m"},
+	{Intel: "VDBPSADBW", Go: "VDBPSADBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 42 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VDBPSADBW", Go: "VDBPSADBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 42 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VDBPSADBW", Go: "VDBPSADBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 42 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VDBPSADBW", Go: "VDBPSADBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 42 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VDBPSADBW", Go: "VDBPSADBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 42 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VDBPSADBW", Go: "VDBPSADBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 42 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VDIVPD", Go: "VDIVPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 5E /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VDIVPD", Go: "VDIVPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 5E /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VDIVPD", Go: "VDIVPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 5E /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VDIVPD", Go: "VDIVPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 5E /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VDIVPS", Go: "VDIVPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 5E /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VDIVPS", Go: "VDIVPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 5E /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VDIVPS", Go: "VDIVPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 5E /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VDIVPS", Go: "VDIVPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 5E /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VEXPANDPD", Go: "VEXPANDPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 88 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VEXPANDPD", Go: "VEXPANDPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 88 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VEXPANDPD", Go: "VEXPANDPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 88 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VEXPANDPD", Go: "VEXPANDPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 88 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VEXPANDPS", Go: "VEXPANDPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 88 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VEXPANDPS", Go: "VEXPANDPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 88 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VEXPANDPS", Go: "VEXPANDPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 88 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VEXPANDPS", Go: "VEXPANDPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 88 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VEXTRACTF32X4", Go: "VEXTRACTF32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 19 /r ib", Operands: "xmm, {k}, ymm, imm8"},
+	{Intel: "VEXTRACTF32X4", Go: "VEXTRACTF32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 19 /r ib", Operands: "m, {k}, ymm, imm8"},
+	{Intel: "VEXTRACTF32X8", Go: "VEXTRACTF32X8", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 1B /r ib", Operands: "ymm, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTF32X8", Go: "VEXTRACTF32X8", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 1B /r ib", Operands: "m, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTF64X2", Go: "VEXTRACTF64X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 19 /r ib", Operands: "xmm, {k}, ymm, imm8"},
+	{Intel: "VEXTRACTF64X2", Go: "VEXTRACTF64X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 19 /r ib", Operands: "m, {k}, ymm, imm8"},
+	{Intel: "VEXTRACTF64X2", Go: "VEXTRACTF64X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 19 /r ib", Operands: "xmm, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTF64X2", Go: "VEXTRACTF64X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 19 /r ib", Operands: "m, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTI32X4", Go: "VEXTRACTI32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 39 /r ib", Operands: "xmm, {k}, ymm, imm8"},
+	{Intel: "VEXTRACTI32X4", Go: "VEXTRACTI32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 39 /r ib", Operands: "m, {k}, ymm, imm8"},
+	{Intel: "VEXTRACTI32X8", Go: "VEXTRACTI32X8", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 3B /r ib", Operands: "ymm, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTI32X8", Go: "VEXTRACTI32X8", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 3B /r ib", Operands: "m, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTI64X2", Go: "VEXTRACTI64X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 39 /r ib", Operands: "xmm, {k}, ymm, imm8"},
+	{Intel: "VEXTRACTI64X2", Go: "VEXTRACTI64X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 39 /r ib", Operands: "m, {k}, ymm, imm8"},
+	{Intel: "VEXTRACTI64X2", Go: "VEXTRACTI64X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 39 /r ib", Operands: "xmm, {k}, zmm, imm8"},
+	{Intel: "VEXTRACTI64X2", Go: "VEXTRACTI64X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 39 /r ib", Operands: "m, {k}, zmm, imm8"},
+	{Intel: "VFIXUPIMMPD", Go: "VFIXUPIMMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 54 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VFIXUPIMMPD", Go: "VFIXUPIMMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 54 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VFIXUPIMMPD", Go: "VFIXUPIMMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 54 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VFIXUPIMMPD", Go: "VFIXUPIMMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 54 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VFIXUPIMMPS", Go: "VFIXUPIMMPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 54 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VFIXUPIMMPS", Go: "VFIXUPIMMPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 54 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VFIXUPIMMPS", Go: "VFIXUPIMMPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 54 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VFIXUPIMMPS", Go: "VFIXUPIMMPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 54 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VFMADD132PD", Go: "VFMADD132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 98 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADD132PD", Go: "VFMADD132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 98 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADD132PD", Go: "VFMADD132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 98 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMADD132PD", Go: "VFMADD132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 98 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMADD132PS", Go: "VFMADD132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 98 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADD132PS", Go: "VFMADD132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 98 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADD132PS", Go: "VFMADD132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 98 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMADD132PS", Go: "VFMADD132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 98 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMADD213PD", Go: "VFMADD213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 A8 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADD213PD", Go: "VFMADD213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 A8 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADD213PD", Go: "VFMADD213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 A8 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMADD213PD", Go: "VFMADD213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 A8 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMADD213PS", Go: "VFMADD213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 A8 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADD213PS", Go: "VFMADD213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 A8 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADD213PS", Go: "VFMADD213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 A8 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMADD213PS", Go: "VFMADD213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 A8 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMADD231PD", Go: "VFMADD231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 B8 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADD231PD", Go: "VFMADD231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 B8 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADD231PD", Go: "VFMADD231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 B8 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMADD231PD", Go: "VFMADD231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 B8 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMADD231PS", Go: "VFMADD231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 B8 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADD231PS", Go: "VFMADD231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 B8 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADD231PS", Go: "VFMADD231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 B8 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMADD231PS", Go: "VFMADD231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 B8 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMADDSUB132PD", Go: "VFMADDSUB132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 96 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADDSUB132PD", Go: "VFMADDSUB132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 96 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADDSUB132PD", Go: "VFMADDSUB132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 96 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMADDSUB132PD", Go: "VFMADDSUB132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 96 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMADDSUB132PS", Go: "VFMADDSUB132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 96 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADDSUB132PS", Go: "VFMADDSUB132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 96 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADDSUB132PS", Go: "VFMADDSUB132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 96 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMADDSUB132PS", Go: "VFMADDSUB132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 96 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMADDSUB213PD", Go: "VFMADDSUB213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 A6 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADDSUB213PD", Go: "VFMADDSUB213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 A6 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADDSUB213PD", Go: "VFMADDSUB213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 A6 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMADDSUB213PD", Go: "VFMADDSUB213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 A6 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMADDSUB213PS", Go: "VFMADDSUB213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 A6 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADDSUB213PS", Go: "VFMADDSUB213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 A6 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADDSUB213PS", Go: "VFMADDSUB213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 A6 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMADDSUB213PS", Go: "VFMADDSUB213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 A6 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMADDSUB231PD", Go: "VFMADDSUB231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 B6 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADDSUB231PD", Go: "VFMADDSUB231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 B6 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADDSUB231PD", Go: "VFMADDSUB231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 B6 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMADDSUB231PD", Go: "VFMADDSUB231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 B6 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMADDSUB231PS", Go: "VFMADDSUB231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 B6 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMADDSUB231PS", Go: "VFMADDSUB231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 B6 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMADDSUB231PS", Go: "VFMADDSUB231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 B6 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMADDSUB231PS", Go: "VFMADDSUB231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 B6 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMSUB132PD", Go: "VFMSUB132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 9A /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUB132PD", Go: "VFMSUB132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 9A /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUB132PD", Go: "VFMSUB132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 9A /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMSUB132PD", Go: "VFMSUB132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 9A /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMSUB132PS", Go: "VFMSUB132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 9A /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUB132PS", Go: "VFMSUB132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 9A /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUB132PS", Go: "VFMSUB132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 9A /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMSUB132PS", Go: "VFMSUB132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 9A /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMSUB213PD", Go: "VFMSUB213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 AA /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUB213PD", Go: "VFMSUB213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 AA /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUB213PD", Go: "VFMSUB213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 AA /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMSUB213PD", Go: "VFMSUB213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 AA /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMSUB213PS", Go: "VFMSUB213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 AA /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUB213PS", Go: "VFMSUB213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 AA /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUB213PS", Go: "VFMSUB213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 AA /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMSUB213PS", Go: "VFMSUB213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 AA /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMSUB231PD", Go: "VFMSUB231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 BA /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUB231PD", Go: "VFMSUB231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 BA /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUB231PD", Go: "VFMSUB231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 BA /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMSUB231PD", Go: "VFMSUB231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 BA /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMSUB231PS", Go: "VFMSUB231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 BA /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUB231PS", Go: "VFMSUB231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 BA /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUB231PS", Go: "VFMSUB231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 BA /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMSUB231PS", Go: "VFMSUB231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 BA /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMSUBADD132PD", Go: "VFMSUBADD132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 97 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUBADD132PD", Go: "VFMSUBADD132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 97 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUBADD132PD", Go: "VFMSUBADD132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 97 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMSUBADD132PD", Go: "VFMSUBADD132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 97 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMSUBADD132PS", Go: "VFMSUBADD132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 97 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUBADD132PS", Go: "VFMSUBADD132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 97 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUBADD132PS", Go: "VFMSUBADD132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 97 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMSUBADD132PS", Go: "VFMSUBADD132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 97 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMSUBADD213PD", Go: "VFMSUBADD213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 A7 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUBADD213PD", Go: "VFMSUBADD213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 A7 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUBADD213PD", Go: "VFMSUBADD213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 A7 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMSUBADD213PD", Go: "VFMSUBADD213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 A7 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMSUBADD213PS", Go: "VFMSUBADD213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 A7 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUBADD213PS", Go: "VFMSUBADD213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 A7 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUBADD213PS", Go: "VFMSUBADD213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 A7 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMSUBADD213PS", Go: "VFMSUBADD213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 A7 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMSUBADD231PD", Go: "VFMSUBADD231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 B7 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUBADD231PD", Go: "VFMSUBADD231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 B7 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUBADD231PD", Go: "VFMSUBADD231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 B7 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMSUBADD231PD", Go: "VFMSUBADD231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 B7 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFMSUBADD231PS", Go: "VFMSUBADD231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 B7 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFMSUBADD231PS", Go: "VFMSUBADD231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 B7 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFMSUBADD231PS", Go: "VFMSUBADD231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 B7 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFMSUBADD231PS", Go: "VFMSUBADD231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 B7 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFNMADD132PD", Go: "VFNMADD132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 9C /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMADD132PD", Go: "VFNMADD132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 9C /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMADD132PD", Go: "VFNMADD132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 9C /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFNMADD132PD", Go: "VFNMADD132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 9C /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFNMADD132PS", Go: "VFNMADD132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 9C /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMADD132PS", Go: "VFNMADD132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 9C /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMADD132PS", Go: "VFNMADD132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 9C /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFNMADD132PS", Go: "VFNMADD132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 9C /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFNMADD213PD", Go: "VFNMADD213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 AC /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMADD213PD", Go: "VFNMADD213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 AC /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMADD213PD", Go: "VFNMADD213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 AC /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFNMADD213PD", Go: "VFNMADD213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 AC /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFNMADD213PS", Go: "VFNMADD213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 AC /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMADD213PS", Go: "VFNMADD213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 AC /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMADD213PS", Go: "VFNMADD213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 AC /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFNMADD213PS", Go: "VFNMADD213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 AC /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFNMADD231PD", Go: "VFNMADD231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 BC /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMADD231PD", Go: "VFNMADD231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 BC /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMADD231PD", Go: "VFNMADD231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 BC /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFNMADD231PD", Go: "VFNMADD231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 BC /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFNMADD231PS", Go: "VFNMADD231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 BC /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMADD231PS", Go: "VFNMADD231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 BC /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMADD231PS", Go: "VFNMADD231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 BC /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFNMADD231PS", Go: "VFNMADD231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 BC /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFNMSUB132PD", Go: "VFNMSUB132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 9E /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMSUB132PD", Go: "VFNMSUB132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 9E /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMSUB132PD", Go: "VFNMSUB132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 9E /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFNMSUB132PD", Go: "VFNMSUB132PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 9E /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFNMSUB132PS", Go: "VFNMSUB132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 9E /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMSUB132PS", Go: "VFNMSUB132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 9E /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMSUB132PS", Go: "VFNMSUB132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 9E /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFNMSUB132PS", Go: "VFNMSUB132PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 9E /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFNMSUB213PD", Go: "VFNMSUB213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 AE /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMSUB213PD", Go: "VFNMSUB213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 AE /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMSUB213PD", Go: "VFNMSUB213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 AE /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFNMSUB213PD", Go: "VFNMSUB213PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 AE /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFNMSUB213PS", Go: "VFNMSUB213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 AE /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMSUB213PS", Go: "VFNMSUB213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 AE /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMSUB213PS", Go: "VFNMSUB213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 AE /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFNMSUB213PS", Go: "VFNMSUB213PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 AE /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFNMSUB231PD", Go: "VFNMSUB231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 BE /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMSUB231PD", Go: "VFNMSUB231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 BE /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMSUB231PD", Go: "VFNMSUB231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 BE /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFNMSUB231PD", Go: "VFNMSUB231PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 BE /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFNMSUB231PS", Go: "VFNMSUB231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 BE /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VFNMSUB231PS", Go: "VFNMSUB231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 BE /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VFNMSUB231PS", Go: "VFNMSUB231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 BE /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VFNMSUB231PS", Go: "VFNMSUB231PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 BE /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VFPCLASSPD", Go: "VFPCLASSPDX", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 66 /r ib", Operands: "k, {k}, xmm, imm8"},
+	{Intel: "VFPCLASSPD", Go: "VFPCLASSPDX", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 66 /r ib", Operands: "k, {k}, m, imm8"},
+	{Intel: "VFPCLASSPD", Go: "VFPCLASSPDY", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 66 /r ib", Operands: "k, {k}, ymm, imm8"},
+	{Intel: "VFPCLASSPD", Go: "VFPCLASSPDY", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 66 /r ib", Operands: "k, {k}, m, imm8"},
+	{Intel: "VFPCLASSPD", Go: "VFPCLASSPDZ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 66 /r ib", Operands: "k, {k}, zmm, imm8"},
+	{Intel: "VFPCLASSPD", Go: "VFPCLASSPDZ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 66 /r ib", Operands: "k, {k}, m, imm8"},
+	{Intel: "VFPCLASSPS", Go: "VFPCLASSPSX", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 66 /r ib", Operands: "k, {k}, xmm, imm8"},
+	{Intel: "VFPCLASSPS", Go: "VFPCLASSPSX", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 66 /r ib", Operands: "k, {k}, m, imm8"},
+	{Intel: "VFPCLASSPS", Go: "VFPCLASSPSY", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 66 /r ib", Operands: "k, {k}, ymm, imm8"},
+	{Intel: "VFPCLASSPS", Go: "VFPCLASSPSY", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 66 /r ib", Operands: "k, {k}, m, imm8"},
+	{Intel: "VFPCLASSPS", Go: "VFPCLASSPSZ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 66 /r ib", Operands: "k, {k}, zmm, imm8"},
+	{Intel: "VFPCLASSPS", Go: "VFPCLASSPSZ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 66 /r ib", Operands: "k, {k}, m, imm8"},
+	{Intel: "VFPCLASSSD", Go: "VFPCLASSSD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.LIG.66.0F3A.W1 67 /r ib", Operands: "k, {k}, xmm, imm8"},
+	{Intel: "VFPCLASSSD", Go: "VFPCLASSSD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.LIG.66.0F3A.W1 67 /r ib", Operands: "k, {k}, m, imm8"},
+	{Intel: "VFPCLASSSS", Go: "VFPCLASSSS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.LIG.66.0F3A.W0 67 /r ib", Operands: "k, {k}, xmm, imm8"},
+	{Intel: "VFPCLASSSS", Go: "VFPCLASSSS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.LIG.66.0F3A.W0 67 /r ib", Operands: "k, {k}, m, imm8"},
+	{Intel: "VGATHERDPD", Go: "VGATHERDPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 92 /r", Operands: "xmm, m"},
+	{Intel: "VGATHERDPD", Go: "VGATHERDPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 92 /r", Operands: "ymm, m"},
+	{Intel: "VGATHERDPS", Go: "VGATHERDPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 92 /r", Operands: "xmm, m"},
+	{Intel: "VGATHERDPS", Go: "VGATHERDPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 92 /r", Operands: "ymm, m"},
+	{Intel: "VGATHERQPD", Go: "VGATHERQPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 93 /r", Operands: "xmm, m"},
+	{Intel: "VGATHERQPD", Go: "VGATHERQPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 93 /r", Operands: "ymm, m"},
+	{Intel: "VGATHERQPS", Go: "VGATHERQPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 93 /r", Operands: "xmm, m"},
+	{Intel: "VGATHERQPS", Go: "VGATHERQPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 93 /r", Operands: "xmm, m"},
+	{Intel: "VGETEXPPD", Go: "VGETEXPPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 42 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VGETEXPPD", Go: "VGETEXPPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 42 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VGETEXPPD", Go: "VGETEXPPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 42 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VGETEXPPD", Go: "VGETEXPPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 42 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VGETEXPPS", Go: "VGETEXPPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 42 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VGETEXPPS", Go: "VGETEXPPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 42 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VGETEXPPS", Go: "VGETEXPPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 42 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VGETEXPPS", Go: "VGETEXPPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 42 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VGETMANTPD", Go: "VGETMANTPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 26 /r ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VGETMANTPD", Go: "VGETMANTPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 26 /r ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VGETMANTPD", Go: "VGETMANTPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 26 /r ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VGETMANTPD", Go: "VGETMANTPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 26 /r ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VGETMANTPS", Go: "VGETMANTPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 26 /r ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VGETMANTPS", Go: "VGETMANTPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 26 /r ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VGETMANTPS", Go: "VGETMANTPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 26 /r ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VGETMANTPS", Go: "VGETMANTPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 26 /r ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VINSERTF32X4", Go: "VINSERTF32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 18 /r ib", Operands: "ymm, {k}, ymm, xmm, imm8"},
+	{Intel: "VINSERTF32X4", Go: "VINSERTF32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 18 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VINSERTF32X8", Go: "VINSERTF32X8", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 1A /r ib", Operands: "zmm, {k}, zmm, ymm, imm8"},
+	{Intel: "VINSERTF32X8", Go: "VINSERTF32X8", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 1A /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VINSERTF64X2", Go: "VINSERTF64X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 18 /r ib", Operands: "ymm, {k}, ymm, xmm, imm8"},
+	{Intel: "VINSERTF64X2", Go: "VINSERTF64X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 18 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VINSERTF64X2", Go: "VINSERTF64X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 18 /r ib", Operands: "zmm, {k}, zmm, xmm, imm8"},
+	{Intel: "VINSERTF64X2", Go: "VINSERTF64X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 18 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VINSERTI32X4", Go: "VINSERTI32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 38 /r ib", Operands: "ymm, {k}, ymm, xmm, imm8"},
+	{Intel: "VINSERTI32X4", Go: "VINSERTI32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 38 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VINSERTI32X8", Go: "VINSERTI32X8", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 3A /r ib", Operands: "zmm, {k}, zmm, ymm, imm8"},
+	{Intel: "VINSERTI32X8", Go: "VINSERTI32X8", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 3A /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VINSERTI64X2", Go: "VINSERTI64X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 38 /r ib", Operands: "ymm, {k}, ymm, xmm, imm8"},
+	{Intel: "VINSERTI64X2", Go: "VINSERTI64X2", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 38 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VINSERTI64X2", Go: "VINSERTI64X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 38 /r ib", Operands: "zmm, {k}, zmm, xmm, imm8"},
+	{Intel: "VINSERTI64X2", Go: "VINSERTI64X2", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 38 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VMAXPD", Go: "VMAXPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 5F /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMAXPD", Go: "VMAXPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 5F /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VMAXPD", Go: "VMAXPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 5F /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VMAXPD", Go: "VMAXPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 5F /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VMAXPS", Go: "VMAXPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 5F /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMAXPS", Go: "VMAXPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 5F /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VMAXPS", Go: "VMAXPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 5F /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VMAXPS", Go: "VMAXPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 5F /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VMINPD", Go: "VMINPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 5D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMINPD", Go: "VMINPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 5D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VMINPD", Go: "VMINPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 5D /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VMINPD", Go: "VMINPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 5D /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VMINPS", Go: "VMINPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 5D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMINPS", Go: "VMINPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 5D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VMINPS", Go: "VMINPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 5D /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VMINPS", Go: "VMINPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 5D /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 28 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 28 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 29 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 29 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 28 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 28 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 29 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVAPD", Go: "VMOVAPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 29 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 28 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 28 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 29 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 29 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 28 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 28 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 29 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVAPS", Go: "VMOVAPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 29 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VMOVDDUP", Go: "VMOVDDUP", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W1 12 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVDDUP", Go: "VMOVDDUP", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W1 12 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVDDUP", Go: "VMOVDDUP", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W1 12 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVDDUP", Go: "VMOVDDUP", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W1 12 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VMOVDQA32", Go: "VMOVDQA32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 6F /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVDQA32", Go: "VMOVDQA32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 6F /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVDQA32", Go: "VMOVDQA32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 7F /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVDQA32", Go: "VMOVDQA32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 7F /r", Operands: "m, {k}, xmm"},
+	{Intel: "VMOVDQA32", Go: "VMOVDQA32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 6F /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVDQA32", Go: "VMOVDQA32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 6F /r", Operands: "ymm, {k}, m"},
+	{Intel: "VMOVDQA32", Go: "VMOVDQA32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 7F /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVDQA32", Go: "VMOVDQA32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 7F /r", Operands: "m, {k}, ymm"},
+	{Intel: "VMOVDQA64", Go: "VMOVDQA64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 6F /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVDQA64", Go: "VMOVDQA64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 6F /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVDQA64", Go: "VMOVDQA64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 7F /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVDQA64", Go: "VMOVDQA64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 7F /r", Operands: "m, {k}, xmm"},
+	{Intel: "VMOVDQA64", Go: "VMOVDQA64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 6F /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVDQA64", Go: "VMOVDQA64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 6F /r", Operands: "ymm, {k}, m"},
+	{Intel: "VMOVDQA64", Go: "VMOVDQA64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 7F /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVDQA64", Go: "VMOVDQA64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 7F /r", Operands: "m, {k}, ymm"},
+	{Intel: "VMOVDQU16", Go: "VMOVDQU16", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W1 6F /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVDQU16", Go: "VMOVDQU16", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W1 6F /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVDQU16", Go: "VMOVDQU16", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W1 7F /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVDQU16", Go: "VMOVDQU16", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W1 7F /r", Operands: "m, {k}, xmm"},
+	{Intel: "VMOVDQU16", Go: "VMOVDQU16", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W1 6F /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVDQU16", Go: "VMOVDQU16", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W1 6F /r", Operands: "ymm, {k}, m"},
+	{Intel: "VMOVDQU16", Go: "VMOVDQU16", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W1 7F /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVDQU16", Go: "VMOVDQU16", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W1 7F /r", Operands: "m, {k}, ymm"},
+	{Intel: "VMOVDQU16", Go: "VMOVDQU16", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F2.0F.W1 6F /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVDQU16", Go: "VMOVDQU16", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F2.0F.W1 6F /r", Operands: "zmm, {k}, m"},
+	{Intel: "VMOVDQU16", Go: "VMOVDQU16", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F2.0F.W1 7F /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVDQU16", Go: "VMOVDQU16", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F2.0F.W1 7F /r", Operands: "m, {k}, zmm"},
+	{Intel: "VMOVDQU32", Go: "VMOVDQU32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 6F /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVDQU32", Go: "VMOVDQU32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 6F /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVDQU32", Go: "VMOVDQU32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 7F /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVDQU32", Go: "VMOVDQU32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 7F /r", Operands: "m, {k}, xmm"},
+	{Intel: "VMOVDQU32", Go: "VMOVDQU32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 6F /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVDQU32", Go: "VMOVDQU32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 6F /r", Operands: "ymm, {k}, m"},
+	{Intel: "VMOVDQU32", Go: "VMOVDQU32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 7F /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVDQU32", Go: "VMOVDQU32", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 7F /r", Operands: "m, {k}, ymm"},
+	{Intel: "VMOVDQU64", Go: "VMOVDQU64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W1 6F /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVDQU64", Go: "VMOVDQU64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W1 6F /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVDQU64", Go: "VMOVDQU64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W1 7F /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVDQU64", Go: "VMOVDQU64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W1 7F /r", Operands: "m, {k}, xmm"},
+	{Intel: "VMOVDQU64", Go: "VMOVDQU64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W1 6F /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVDQU64", Go: "VMOVDQU64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W1 6F /r", Operands: "ymm, {k}, m"},
+	{Intel: "VMOVDQU64", Go: "VMOVDQU64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W1 7F /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVDQU64", Go: "VMOVDQU64", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W1 7F /r", Operands: "m, {k}, ymm"},
+	{Intel: "VMOVDQU8", Go: "VMOVDQU8", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W0 6F /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVDQU8", Go: "VMOVDQU8", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W0 6F /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVDQU8", Go: "VMOVDQU8", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W0 7F /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVDQU8", Go: "VMOVDQU8", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.W0 7F /r", Operands: "m, {k}, xmm"},
+	{Intel: "VMOVDQU8", Go: "VMOVDQU8", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W0 6F /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVDQU8", Go: "VMOVDQU8", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W0 6F /r", Operands: "ymm, {k}, m"},
+	{Intel: "VMOVDQU8", Go: "VMOVDQU8", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W0 7F /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVDQU8", Go: "VMOVDQU8", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.W0 7F /r", Operands: "m, {k}, ymm"},
+	{Intel: "VMOVDQU8", Go: "VMOVDQU8", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F2.0F.W0 6F /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVDQU8", Go: "VMOVDQU8", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F2.0F.W0 6F /r", Operands: "zmm, {k}, m"},
+	{Intel: "VMOVDQU8", Go: "VMOVDQU8", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F2.0F.W0 7F /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VMOVDQU8", Go: "VMOVDQU8", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F2.0F.W0 7F /r", Operands: "m, {k}, zmm"},
+	{Intel: "VMOVNTDQ", Go: "VMOVNTDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 E7 /r", Operands: "m, xmm"},
+	{Intel: "VMOVNTDQ", Go: "VMOVNTDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 E7 /r", Operands: "m, ymm"},
+	{Intel: "VMOVNTDQA", Go: "VMOVNTDQA", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 2A /r", Operands: "xmm, m"},
+	{Intel: "VMOVNTDQA", Go: "VMOVNTDQA", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 2A /r", Operands: "ymm, m"},
+	{Intel: "VMOVNTPD", Go: "VMOVNTPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 2B /r", Operands: "m, xmm"},
+	{Intel: "VMOVNTPD", Go: "VMOVNTPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 2B /r", Operands: "m, ymm"},
+	{Intel: "VMOVNTPS", Go: "VMOVNTPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 2B /r", Operands: "m, xmm"},
+	{Intel: "VMOVNTPS", Go: "VMOVNTPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 2B /r", Operands: "m, ymm"},
+	{Intel: "VMOVSHDUP", Go: "VMOVSHDUP", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 16 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVSHDUP", Go: "VMOVSHDUP", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 16 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVSHDUP", Go: "VMOVSHDUP", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 16 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVSHDUP", Go: "VMOVSHDUP", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 16 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VMOVSLDUP", Go: "VMOVSLDUP", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 12 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVSLDUP", Go: "VMOVSLDUP", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.W0 12 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVSLDUP", Go: "VMOVSLDUP", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 12 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVSLDUP", Go: "VMOVSLDUP", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.W0 12 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 10 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 10 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 11 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 11 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 10 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 10 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 11 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVUPD", Go: "VMOVUPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 11 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 10 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 10 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 11 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 11 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 10 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 10 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 11 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VMOVUPS", Go: "VMOVUPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 11 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VMULPD", Go: "VMULPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 59 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMULPD", Go: "VMULPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 59 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VMULPD", Go: "VMULPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 59 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VMULPD", Go: "VMULPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 59 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VMULPS", Go: "VMULPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 59 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VMULPS", Go: "VMULPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 59 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VMULPS", Go: "VMULPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 59 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VMULPS", Go: "VMULPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 59 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VORPD", Go: "VORPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 56 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VORPD", Go: "VORPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 56 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VORPD", Go: "VORPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 56 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VORPD", Go: "VORPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 56 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VORPD", Go: "VORPD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 56 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VORPD", Go: "VORPD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 56 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VORPS", Go: "VORPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 56 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VORPS", Go: "VORPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 56 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VORPS", Go: "VORPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 56 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VORPS", Go: "VORPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 56 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VORPS", Go: "VORPS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.0F.W0 56 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VORPS", Go: "VORPS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.0F.W0 56 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPABSB", Go: "VPABSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 1C /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPABSB", Go: "VPABSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 1C /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPABSB", Go: "VPABSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 1C /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPABSB", Go: "VPABSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 1C /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPABSB", Go: "VPABSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 1C /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPABSB", Go: "VPABSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 1C /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPABSD", Go: "VPABSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 1E /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPABSD", Go: "VPABSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 1E /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPABSD", Go: "VPABSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 1E /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPABSD", Go: "VPABSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 1E /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPABSQ", Go: "VPABSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 1F /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPABSQ", Go: "VPABSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 1F /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPABSQ", Go: "VPABSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 1F /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPABSQ", Go: "VPABSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 1F /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPABSW", Go: "VPABSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 1D /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPABSW", Go: "VPABSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 1D /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPABSW", Go: "VPABSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 1D /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPABSW", Go: "VPABSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 1D /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPABSW", Go: "VPABSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 1D /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPABSW", Go: "VPABSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 1D /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPACKSSDW", Go: "VPACKSSDW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 6B /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPACKSSDW", Go: "VPACKSSDW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 6B /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPACKSSDW", Go: "VPACKSSDW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 6B /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPACKSSDW", Go: "VPACKSSDW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 6B /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPACKSSDW", Go: "VPACKSSDW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.W0 6B /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPACKSSDW", Go: "VPACKSSDW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.W0 6B /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPACKSSWB", Go: "VPACKSSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 63 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPACKSSWB", Go: "VPACKSSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 63 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPACKSSWB", Go: "VPACKSSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 63 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPACKSSWB", Go: "VPACKSSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 63 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPACKSSWB", Go: "VPACKSSWB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 63 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPACKSSWB", Go: "VPACKSSWB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 63 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPACKUSDW", Go: "VPACKUSDW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 2B /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPACKUSDW", Go: "VPACKUSDW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 2B /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPACKUSDW", Go: "VPACKUSDW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 2B /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPACKUSDW", Go: "VPACKUSDW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 2B /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPACKUSDW", Go: "VPACKUSDW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W0 2B /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPACKUSDW", Go: "VPACKUSDW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W0 2B /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPACKUSWB", Go: "VPACKUSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 67 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPACKUSWB", Go: "VPACKUSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 67 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPACKUSWB", Go: "VPACKUSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 67 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPACKUSWB", Go: "VPACKUSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 67 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPACKUSWB", Go: "VPACKUSWB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 67 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPACKUSWB", Go: "VPACKUSWB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 67 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPADDB", Go: "VPADDB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG FC /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPADDB", Go: "VPADDB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG FC /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPADDB", Go: "VPADDB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG FC /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPADDB", Go: "VPADDB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG FC /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPADDB", Go: "VPADDB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG FC /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPADDB", Go: "VPADDB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG FC /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPADDD", Go: "VPADDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 FE /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPADDD", Go: "VPADDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 FE /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPADDD", Go: "VPADDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 FE /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPADDD", Go: "VPADDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 FE /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPADDQ", Go: "VPADDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 D4 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPADDQ", Go: "VPADDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 D4 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPADDQ", Go: "VPADDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 D4 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPADDQ", Go: "VPADDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 D4 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPADDSB", Go: "VPADDSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG EC /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPADDSB", Go: "VPADDSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG EC /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPADDSB", Go: "VPADDSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG EC /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPADDSB", Go: "VPADDSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG EC /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPADDSB", Go: "VPADDSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG EC /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPADDSB", Go: "VPADDSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG EC /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPADDSW", Go: "VPADDSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG ED /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPADDSW", Go: "VPADDSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG ED /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPADDSW", Go: "VPADDSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG ED /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPADDSW", Go: "VPADDSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG ED /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPADDSW", Go: "VPADDSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG ED /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPADDSW", Go: "VPADDSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG ED /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPADDUSB", Go: "VPADDUSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG DC /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPADDUSB", Go: "VPADDUSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG DC /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPADDUSB", Go: "VPADDUSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG DC /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPADDUSB", Go: "VPADDUSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG DC /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPADDUSB", Go: "VPADDUSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG DC /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPADDUSB", Go: "VPADDUSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG DC /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPADDUSW", Go: "VPADDUSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG DD /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPADDUSW", Go: "VPADDUSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG DD /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPADDUSW", Go: "VPADDUSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG DD /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPADDUSW", Go: "VPADDUSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG DD /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPADDUSW", Go: "VPADDUSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG DD /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPADDUSW", Go: "VPADDUSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG DD /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPADDW", Go: "VPADDW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG FD /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPADDW", Go: "VPADDW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG FD /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPADDW", Go: "VPADDW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG FD /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPADDW", Go: "VPADDW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG FD /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPADDW", Go: "VPADDW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG FD /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPADDW", Go: "VPADDW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG FD /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPALIGNR", Go: "VPALIGNR", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.WIG 0F /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VPALIGNR", Go: "VPALIGNR", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.WIG 0F /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VPALIGNR", Go: "VPALIGNR", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.WIG 0F /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VPALIGNR", Go: "VPALIGNR", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.WIG 0F /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VPALIGNR", Go: "VPALIGNR", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F3A.WIG 0F /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VPALIGNR", Go: "VPALIGNR", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F3A.WIG 0F /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VPANDD", Go: "VPANDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 DB /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPANDD", Go: "VPANDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 DB /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPANDD", Go: "VPANDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 DB /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPANDD", Go: "VPANDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 DB /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPANDND", Go: "VPANDND", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 DF /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPANDND", Go: "VPANDND", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 DF /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPANDND", Go: "VPANDND", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 DF /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPANDND", Go: "VPANDND", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 DF /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPANDNQ", Go: "VPANDNQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 DF /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPANDNQ", Go: "VPANDNQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 DF /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPANDNQ", Go: "VPANDNQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 DF /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPANDNQ", Go: "VPANDNQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 DF /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPANDQ", Go: "VPANDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 DB /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPANDQ", Go: "VPANDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 DB /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPANDQ", Go: "VPANDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 DB /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPANDQ", Go: "VPANDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 DB /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPAVGB", Go: "VPAVGB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E0 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPAVGB", Go: "VPAVGB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E0 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPAVGB", Go: "VPAVGB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E0 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPAVGB", Go: "VPAVGB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E0 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPAVGB", Go: "VPAVGB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E0 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPAVGB", Go: "VPAVGB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E0 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPAVGW", Go: "VPAVGW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E3 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPAVGW", Go: "VPAVGW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E3 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPAVGW", Go: "VPAVGW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E3 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPAVGW", Go: "VPAVGW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E3 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPAVGW", Go: "VPAVGW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E3 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPAVGW", Go: "VPAVGW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E3 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPBLENDMB", Go: "VPBLENDMB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 66 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPBLENDMB", Go: "VPBLENDMB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 66 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPBLENDMB", Go: "VPBLENDMB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 66 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPBLENDMB", Go: "VPBLENDMB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 66 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPBLENDMB", Go: "VPBLENDMB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W0 66 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPBLENDMB", Go: "VPBLENDMB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W0 66 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPBLENDMD", Go: "VPBLENDMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 64 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPBLENDMD", Go: "VPBLENDMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 64 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPBLENDMD", Go: "VPBLENDMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 64 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPBLENDMD", Go: "VPBLENDMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 64 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPBLENDMQ", Go: "VPBLENDMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 64 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPBLENDMQ", Go: "VPBLENDMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 64 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPBLENDMQ", Go: "VPBLENDMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 64 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPBLENDMQ", Go: "VPBLENDMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 64 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPBLENDMW", Go: "VPBLENDMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 66 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPBLENDMW", Go: "VPBLENDMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 66 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPBLENDMW", Go: "VPBLENDMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 66 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPBLENDMW", Go: "VPBLENDMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 66 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPBLENDMW", Go: "VPBLENDMW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 66 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPBLENDMW", Go: "VPBLENDMW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 66 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPBROADCASTB", Go: "VPBROADCASTB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 78 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPBROADCASTB", Go: "VPBROADCASTB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 78 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPBROADCASTB", Go: "VPBROADCASTB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 7A /r", Operands: "xmm, {k}, r32"},
+	{Intel: "VPBROADCASTB", Go: "VPBROADCASTB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 78 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPBROADCASTB", Go: "VPBROADCASTB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 78 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPBROADCASTB", Go: "VPBROADCASTB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 7A /r", Operands: "ymm, {k}, r32"},
+	{Intel: "VPBROADCASTB", Go: "VPBROADCASTB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W0 78 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VPBROADCASTB", Go: "VPBROADCASTB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W0 78 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPBROADCASTB", Go: "VPBROADCASTB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W0 7A /r", Operands: "zmm, {k}, r32"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 58 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 58 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 7C /r", Operands: "xmm, {k}, r32"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 7C /r", Operands: "xmm, {k}, r32"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 58 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 58 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 7C /r", Operands: "ymm, {k}, r32"},
+	{Intel: "VPBROADCASTD", Go: "VPBROADCASTD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 7C /r", Operands: "ymm, {k}, r32"},
+	{Intel: "VPBROADCASTMB2Q", Go: "VPBROADCASTMB2Q", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W1 2A /r", Operands: "xmm, k"},
+	{Intel: "VPBROADCASTMB2Q", Go: "VPBROADCASTMB2Q", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W1 2A /r", Operands: "ymm, k"},
+	{Intel: "VPBROADCASTMW2D", Go: "VPBROADCASTMW2D", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 3A /r", Operands: "xmm, k"},
+	{Intel: "VPBROADCASTMW2D", Go: "VPBROADCASTMW2D", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 3A /r", Operands: "ymm, k"},
+	{Intel: "VPBROADCASTQ", Go: "VPBROADCASTQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 59 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPBROADCASTQ", Go: "VPBROADCASTQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 59 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPBROADCASTQ", Go: "VPBROADCASTQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 7C /r", Operands: "xmm, {k}, r64"},
+	{Intel: "VPBROADCASTQ", Go: "VPBROADCASTQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 59 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPBROADCASTQ", Go: "VPBROADCASTQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 59 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPBROADCASTQ", Go: "VPBROADCASTQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 7C /r", Operands: "ymm, {k}, r64"},
+	{Intel: "VPBROADCASTW", Go: "VPBROADCASTW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 79 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPBROADCASTW", Go: "VPBROADCASTW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 79 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPBROADCASTW", Go: "VPBROADCASTW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 7B /r", Operands: "xmm, {k}, r32"},
+	{Intel: "VPBROADCASTW", Go: "VPBROADCASTW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 79 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPBROADCASTW", Go: "VPBROADCASTW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 79 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPBROADCASTW", Go: "VPBROADCASTW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 7B /r", Operands: "ymm, {k}, r32"},
+	{Intel: "VPBROADCASTW", Go: "VPBROADCASTW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W0 79 /r", Operands: "zmm, {k}, xmm"},
+	{Intel: "VPBROADCASTW", Go: "VPBROADCASTW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W0 79 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPBROADCASTW", Go: "VPBROADCASTW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W0 7B /r", Operands: "zmm, {k}, r32"},
+	{Intel: "VPCMPB", Go: "VPCMPB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 3F /r ib", Operands: "k, {k}, xmm, xmm, imm8"},
+	{Intel: "VPCMPB", Go: "VPCMPB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 3F /r ib", Operands: "k, {k}, xmm, m, imm8"},
+	{Intel: "VPCMPB", Go: "VPCMPB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 3F /r ib", Operands: "k, {k}, ymm, ymm, imm8"},
+	{Intel: "VPCMPB", Go: "VPCMPB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 3F /r ib", Operands: "k, {k}, ymm, m, imm8"},
+	{Intel: "VPCMPB", Go: "VPCMPB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 3F /r ib", Operands: "k, {k}, zmm, zmm, imm8"},
+	{Intel: "VPCMPB", Go: "VPCMPB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 3F /r ib", Operands: "k, {k}, zmm, m, imm8"},
+	{Intel: "VPCMPD", Go: "VPCMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 1F /r ib", Operands: "k, {k}, xmm, xmm, imm8"},
+	{Intel: "VPCMPD", Go: "VPCMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 1F /r ib", Operands: "k, {k}, xmm, m, imm8"},
+	{Intel: "VPCMPD", Go: "VPCMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 1F /r ib", Operands: "k, {k}, ymm, ymm, imm8"},
+	{Intel: "VPCMPD", Go: "VPCMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 1F /r ib", Operands: "k, {k}, ymm, m, imm8"},
+	{Intel: "VPCMPEQB", Go: "VPCMPEQB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 74 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPCMPEQB", Go: "VPCMPEQB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 74 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPCMPEQB", Go: "VPCMPEQB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 74 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPCMPEQB", Go: "VPCMPEQB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 74 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPCMPEQB", Go: "VPCMPEQB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 74 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPCMPEQB", Go: "VPCMPEQB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 74 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPCMPEQD", Go: "VPCMPEQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 76 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPCMPEQD", Go: "VPCMPEQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 76 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPCMPEQD", Go: "VPCMPEQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 76 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPCMPEQD", Go: "VPCMPEQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 76 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPCMPEQQ", Go: "VPCMPEQQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 29 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPCMPEQQ", Go: "VPCMPEQQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 29 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPCMPEQQ", Go: "VPCMPEQQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 29 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPCMPEQQ", Go: "VPCMPEQQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 29 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPCMPEQW", Go: "VPCMPEQW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 75 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPCMPEQW", Go: "VPCMPEQW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 75 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPCMPEQW", Go: "VPCMPEQW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 75 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPCMPEQW", Go: "VPCMPEQW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 75 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPCMPEQW", Go: "VPCMPEQW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 75 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPCMPEQW", Go: "VPCMPEQW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 75 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPCMPGTB", Go: "VPCMPGTB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 64 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPCMPGTB", Go: "VPCMPGTB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 64 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPCMPGTB", Go: "VPCMPGTB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 64 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPCMPGTB", Go: "VPCMPGTB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 64 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPCMPGTB", Go: "VPCMPGTB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 64 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPCMPGTB", Go: "VPCMPGTB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 64 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPCMPGTD", Go: "VPCMPGTD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 66 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPCMPGTD", Go: "VPCMPGTD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 66 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPCMPGTD", Go: "VPCMPGTD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 66 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPCMPGTD", Go: "VPCMPGTD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 66 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPCMPGTQ", Go: "VPCMPGTQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 37 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPCMPGTQ", Go: "VPCMPGTQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 37 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPCMPGTQ", Go: "VPCMPGTQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 37 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPCMPGTQ", Go: "VPCMPGTQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 37 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPCMPGTW", Go: "VPCMPGTW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 65 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPCMPGTW", Go: "VPCMPGTW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 65 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPCMPGTW", Go: "VPCMPGTW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 65 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPCMPGTW", Go: "VPCMPGTW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 65 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPCMPGTW", Go: "VPCMPGTW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 65 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPCMPGTW", Go: "VPCMPGTW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 65 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPCMPQ", Go: "VPCMPQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 1F /r ib", Operands: "k, {k}, xmm, xmm, imm8"},
+	{Intel: "VPCMPQ", Go: "VPCMPQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 1F /r ib", Operands: "k, {k}, xmm, m, imm8"},
+	{Intel: "VPCMPQ", Go: "VPCMPQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 1F /r ib", Operands: "k, {k}, ymm, ymm, imm8"},
+	{Intel: "VPCMPQ", Go: "VPCMPQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 1F /r ib", Operands: "k, {k}, ymm, m, imm8"},
+	{Intel: "VPCMPUB", Go: "VPCMPUB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 3E /r ib", Operands: "k, {k}, xmm, xmm, imm8"},
+	{Intel: "VPCMPUB", Go: "VPCMPUB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 3E /r ib", Operands: "k, {k}, xmm, m, imm8"},
+	{Intel: "VPCMPUB", Go: "VPCMPUB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 3E /r ib", Operands: "k, {k}, ymm, ymm, imm8"},
+	{Intel: "VPCMPUB", Go: "VPCMPUB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 3E /r ib", Operands: "k, {k}, ymm, m, imm8"},
+	{Intel: "VPCMPUB", Go: "VPCMPUB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 3E /r ib", Operands: "k, {k}, zmm, zmm, imm8"},
+	{Intel: "VPCMPUB", Go: "VPCMPUB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 3E /r ib", Operands: "k, {k}, zmm, m, imm8"},
+	{Intel: "VPCMPUD", Go: "VPCMPUD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 1E /r ib", Operands: "k, {k}, xmm, xmm, imm8"},
+	{Intel: "VPCMPUD", Go: "VPCMPUD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 1E /r ib", Operands: "k, {k}, xmm, m, imm8"},
+	{Intel: "VPCMPUD", Go: "VPCMPUD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 1E /r ib", Operands: "k, {k}, ymm, ymm, imm8"},
+	{Intel: "VPCMPUD", Go: "VPCMPUD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 1E /r ib", Operands: "k, {k}, ymm, m, imm8"},
+	{Intel: "VPCMPUQ", Go: "VPCMPUQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 1E /r ib", Operands: "k, {k}, xmm, xmm, imm8"},
+	{Intel: "VPCMPUQ", Go: "VPCMPUQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 1E /r ib", Operands: "k, {k}, xmm, m, imm8"},
+	{Intel: "VPCMPUQ", Go: "VPCMPUQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 1E /r ib", Operands: "k, {k}, ymm, ymm, imm8"},
+	{Intel: "VPCMPUQ", Go: "VPCMPUQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 1E /r ib", Operands: "k, {k}, ymm, m, imm8"},
+	{Intel: "VPCMPUW", Go: "VPCMPUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 3E /r ib", Operands: "k, {k}, xmm, xmm, imm8"},
+	{Intel: "VPCMPUW", Go: "VPCMPUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 3E /r ib", Operands: "k, {k}, xmm, m, imm8"},
+	{Intel: "VPCMPUW", Go: "VPCMPUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 3E /r ib", Operands: "k, {k}, ymm, ymm, imm8"},
+	{Intel: "VPCMPUW", Go: "VPCMPUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 3E /r ib", Operands: "k, {k}, ymm, m, imm8"},
+	{Intel: "VPCMPUW", Go: "VPCMPUW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 3E /r ib", Operands: "k, {k}, zmm, zmm, imm8"},
+	{Intel: "VPCMPUW", Go: "VPCMPUW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 3E /r ib", Operands: "k, {k}, zmm, m, imm8"},
+	{Intel: "VPCMPW", Go: "VPCMPW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 3F /r ib", Operands: "k, {k}, xmm, xmm, imm8"},
+	{Intel: "VPCMPW", Go: "VPCMPW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 3F /r ib", Operands: "k, {k}, xmm, m, imm8"},
+	{Intel: "VPCMPW", Go: "VPCMPW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 3F /r ib", Operands: "k, {k}, ymm, ymm, imm8"},
+	{Intel: "VPCMPW", Go: "VPCMPW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 3F /r ib", Operands: "k, {k}, ymm, m, imm8"},
+	{Intel: "VPCMPW", Go: "VPCMPW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 3F /r ib", Operands: "k, {k}, zmm, zmm, imm8"},
+	{Intel: "VPCMPW", Go: "VPCMPW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 3F /r ib", Operands: "k, {k}, zmm, m, imm8"},
+	{Intel: "VPCOMPRESSD", Go: "VPCOMPRESSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 8B /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPCOMPRESSD", Go: "VPCOMPRESSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 8B /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPCOMPRESSD", Go: "VPCOMPRESSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 8B /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPCOMPRESSD", Go: "VPCOMPRESSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 8B /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPCOMPRESSQ", Go: "VPCOMPRESSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 8B /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPCOMPRESSQ", Go: "VPCOMPRESSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 8B /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPCOMPRESSQ", Go: "VPCOMPRESSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 8B /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPCOMPRESSQ", Go: "VPCOMPRESSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 8B /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPCONFLICTD", Go: "VPCONFLICTD", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 C4 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPCONFLICTD", Go: "VPCONFLICTD", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 C4 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPCONFLICTD", Go: "VPCONFLICTD", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 C4 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPCONFLICTD", Go: "VPCONFLICTD", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 C4 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPCONFLICTQ", Go: "VPCONFLICTQ", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 C4 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPCONFLICTQ", Go: "VPCONFLICTQ", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 C4 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPCONFLICTQ", Go: "VPCONFLICTQ", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 C4 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPCONFLICTQ", Go: "VPCONFLICTQ", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 C4 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPERMD", Go: "VPERMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 36 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMD", Go: "VPERMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 36 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMI2D", Go: "VPERMI2D", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 76 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMI2D", Go: "VPERMI2D", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 76 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMI2D", Go: "VPERMI2D", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 76 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMI2D", Go: "VPERMI2D", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 76 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMI2PD", Go: "VPERMI2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 77 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMI2PD", Go: "VPERMI2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 77 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMI2PD", Go: "VPERMI2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 77 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMI2PD", Go: "VPERMI2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 77 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMI2PS", Go: "VPERMI2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 77 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMI2PS", Go: "VPERMI2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 77 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMI2PS", Go: "VPERMI2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 77 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMI2PS", Go: "VPERMI2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 77 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMI2Q", Go: "VPERMI2Q", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 76 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMI2Q", Go: "VPERMI2Q", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 76 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMI2Q", Go: "VPERMI2Q", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 76 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMI2Q", Go: "VPERMI2Q", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 76 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMI2W", Go: "VPERMI2W", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 75 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMI2W", Go: "VPERMI2W", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 75 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMI2W", Go: "VPERMI2W", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 75 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMI2W", Go: "VPERMI2W", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 75 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMI2W", Go: "VPERMI2W", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 75 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMI2W", Go: "VPERMI2W", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 75 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 05 /r ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 05 /r ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 0D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 0D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 05 /r ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 05 /r ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 0D /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMILPD", Go: "VPERMILPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 0D /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 04 /r ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 04 /r ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 0C /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 0C /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 04 /r ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 04 /r ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 0C /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMILPS", Go: "VPERMILPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 0C /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMPD", Go: "VPERMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 01 /r ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPERMPD", Go: "VPERMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 01 /r ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPERMPD", Go: "VPERMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 16 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMPD", Go: "VPERMPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 16 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMPS", Go: "VPERMPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 16 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMPS", Go: "VPERMPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 16 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMQ", Go: "VPERMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 00 /r ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPERMQ", Go: "VPERMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 00 /r ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPERMQ", Go: "VPERMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 36 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMQ", Go: "VPERMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 36 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMT2D", Go: "VPERMT2D", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 7E /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMT2D", Go: "VPERMT2D", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 7E /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMT2D", Go: "VPERMT2D", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 7E /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMT2D", Go: "VPERMT2D", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 7E /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMT2PD", Go: "VPERMT2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 7F /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMT2PD", Go: "VPERMT2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 7F /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMT2PD", Go: "VPERMT2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 7F /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMT2PD", Go: "VPERMT2PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 7F /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMT2PS", Go: "VPERMT2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 7F /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMT2PS", Go: "VPERMT2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 7F /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMT2PS", Go: "VPERMT2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 7F /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMT2PS", Go: "VPERMT2PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 7F /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMT2Q", Go: "VPERMT2Q", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 7E /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMT2Q", Go: "VPERMT2Q", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 7E /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMT2Q", Go: "VPERMT2Q", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 7E /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMT2Q", Go: "VPERMT2Q", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 7E /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMT2W", Go: "VPERMT2W", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 7D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMT2W", Go: "VPERMT2W", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 7D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMT2W", Go: "VPERMT2W", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 7D /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMT2W", Go: "VPERMT2W", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 7D /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMT2W", Go: "VPERMT2W", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 7D /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMT2W", Go: "VPERMT2W", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 7D /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMW", Go: "VPERMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 8D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMW", Go: "VPERMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 8D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMW", Go: "VPERMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 8D /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMW", Go: "VPERMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 8D /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMW", Go: "VPERMW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 8D /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMW", Go: "VPERMW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 8D /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPEXPANDD", Go: "VPEXPANDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 89 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPEXPANDD", Go: "VPEXPANDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 89 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPEXPANDD", Go: "VPEXPANDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 89 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPEXPANDD", Go: "VPEXPANDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 89 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPEXPANDQ", Go: "VPEXPANDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 89 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPEXPANDQ", Go: "VPEXPANDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 89 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPEXPANDQ", Go: "VPEXPANDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 89 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPEXPANDQ", Go: "VPEXPANDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 89 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPEXTRB", Go: "VPEXTRB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.128.66.0F3A.WIG 14 /r ib", Operands: "r32, xmm, imm8"},
+	{Intel: "VPEXTRB", Go: "VPEXTRB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.128.66.0F3A.WIG 14 /r ib", Operands: "m, xmm, imm8"},
+	{Intel: "VPEXTRD", Go: "VPEXTRD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.128.66.0F3A.WIG 16 /r ib", Operands: "r32, xmm, imm8"},
+	{Intel: "VPEXTRD", Go: "VPEXTRD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.128.66.0F3A.W0 16 /r ib", Operands: "r32, xmm, imm8"},
+	{Intel: "VPEXTRD", Go: "VPEXTRD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.128.66.0F3A.WIG 16 /r ib", Operands: "m, xmm, imm8"},
+	{Intel: "VPEXTRD", Go: "VPEXTRD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.128.66.0F3A.W0 16 /r ib", Operands: "m, xmm, imm8"},
+	{Intel: "VPEXTRQ", Go: "VPEXTRQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.128.66.0F3A.W1 16 /r ib", Operands: "r64, xmm, imm8"},
+	{Intel: "VPEXTRQ", Go: "VPEXTRQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.128.66.0F3A.W1 16 /r ib", Operands: "m, xmm, imm8"},
+	{Intel: "VPEXTRW", Go: "VPEXTRW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.128.66.0F3A.WIG 15 /r ib", Operands: "r32, xmm, imm8"},
+	{Intel: "VPEXTRW", Go: "VPEXTRW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.128.66.0F3A.WIG 15 /r ib", Operands: "m, xmm, imm8"},
+	{Intel: "VPEXTRW", Go: "VPEXTRW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.128.66.0F.WIG C5 /r ib", Operands: "r32, xmm, imm8"},
+	{Intel: "VPGATHERDD", Go: "VPGATHERDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 90 /r", Operands: "xmm, m"},
+	{Intel: "VPGATHERDD", Go: "VPGATHERDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 90 /r", Operands: "ymm, m"},
+	{Intel: "VPGATHERDQ", Go: "VPGATHERDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 90 /r", Operands: "xmm, m"},
+	{Intel: "VPGATHERDQ", Go: "VPGATHERDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 90 /r", Operands: "ymm, m"},
+	{Intel: "VPGATHERQD", Go: "VPGATHERQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 91 /r", Operands: "xmm, m"},
+	{Intel: "VPGATHERQD", Go: "VPGATHERQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 91 /r", Operands: "xmm, m"},
+	{Intel: "VPGATHERQQ", Go: "VPGATHERQQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 91 /r", Operands: "xmm, m"},
+	{Intel: "VPGATHERQQ", Go: "VPGATHERQQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 91 /r", Operands: "ymm, m"},
+	{Intel: "VPINSRB", Go: "VPINSRB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.128.66.0F3A.WIG 20 /r ib", Operands: "xmm, xmm, r32, imm8"},
+	{Intel: "VPINSRB", Go: "VPINSRB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.128.66.0F3A.WIG 20 /r ib", Operands: "xmm, xmm, m, imm8"},
+	{Intel: "VPINSRD", Go: "VPINSRD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.128.66.0F3A.WIG 22 /r ib", Operands: "xmm, xmm, r32, imm8"},
+	{Intel: "VPINSRD", Go: "VPINSRD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.128.66.0F3A.W0 22 /r ib", Operands: "xmm, xmm, r32, imm8"},
+	{Intel: "VPINSRD", Go: "VPINSRD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.128.66.0F3A.WIG 22 /r ib", Operands: "xmm, xmm, m, imm8"},
+	{Intel: "VPINSRD", Go: "VPINSRD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.128.66.0F3A.W0 22 /r ib", Operands: "xmm, xmm, m, imm8"},
+	{Intel: "VPINSRQ", Go: "VPINSRQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.128.66.0F3A.W1 22 /r ib", Operands: "xmm, xmm, r64, imm8"},
+	{Intel: "VPINSRQ", Go: "VPINSRQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.128.66.0F3A.W1 22 /r ib", Operands: "xmm, xmm, m, imm8"},
+	{Intel: "VPINSRW", Go: "VPINSRW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.128.66.0F.WIG C4 /r ib", Operands: "xmm, xmm, r32, imm8"},
+	{Intel: "VPINSRW", Go: "VPINSRW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.128.66.0F.WIG C4 /r ib", Operands: "xmm, xmm, m, imm8"},
+	{Intel: "VPLZCNTD", Go: "VPLZCNTD", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 44 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPLZCNTD", Go: "VPLZCNTD", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 44 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPLZCNTD", Go: "VPLZCNTD", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 44 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPLZCNTD", Go: "VPLZCNTD", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 44 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPLZCNTQ", Go: "VPLZCNTQ", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 44 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPLZCNTQ", Go: "VPLZCNTQ", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 44 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPLZCNTQ", Go: "VPLZCNTQ", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 44 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPLZCNTQ", Go: "VPLZCNTQ", CPUID: "AVX512CD,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 44 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPMADDUBSW", Go: "VPMADDUBSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 04 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMADDUBSW", Go: "VPMADDUBSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 04 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMADDUBSW", Go: "VPMADDUBSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 04 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMADDUBSW", Go: "VPMADDUBSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 04 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMADDUBSW", Go: "VPMADDUBSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 04 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMADDUBSW", Go: "VPMADDUBSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 04 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMADDWD", Go: "VPMADDWD", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG F5 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMADDWD", Go: "VPMADDWD", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG F5 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMADDWD", Go: "VPMADDWD", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG F5 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMADDWD", Go: "VPMADDWD", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG F5 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMADDWD", Go: "VPMADDWD", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG F5 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMADDWD", Go: "VPMADDWD", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG F5 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMAXSB", Go: "VPMAXSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 3C /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMAXSB", Go: "VPMAXSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 3C /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMAXSB", Go: "VPMAXSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 3C /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMAXSB", Go: "VPMAXSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 3C /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMAXSB", Go: "VPMAXSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 3C /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMAXSB", Go: "VPMAXSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 3C /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMAXSD", Go: "VPMAXSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 3D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMAXSD", Go: "VPMAXSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 3D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMAXSD", Go: "VPMAXSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 3D /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMAXSD", Go: "VPMAXSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 3D /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMAXSQ", Go: "VPMAXSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 3D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMAXSQ", Go: "VPMAXSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 3D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMAXSQ", Go: "VPMAXSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 3D /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMAXSQ", Go: "VPMAXSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 3D /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMAXSW", Go: "VPMAXSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG EE /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMAXSW", Go: "VPMAXSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG EE /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMAXSW", Go: "VPMAXSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG EE /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMAXSW", Go: "VPMAXSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG EE /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMAXSW", Go: "VPMAXSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG EE /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMAXSW", Go: "VPMAXSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG EE /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMAXUB", Go: "VPMAXUB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG DE /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMAXUB", Go: "VPMAXUB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG DE /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMAXUB", Go: "VPMAXUB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG DE /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMAXUB", Go: "VPMAXUB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG DE /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMAXUB", Go: "VPMAXUB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG DE /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMAXUB", Go: "VPMAXUB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG DE /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMAXUD", Go: "VPMAXUD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 3F /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMAXUD", Go: "VPMAXUD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 3F /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMAXUD", Go: "VPMAXUD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 3F /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMAXUD", Go: "VPMAXUD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 3F /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMAXUQ", Go: "VPMAXUQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 3F /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMAXUQ", Go: "VPMAXUQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 3F /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMAXUQ", Go: "VPMAXUQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 3F /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMAXUQ", Go: "VPMAXUQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 3F /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMAXUW", Go: "VPMAXUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 3E /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMAXUW", Go: "VPMAXUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 3E /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMAXUW", Go: "VPMAXUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 3E /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMAXUW", Go: "VPMAXUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 3E /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMAXUW", Go: "VPMAXUW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 3E /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMAXUW", Go: "VPMAXUW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 3E /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMINSB", Go: "VPMINSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 38 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMINSB", Go: "VPMINSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 38 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMINSB", Go: "VPMINSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 38 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMINSB", Go: "VPMINSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 38 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMINSB", Go: "VPMINSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 38 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMINSB", Go: "VPMINSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 38 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMINSD", Go: "VPMINSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 39 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMINSD", Go: "VPMINSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 39 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMINSD", Go: "VPMINSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 39 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMINSD", Go: "VPMINSD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 39 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMINSQ", Go: "VPMINSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 39 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMINSQ", Go: "VPMINSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 39 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMINSQ", Go: "VPMINSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 39 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMINSQ", Go: "VPMINSQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 39 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMINSW", Go: "VPMINSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG EA /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMINSW", Go: "VPMINSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG EA /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMINSW", Go: "VPMINSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG EA /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMINSW", Go: "VPMINSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG EA /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMINSW", Go: "VPMINSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG EA /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMINSW", Go: "VPMINSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG EA /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMINUB", Go: "VPMINUB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG DA /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMINUB", Go: "VPMINUB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG DA /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMINUB", Go: "VPMINUB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG DA /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMINUB", Go: "VPMINUB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG DA /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMINUB", Go: "VPMINUB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG DA /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMINUB", Go: "VPMINUB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG DA /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMINUD", Go: "VPMINUD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 3B /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMINUD", Go: "VPMINUD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 3B /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMINUD", Go: "VPMINUD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 3B /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMINUD", Go: "VPMINUD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 3B /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMINUQ", Go: "VPMINUQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 3B /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMINUQ", Go: "VPMINUQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 3B /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMINUQ", Go: "VPMINUQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 3B /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMINUQ", Go: "VPMINUQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 3B /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMINUW", Go: "VPMINUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 3A /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMINUW", Go: "VPMINUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 3A /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMINUW", Go: "VPMINUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 3A /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMINUW", Go: "VPMINUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 3A /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMINUW", Go: "VPMINUW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 3A /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMINUW", Go: "VPMINUW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 3A /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMOVB2M", Go: "VPMOVB2M", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 29 /r", Operands: "k, xmm"},
+	{Intel: "VPMOVB2M", Go: "VPMOVB2M", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 29 /r", Operands: "k, ymm"},
+	{Intel: "VPMOVB2M", Go: "VPMOVB2M", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W0 29 /r", Operands: "k, zmm"},
+	{Intel: "VPMOVD2M", Go: "VPMOVD2M", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 39 /r", Operands: "k, xmm"},
+	{Intel: "VPMOVD2M", Go: "VPMOVD2M", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 39 /r", Operands: "k, ymm"},
+	{Intel: "VPMOVD2M", Go: "VPMOVD2M", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.F3.0F38.W0 39 /r", Operands: "k, zmm"},
+	{Intel: "VPMOVDB", Go: "VPMOVDB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 31 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVDB", Go: "VPMOVDB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 31 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVDB", Go: "VPMOVDB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 31 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVDB", Go: "VPMOVDB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 31 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVDW", Go: "VPMOVDW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 33 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVDW", Go: "VPMOVDW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 33 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVDW", Go: "VPMOVDW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 33 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVDW", Go: "VPMOVDW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 33 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVM2B", Go: "VPMOVM2B", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 28 /r", Operands: "xmm, k"},
+	{Intel: "VPMOVM2B", Go: "VPMOVM2B", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 28 /r", Operands: "ymm, k"},
+	{Intel: "VPMOVM2B", Go: "VPMOVM2B", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W0 28 /r", Operands: "zmm, k"},
+	{Intel: "VPMOVM2D", Go: "VPMOVM2D", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 38 /r", Operands: "xmm, k"},
+	{Intel: "VPMOVM2D", Go: "VPMOVM2D", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 38 /r", Operands: "ymm, k"},
+	{Intel: "VPMOVM2D", Go: "VPMOVM2D", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.F3.0F38.W0 38 /r", Operands: "zmm, k"},
+	{Intel: "VPMOVM2Q", Go: "VPMOVM2Q", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W1 38 /r", Operands: "xmm, k"},
+	{Intel: "VPMOVM2Q", Go: "VPMOVM2Q", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W1 38 /r", Operands: "ymm, k"},
+	{Intel: "VPMOVM2Q", Go: "VPMOVM2Q", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.F3.0F38.W1 38 /r", Operands: "zmm, k"},
+	{Intel: "VPMOVM2W", Go: "VPMOVM2W", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W1 28 /r", Operands: "xmm, k"},
+	{Intel: "VPMOVM2W", Go: "VPMOVM2W", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W1 28 /r", Operands: "ymm, k"},
+	{Intel: "VPMOVM2W", Go: "VPMOVM2W", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W1 28 /r", Operands: "zmm, k"},
+	{Intel: "VPMOVQ2M", Go: "VPMOVQ2M", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W1 39 /r", Operands: "k, xmm"},
+	{Intel: "VPMOVQ2M", Go: "VPMOVQ2M", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W1 39 /r", Operands: "k, ymm"},
+	{Intel: "VPMOVQ2M", Go: "VPMOVQ2M", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.F3.0F38.W1 39 /r", Operands: "k, zmm"},
+	{Intel: "VPMOVQB", Go: "VPMOVQB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 32 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVQB", Go: "VPMOVQB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 32 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVQB", Go: "VPMOVQB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 32 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVQB", Go: "VPMOVQB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 32 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVQD", Go: "VPMOVQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 35 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVQD", Go: "VPMOVQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 35 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVQD", Go: "VPMOVQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 35 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVQD", Go: "VPMOVQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 35 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVQW", Go: "VPMOVQW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 34 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVQW", Go: "VPMOVQW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 34 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVQW", Go: "VPMOVQW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 34 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVQW", Go: "VPMOVQW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 34 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVSDB", Go: "VPMOVSDB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 21 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVSDB", Go: "VPMOVSDB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 21 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVSDB", Go: "VPMOVSDB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 21 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVSDB", Go: "VPMOVSDB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 21 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVSDW", Go: "VPMOVSDW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 23 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVSDW", Go: "VPMOVSDW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 23 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVSDW", Go: "VPMOVSDW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 23 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVSDW", Go: "VPMOVSDW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 23 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVSQB", Go: "VPMOVSQB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 22 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVSQB", Go: "VPMOVSQB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 22 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVSQB", Go: "VPMOVSQB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 22 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVSQB", Go: "VPMOVSQB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 22 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVSQD", Go: "VPMOVSQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 25 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVSQD", Go: "VPMOVSQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 25 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVSQD", Go: "VPMOVSQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 25 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVSQD", Go: "VPMOVSQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 25 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVSQW", Go: "VPMOVSQW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 24 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVSQW", Go: "VPMOVSQW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 24 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVSQW", Go: "VPMOVSQW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 24 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVSQW", Go: "VPMOVSQW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 24 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVSWB", Go: "VPMOVSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 20 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVSWB", Go: "VPMOVSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 20 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVSWB", Go: "VPMOVSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 20 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVSWB", Go: "VPMOVSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 20 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVSWB", Go: "VPMOVSWB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W0 20 /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VPMOVSWB", Go: "VPMOVSWB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W0 20 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVSXBD", Go: "VPMOVSXBD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 21 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVSXBD", Go: "VPMOVSXBD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 21 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPMOVSXBD", Go: "VPMOVSXBD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 21 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPMOVSXBD", Go: "VPMOVSXBD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 21 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPMOVSXBQ", Go: "VPMOVSXBQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 22 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVSXBQ", Go: "VPMOVSXBQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 22 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPMOVSXBQ", Go: "VPMOVSXBQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 22 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPMOVSXBQ", Go: "VPMOVSXBQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 22 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPMOVSXBW", Go: "VPMOVSXBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 20 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVSXBW", Go: "VPMOVSXBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 20 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPMOVSXBW", Go: "VPMOVSXBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 20 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPMOVSXBW", Go: "VPMOVSXBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 20 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPMOVSXBW", Go: "VPMOVSXBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 20 /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VPMOVSXBW", Go: "VPMOVSXBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 20 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPMOVSXDQ", Go: "VPMOVSXDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 25 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVSXDQ", Go: "VPMOVSXDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 25 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPMOVSXDQ", Go: "VPMOVSXDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 25 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPMOVSXDQ", Go: "VPMOVSXDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 25 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPMOVSXWD", Go: "VPMOVSXWD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 23 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVSXWD", Go: "VPMOVSXWD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 23 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPMOVSXWD", Go: "VPMOVSXWD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 23 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPMOVSXWD", Go: "VPMOVSXWD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 23 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPMOVSXWQ", Go: "VPMOVSXWQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 24 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVSXWQ", Go: "VPMOVSXWQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 24 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPMOVSXWQ", Go: "VPMOVSXWQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 24 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPMOVSXWQ", Go: "VPMOVSXWQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 24 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPMOVUSDB", Go: "VPMOVUSDB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 11 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVUSDB", Go: "VPMOVUSDB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 11 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVUSDB", Go: "VPMOVUSDB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 11 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVUSDB", Go: "VPMOVUSDB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 11 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVUSDW", Go: "VPMOVUSDW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 13 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVUSDW", Go: "VPMOVUSDW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 13 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVUSDW", Go: "VPMOVUSDW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 13 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVUSDW", Go: "VPMOVUSDW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 13 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVUSQB", Go: "VPMOVUSQB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 12 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVUSQB", Go: "VPMOVUSQB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 12 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVUSQB", Go: "VPMOVUSQB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 12 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVUSQB", Go: "VPMOVUSQB", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 12 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVUSQD", Go: "VPMOVUSQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 15 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVUSQD", Go: "VPMOVUSQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 15 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVUSQD", Go: "VPMOVUSQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 15 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVUSQD", Go: "VPMOVUSQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 15 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVUSQW", Go: "VPMOVUSQW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 14 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVUSQW", Go: "VPMOVUSQW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 14 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVUSQW", Go: "VPMOVUSQW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 14 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVUSQW", Go: "VPMOVUSQW", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 14 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVUSWB", Go: "VPMOVUSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 10 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVUSWB", Go: "VPMOVUSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 10 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVUSWB", Go: "VPMOVUSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 10 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVUSWB", Go: "VPMOVUSWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 10 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVUSWB", Go: "VPMOVUSWB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W0 10 /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VPMOVUSWB", Go: "VPMOVUSWB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W0 10 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVW2M", Go: "VPMOVW2M", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W1 29 /r", Operands: "k, xmm"},
+	{Intel: "VPMOVW2M", Go: "VPMOVW2M", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W1 29 /r", Operands: "k, ymm"},
+	{Intel: "VPMOVW2M", Go: "VPMOVW2M", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W1 29 /r", Operands: "k, zmm"},
+	{Intel: "VPMOVWB", Go: "VPMOVWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 30 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVWB", Go: "VPMOVWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 30 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPMOVWB", Go: "VPMOVWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 30 /r", Operands: "xmm, {k}, ymm"},
+	{Intel: "VPMOVWB", Go: "VPMOVWB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 30 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPMOVWB", Go: "VPMOVWB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W0 30 /r", Operands: "ymm, {k}, zmm"},
+	{Intel: "VPMOVWB", Go: "VPMOVWB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W0 30 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPMOVZXBD", Go: "VPMOVZXBD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 31 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVZXBD", Go: "VPMOVZXBD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 31 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPMOVZXBD", Go: "VPMOVZXBD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 31 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPMOVZXBD", Go: "VPMOVZXBD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 31 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPMOVZXBQ", Go: "VPMOVZXBQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 32 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVZXBQ", Go: "VPMOVZXBQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 32 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPMOVZXBQ", Go: "VPMOVZXBQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 32 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPMOVZXBQ", Go: "VPMOVZXBQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 32 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPMOVZXBW", Go: "VPMOVZXBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 30 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVZXBW", Go: "VPMOVZXBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 30 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPMOVZXBW", Go: "VPMOVZXBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 30 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPMOVZXBW", Go: "VPMOVZXBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 30 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPMOVZXBW", Go: "VPMOVZXBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 30 /r", Operands: "zmm, {k}, ymm"},
+	{Intel: "VPMOVZXBW", Go: "VPMOVZXBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 30 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPMOVZXDQ", Go: "VPMOVZXDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 35 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVZXDQ", Go: "VPMOVZXDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 35 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPMOVZXDQ", Go: "VPMOVZXDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 35 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPMOVZXDQ", Go: "VPMOVZXDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 35 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPMOVZXWD", Go: "VPMOVZXWD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 33 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVZXWD", Go: "VPMOVZXWD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 33 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPMOVZXWD", Go: "VPMOVZXWD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 33 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPMOVZXWD", Go: "VPMOVZXWD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 33 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPMOVZXWQ", Go: "VPMOVZXWQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 34 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPMOVZXWQ", Go: "VPMOVZXWQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 34 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPMOVZXWQ", Go: "VPMOVZXWQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 34 /r", Operands: "ymm, {k}, xmm"},
+	{Intel: "VPMOVZXWQ", Go: "VPMOVZXWQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 34 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPMULDQ", Go: "VPMULDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 28 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMULDQ", Go: "VPMULDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 28 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMULDQ", Go: "VPMULDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 28 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMULDQ", Go: "VPMULDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 28 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMULHRSW", Go: "VPMULHRSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 0B /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMULHRSW", Go: "VPMULHRSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 0B /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMULHRSW", Go: "VPMULHRSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 0B /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMULHRSW", Go: "VPMULHRSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 0B /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMULHRSW", Go: "VPMULHRSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 0B /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMULHRSW", Go: "VPMULHRSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 0B /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMULHUW", Go: "VPMULHUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E4 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMULHUW", Go: "VPMULHUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E4 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMULHUW", Go: "VPMULHUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E4 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMULHUW", Go: "VPMULHUW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E4 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMULHUW", Go: "VPMULHUW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E4 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMULHUW", Go: "VPMULHUW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E4 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMULHW", Go: "VPMULHW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E5 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMULHW", Go: "VPMULHW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E5 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMULHW", Go: "VPMULHW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E5 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMULHW", Go: "VPMULHW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E5 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMULHW", Go: "VPMULHW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E5 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMULHW", Go: "VPMULHW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E5 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMULLD", Go: "VPMULLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 40 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMULLD", Go: "VPMULLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 40 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMULLD", Go: "VPMULLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 40 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMULLD", Go: "VPMULLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 40 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMULLQ", Go: "VPMULLQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 40 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMULLQ", Go: "VPMULLQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 40 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMULLQ", Go: "VPMULLQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 40 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMULLQ", Go: "VPMULLQ", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 40 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMULLQ", Go: "VPMULLQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F38.W1 40 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMULLQ", Go: "VPMULLQ", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F38.W1 40 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMULLW", Go: "VPMULLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG D5 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMULLW", Go: "VPMULLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG D5 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMULLW", Go: "VPMULLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG D5 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMULLW", Go: "VPMULLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG D5 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMULLW", Go: "VPMULLW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG D5 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMULLW", Go: "VPMULLW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG D5 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMULUDQ", Go: "VPMULUDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 F4 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMULUDQ", Go: "VPMULUDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 F4 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMULUDQ", Go: "VPMULUDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 F4 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMULUDQ", Go: "VPMULUDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 F4 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPORD", Go: "VPORD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 EB /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPORD", Go: "VPORD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 EB /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPORD", Go: "VPORD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 EB /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPORD", Go: "VPORD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 EB /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPORQ", Go: "VPORQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 EB /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPORQ", Go: "VPORQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 EB /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPORQ", Go: "VPORQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 EB /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPORQ", Go: "VPORQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 EB /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPROLD", Go: "VPROLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 72 /1 ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPROLD", Go: "VPROLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 72 /1 ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPROLD", Go: "VPROLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 72 /1 ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPROLD", Go: "VPROLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 72 /1 ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPROLQ", Go: "VPROLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 72 /1 ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPROLQ", Go: "VPROLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 72 /1 ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPROLQ", Go: "VPROLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 72 /1 ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPROLQ", Go: "VPROLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 72 /1 ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPROLVD", Go: "VPROLVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 15 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPROLVD", Go: "VPROLVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 15 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPROLVD", Go: "VPROLVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 15 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPROLVD", Go: "VPROLVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 15 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPROLVQ", Go: "VPROLVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 15 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPROLVQ", Go: "VPROLVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 15 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPROLVQ", Go: "VPROLVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 15 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPROLVQ", Go: "VPROLVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 15 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPRORD", Go: "VPRORD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 72 /0 ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPRORD", Go: "VPRORD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 72 /0 ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPRORD", Go: "VPRORD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 72 /0 ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPRORD", Go: "VPRORD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 72 /0 ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPRORQ", Go: "VPRORQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 72 /0 ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPRORQ", Go: "VPRORQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 72 /0 ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPRORQ", Go: "VPRORQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 72 /0 ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPRORQ", Go: "VPRORQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 72 /0 ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPRORVD", Go: "VPRORVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 14 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPRORVD", Go: "VPRORVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 14 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPRORVD", Go: "VPRORVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 14 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPRORVD", Go: "VPRORVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 14 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPRORVQ", Go: "VPRORVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 14 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPRORVQ", Go: "VPRORVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 14 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPRORVQ", Go: "VPRORVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 14 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPRORVQ", Go: "VPRORVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 14 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSADBW", Go: "VPSADBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG F6 /r", Operands: "xmm, xmm, xmm"},
+	{Intel: "VPSADBW", Go: "VPSADBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG F6 /r", Operands: "xmm, xmm, m"},
+	{Intel: "VPSADBW", Go: "VPSADBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG F6 /r", Operands: "ymm, ymm, ymm"},
+	{Intel: "VPSADBW", Go: "VPSADBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG F6 /r", Operands: "ymm, ymm, m"},
+	{Intel: "VPSADBW", Go: "VPSADBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG F6 /r", Operands: "zmm, zmm, zmm"},
+	{Intel: "VPSADBW", Go: "VPSADBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG F6 /r", Operands: "zmm, zmm, m"},
+	{Intel: "VPSCATTERDD", Go: "VPSCATTERDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 A0 /r", Operands: "m, xmm"},
+	{Intel: "VPSCATTERDD", Go: "VPSCATTERDD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 A0 /r", Operands: "m, ymm"},
+	{Intel: "VPSCATTERDQ", Go: "VPSCATTERDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 A0 /r", Operands: "m, xmm"},
+	{Intel: "VPSCATTERDQ", Go: "VPSCATTERDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 A0 /r", Operands: "m, ymm"},
+	{Intel: "VPSCATTERQD", Go: "VPSCATTERQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 A1 /r", Operands: "m, xmm"},
+	{Intel: "VPSCATTERQD", Go: "VPSCATTERQD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 A1 /r", Operands: "m, xmm"},
+	{Intel: "VPSCATTERQQ", Go: "VPSCATTERQQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 A1 /r", Operands: "m, xmm"},
+	{Intel: "VPSCATTERQQ", Go: "VPSCATTERQQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 A1 /r", Operands: "m, ymm"},
+	{Intel: "VPSHUFB", Go: "VPSHUFB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 00 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSHUFB", Go: "VPSHUFB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG 00 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSHUFB", Go: "VPSHUFB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 00 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSHUFB", Go: "VPSHUFB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG 00 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSHUFB", Go: "VPSHUFB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 00 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSHUFB", Go: "VPSHUFB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.WIG 00 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSHUFD", Go: "VPSHUFD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 70 /r ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPSHUFD", Go: "VPSHUFD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 70 /r ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPSHUFD", Go: "VPSHUFD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 70 /r ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPSHUFD", Go: "VPSHUFD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 70 /r ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPSHUFHW", Go: "VPSHUFHW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.WIG 70 /r ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPSHUFHW", Go: "VPSHUFHW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F.WIG 70 /r ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPSHUFHW", Go: "VPSHUFHW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.WIG 70 /r ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPSHUFHW", Go: "VPSHUFHW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F.WIG 70 /r ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPSHUFHW", Go: "VPSHUFHW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F.WIG 70 /r ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPSHUFHW", Go: "VPSHUFHW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F.WIG 70 /r ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPSHUFLW", Go: "VPSHUFLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.WIG 70 /r ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPSHUFLW", Go: "VPSHUFLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.0F.WIG 70 /r ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPSHUFLW", Go: "VPSHUFLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.WIG 70 /r ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPSHUFLW", Go: "VPSHUFLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.0F.WIG 70 /r ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPSHUFLW", Go: "VPSHUFLW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F2.0F.WIG 70 /r ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPSHUFLW", Go: "VPSHUFLW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F2.0F.WIG 70 /r ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 F2 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 F2 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 72 /6 ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 72 /6 ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 F2 /r", Operands: "ymm, {k}, ymm, xmm"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 F2 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 72 /6 ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPSLLD", Go: "VPSLLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 72 /6 ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPSLLDQ", Go: "VPSLLDQ", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 73 /7 ib", Operands: "xmm, xmm, imm8"},
+	{Intel: "VPSLLDQ", Go: "VPSLLDQ", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 73 /7 ib", Operands: "xmm, m, imm8"},
+	{Intel: "VPSLLDQ", Go: "VPSLLDQ", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 73 /7 ib", Operands: "ymm, ymm, imm8"},
+	{Intel: "VPSLLDQ", Go: "VPSLLDQ", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 73 /7 ib", Operands: "ymm, m, imm8"},
+	{Intel: "VPSLLDQ", Go: "VPSLLDQ", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 73 /7 ib", Operands: "zmm, zmm, imm8"},
+	{Intel: "VPSLLDQ", Go: "VPSLLDQ", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 73 /7 ib", Operands: "zmm, m, imm8"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 F3 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 F3 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 73 /6 ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 73 /6 ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 F3 /r", Operands: "ymm, {k}, ymm, xmm"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 F3 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 73 /6 ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPSLLQ", Go: "VPSLLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 73 /6 ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPSLLVD", Go: "VPSLLVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 47 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSLLVD", Go: "VPSLLVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 47 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSLLVD", Go: "VPSLLVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 47 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSLLVD", Go: "VPSLLVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 47 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSLLVQ", Go: "VPSLLVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 47 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSLLVQ", Go: "VPSLLVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 47 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSLLVQ", Go: "VPSLLVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 47 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSLLVQ", Go: "VPSLLVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 47 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSLLVW", Go: "VPSLLVW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 12 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSLLVW", Go: "VPSLLVW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 12 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSLLVW", Go: "VPSLLVW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 12 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSLLVW", Go: "VPSLLVW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 12 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSLLVW", Go: "VPSLLVW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 12 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSLLVW", Go: "VPSLLVW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 12 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG F1 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG F1 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 71 /6 ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 71 /6 ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG F1 /r", Operands: "ymm, {k}, ymm, xmm"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG F1 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 71 /6 ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 71 /6 ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG F1 /r", Operands: "zmm, {k}, zmm, xmm"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG F1 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 71 /6 ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPSLLW", Go: "VPSLLW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 71 /6 ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 E2 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 E2 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 72 /4 ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 72 /4 ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 E2 /r", Operands: "ymm, {k}, ymm, xmm"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 E2 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 72 /4 ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPSRAD", Go: "VPSRAD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 72 /4 ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPSRAQ", Go: "VPSRAQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 E2 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSRAQ", Go: "VPSRAQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 E2 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSRAQ", Go: "VPSRAQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 72 /4 ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPSRAQ", Go: "VPSRAQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 72 /4 ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPSRAQ", Go: "VPSRAQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 E2 /r", Operands: "ymm, {k}, ymm, xmm"},
+	{Intel: "VPSRAQ", Go: "VPSRAQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 E2 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSRAQ", Go: "VPSRAQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 72 /4 ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPSRAQ", Go: "VPSRAQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 72 /4 ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPSRAVD", Go: "VPSRAVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 46 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSRAVD", Go: "VPSRAVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 46 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSRAVD", Go: "VPSRAVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 46 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSRAVD", Go: "VPSRAVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 46 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSRAVQ", Go: "VPSRAVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 46 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSRAVQ", Go: "VPSRAVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 46 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSRAVQ", Go: "VPSRAVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 46 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSRAVQ", Go: "VPSRAVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 46 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSRAVW", Go: "VPSRAVW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 11 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSRAVW", Go: "VPSRAVW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 11 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSRAVW", Go: "VPSRAVW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 11 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSRAVW", Go: "VPSRAVW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 11 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSRAVW", Go: "VPSRAVW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 11 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSRAVW", Go: "VPSRAVW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 11 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E1 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E1 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 71 /4 ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 71 /4 ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E1 /r", Operands: "ymm, {k}, ymm, xmm"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E1 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 71 /4 ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 71 /4 ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E1 /r", Operands: "zmm, {k}, zmm, xmm"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E1 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 71 /4 ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPSRAW", Go: "VPSRAW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 71 /4 ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 D2 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 D2 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 72 /2 ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 72 /2 ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 D2 /r", Operands: "ymm, {k}, ymm, xmm"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 D2 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 72 /2 ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPSRLD", Go: "VPSRLD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 72 /2 ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPSRLDQ", Go: "VPSRLDQ", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 73 /3 ib", Operands: "xmm, xmm, imm8"},
+	{Intel: "VPSRLDQ", Go: "VPSRLDQ", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 73 /3 ib", Operands: "xmm, m, imm8"},
+	{Intel: "VPSRLDQ", Go: "VPSRLDQ", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 73 /3 ib", Operands: "ymm, ymm, imm8"},
+	{Intel: "VPSRLDQ", Go: "VPSRLDQ", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 73 /3 ib", Operands: "ymm, m, imm8"},
+	{Intel: "VPSRLDQ", Go: "VPSRLDQ", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 73 /3 ib", Operands: "zmm, zmm, imm8"},
+	{Intel: "VPSRLDQ", Go: "VPSRLDQ", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 73 /3 ib", Operands: "zmm, m, imm8"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 D3 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 D3 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 73 /2 ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 73 /2 ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 D3 /r", Operands: "ymm, {k}, ymm, xmm"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 D3 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 73 /2 ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPSRLQ", Go: "VPSRLQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 73 /2 ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPSRLVD", Go: "VPSRLVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 45 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSRLVD", Go: "VPSRLVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 45 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSRLVD", Go: "VPSRLVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 45 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSRLVD", Go: "VPSRLVD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 45 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSRLVQ", Go: "VPSRLVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 45 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSRLVQ", Go: "VPSRLVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 45 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSRLVQ", Go: "VPSRLVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 45 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSRLVQ", Go: "VPSRLVQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 45 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSRLVW", Go: "VPSRLVW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 10 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSRLVW", Go: "VPSRLVW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 10 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSRLVW", Go: "VPSRLVW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 10 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSRLVW", Go: "VPSRLVW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 10 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSRLVW", Go: "VPSRLVW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 10 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSRLVW", Go: "VPSRLVW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 10 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG D1 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG D1 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 71 /2 ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 71 /2 ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG D1 /r", Operands: "ymm, {k}, ymm, xmm"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG D1 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 71 /2 ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 71 /2 ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG D1 /r", Operands: "zmm, {k}, zmm, xmm"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG D1 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 71 /2 ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VPSRLW", Go: "VPSRLW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 71 /2 ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VPSUBB", Go: "VPSUBB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG F8 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSUBB", Go: "VPSUBB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG F8 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSUBB", Go: "VPSUBB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG F8 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSUBB", Go: "VPSUBB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG F8 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSUBB", Go: "VPSUBB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG F8 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSUBB", Go: "VPSUBB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG F8 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSUBD", Go: "VPSUBD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 FA /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSUBD", Go: "VPSUBD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 FA /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSUBD", Go: "VPSUBD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 FA /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSUBD", Go: "VPSUBD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 FA /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSUBQ", Go: "VPSUBQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 FB /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSUBQ", Go: "VPSUBQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 FB /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSUBQ", Go: "VPSUBQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 FB /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSUBQ", Go: "VPSUBQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 FB /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSUBSB", Go: "VPSUBSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E8 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSUBSB", Go: "VPSUBSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E8 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSUBSB", Go: "VPSUBSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E8 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSUBSB", Go: "VPSUBSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E8 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSUBSB", Go: "VPSUBSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E8 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSUBSB", Go: "VPSUBSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E8 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSUBSW", Go: "VPSUBSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E9 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSUBSW", Go: "VPSUBSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG E9 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSUBSW", Go: "VPSUBSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E9 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSUBSW", Go: "VPSUBSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG E9 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSUBSW", Go: "VPSUBSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E9 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSUBSW", Go: "VPSUBSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG E9 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSUBUSB", Go: "VPSUBUSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG D8 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSUBUSB", Go: "VPSUBUSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG D8 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSUBUSB", Go: "VPSUBUSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG D8 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSUBUSB", Go: "VPSUBUSB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG D8 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSUBUSB", Go: "VPSUBUSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG D8 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSUBUSB", Go: "VPSUBUSB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG D8 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSUBUSW", Go: "VPSUBUSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG D9 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSUBUSW", Go: "VPSUBUSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG D9 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSUBUSW", Go: "VPSUBUSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG D9 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSUBUSW", Go: "VPSUBUSW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG D9 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSUBUSW", Go: "VPSUBUSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG D9 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSUBUSW", Go: "VPSUBUSW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG D9 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSUBW", Go: "VPSUBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG F9 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSUBW", Go: "VPSUBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG F9 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSUBW", Go: "VPSUBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG F9 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSUBW", Go: "VPSUBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG F9 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSUBW", Go: "VPSUBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG F9 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSUBW", Go: "VPSUBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG F9 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPTERNLOGD", Go: "VPTERNLOGD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 25 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VPTERNLOGD", Go: "VPTERNLOGD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 25 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VPTERNLOGD", Go: "VPTERNLOGD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 25 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VPTERNLOGD", Go: "VPTERNLOGD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 25 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VPTERNLOGQ", Go: "VPTERNLOGQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 25 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VPTERNLOGQ", Go: "VPTERNLOGQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 25 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VPTERNLOGQ", Go: "VPTERNLOGQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 25 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VPTERNLOGQ", Go: "VPTERNLOGQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 25 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VPTESTMB", Go: "VPTESTMB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 26 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPTESTMB", Go: "VPTESTMB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 26 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPTESTMB", Go: "VPTESTMB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 26 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPTESTMB", Go: "VPTESTMB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 26 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPTESTMB", Go: "VPTESTMB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W0 26 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPTESTMB", Go: "VPTESTMB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W0 26 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPTESTMD", Go: "VPTESTMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 27 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPTESTMD", Go: "VPTESTMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 27 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPTESTMD", Go: "VPTESTMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 27 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPTESTMD", Go: "VPTESTMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 27 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPTESTMQ", Go: "VPTESTMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 27 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPTESTMQ", Go: "VPTESTMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 27 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPTESTMQ", Go: "VPTESTMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 27 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPTESTMQ", Go: "VPTESTMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 27 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPTESTMW", Go: "VPTESTMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 26 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPTESTMW", Go: "VPTESTMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 26 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPTESTMW", Go: "VPTESTMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 26 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPTESTMW", Go: "VPTESTMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 26 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPTESTMW", Go: "VPTESTMW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 26 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPTESTMW", Go: "VPTESTMW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F38.W1 26 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPTESTNMB", Go: "VPTESTNMB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 26 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPTESTNMB", Go: "VPTESTNMB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 26 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPTESTNMB", Go: "VPTESTNMB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 26 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPTESTNMB", Go: "VPTESTNMB", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 26 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPTESTNMB", Go: "VPTESTNMB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W0 26 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPTESTNMB", Go: "VPTESTNMB", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W0 26 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPTESTNMD", Go: "VPTESTNMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 27 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPTESTNMD", Go: "VPTESTNMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W0 27 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPTESTNMD", Go: "VPTESTNMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 27 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPTESTNMD", Go: "VPTESTNMD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W0 27 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPTESTNMQ", Go: "VPTESTNMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W1 27 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPTESTNMQ", Go: "VPTESTNMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W1 27 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPTESTNMQ", Go: "VPTESTNMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W1 27 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPTESTNMQ", Go: "VPTESTNMQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W1 27 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPTESTNMW", Go: "VPTESTNMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W1 26 /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPTESTNMW", Go: "VPTESTNMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.0F38.W1 26 /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPTESTNMW", Go: "VPTESTNMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W1 26 /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPTESTNMW", Go: "VPTESTNMW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.0F38.W1 26 /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPTESTNMW", Go: "VPTESTNMW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W1 26 /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPTESTNMW", Go: "VPTESTNMW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.F3.0F38.W1 26 /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPUNPCKHBW", Go: "VPUNPCKHBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 68 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPUNPCKHBW", Go: "VPUNPCKHBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 68 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPUNPCKHBW", Go: "VPUNPCKHBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 68 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPUNPCKHBW", Go: "VPUNPCKHBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 68 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPUNPCKHBW", Go: "VPUNPCKHBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 68 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPUNPCKHBW", Go: "VPUNPCKHBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 68 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPUNPCKHDQ", Go: "VPUNPCKHDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 6A /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPUNPCKHDQ", Go: "VPUNPCKHDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 6A /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPUNPCKHDQ", Go: "VPUNPCKHDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 6A /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPUNPCKHDQ", Go: "VPUNPCKHDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 6A /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPUNPCKHQDQ", Go: "VPUNPCKHQDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 6D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPUNPCKHQDQ", Go: "VPUNPCKHQDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 6D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPUNPCKHQDQ", Go: "VPUNPCKHQDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 6D /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPUNPCKHQDQ", Go: "VPUNPCKHQDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 6D /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPUNPCKHWD", Go: "VPUNPCKHWD", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 69 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPUNPCKHWD", Go: "VPUNPCKHWD", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 69 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPUNPCKHWD", Go: "VPUNPCKHWD", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 69 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPUNPCKHWD", Go: "VPUNPCKHWD", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 69 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPUNPCKHWD", Go: "VPUNPCKHWD", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 69 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPUNPCKHWD", Go: "VPUNPCKHWD", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 69 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPUNPCKLBW", Go: "VPUNPCKLBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 60 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPUNPCKLBW", Go: "VPUNPCKLBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 60 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPUNPCKLBW", Go: "VPUNPCKLBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 60 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPUNPCKLBW", Go: "VPUNPCKLBW", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 60 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPUNPCKLBW", Go: "VPUNPCKLBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 60 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPUNPCKLBW", Go: "VPUNPCKLBW", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 60 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPUNPCKLDQ", Go: "VPUNPCKLDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 62 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPUNPCKLDQ", Go: "VPUNPCKLDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 62 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPUNPCKLDQ", Go: "VPUNPCKLDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 62 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPUNPCKLDQ", Go: "VPUNPCKLDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 62 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPUNPCKLQDQ", Go: "VPUNPCKLQDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 6C /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPUNPCKLQDQ", Go: "VPUNPCKLQDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 6C /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPUNPCKLQDQ", Go: "VPUNPCKLQDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 6C /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPUNPCKLQDQ", Go: "VPUNPCKLQDQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 6C /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPUNPCKLWD", Go: "VPUNPCKLWD", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 61 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPUNPCKLWD", Go: "VPUNPCKLWD", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.WIG 61 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPUNPCKLWD", Go: "VPUNPCKLWD", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 61 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPUNPCKLWD", Go: "VPUNPCKLWD", CPUID: "AVX512BW,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.WIG 61 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPUNPCKLWD", Go: "VPUNPCKLWD", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 61 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPUNPCKLWD", Go: "VPUNPCKLWD", CPUID: "AVX512BW,AVX512F", Encoding: "EVEX.512.66.0F.WIG 61 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPXORD", Go: "VPXORD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 EF /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPXORD", Go: "VPXORD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W0 EF /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPXORD", Go: "VPXORD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 EF /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPXORD", Go: "VPXORD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W0 EF /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPXORQ", Go: "VPXORQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 EF /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPXORQ", Go: "VPXORQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 EF /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPXORQ", Go: "VPXORQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 EF /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPXORQ", Go: "VPXORQ", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 EF /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VRANGEPD", Go: "VRANGEPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 50 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VRANGEPD", Go: "VRANGEPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 50 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VRANGEPD", Go: "VRANGEPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 50 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VRANGEPD", Go: "VRANGEPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 50 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VRANGEPD", Go: "VRANGEPD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 50 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VRANGEPD", Go: "VRANGEPD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 50 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VRANGEPS", Go: "VRANGEPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 50 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VRANGEPS", Go: "VRANGEPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 50 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VRANGEPS", Go: "VRANGEPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 50 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VRANGEPS", Go: "VRANGEPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 50 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VRANGEPS", Go: "VRANGEPS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 50 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VRANGEPS", Go: "VRANGEPS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 50 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VRANGESD", Go: "VRANGESD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.LIG.66.0F3A.W1 51 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VRANGESD", Go: "VRANGESD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.LIG.66.0F3A.W1 51 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VRANGESS", Go: "VRANGESS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.LIG.66.0F3A.W0 51 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VRANGESS", Go: "VRANGESS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.LIG.66.0F3A.W0 51 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VRCP14PD", Go: "VRCP14PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 4C /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VRCP14PD", Go: "VRCP14PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 4C /r", Operands: "xmm, {k}, m"},
+	{Intel: "VRCP14PD", Go: "VRCP14PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 4C /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VRCP14PD", Go: "VRCP14PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 4C /r", Operands: "ymm, {k}, m"},
+	{Intel: "VRCP14PS", Go: "VRCP14PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 4C /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VRCP14PS", Go: "VRCP14PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 4C /r", Operands: "xmm, {k}, m"},
+	{Intel: "VRCP14PS", Go: "VRCP14PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 4C /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VRCP14PS", Go: "VRCP14PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 4C /r", Operands: "ymm, {k}, m"},
+	{Intel: "VREDUCEPD", Go: "VREDUCEPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 56 /r ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VREDUCEPD", Go: "VREDUCEPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 56 /r ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VREDUCEPD", Go: "VREDUCEPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 56 /r ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VREDUCEPD", Go: "VREDUCEPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 56 /r ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VREDUCEPD", Go: "VREDUCEPD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 56 /r ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VREDUCEPD", Go: "VREDUCEPD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 56 /r ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VREDUCEPS", Go: "VREDUCEPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 56 /r ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VREDUCEPS", Go: "VREDUCEPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 56 /r ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VREDUCEPS", Go: "VREDUCEPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 56 /r ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VREDUCEPS", Go: "VREDUCEPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 56 /r ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VREDUCEPS", Go: "VREDUCEPS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 56 /r ib", Operands: "zmm, {k}, zmm, imm8"},
+	{Intel: "VREDUCEPS", Go: "VREDUCEPS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 56 /r ib", Operands: "zmm, {k}, m, imm8"},
+	{Intel: "VREDUCESD", Go: "VREDUCESD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.LIG.66.0F3A.W1 57 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VREDUCESD", Go: "VREDUCESD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.LIG.66.0F3A.W1 57 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VREDUCESS", Go: "VREDUCESS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.LIG.66.0F3A.W0 57 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VREDUCESS", Go: "VREDUCESS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.LIG.66.0F3A.W0 57 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VRNDSCALEPD", Go: "VRNDSCALEPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 09 /r ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VRNDSCALEPD", Go: "VRNDSCALEPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 09 /r ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VRNDSCALEPD", Go: "VRNDSCALEPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 09 /r ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VRNDSCALEPD", Go: "VRNDSCALEPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 09 /r ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VRNDSCALEPS", Go: "VRNDSCALEPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 08 /r ib", Operands: "xmm, {k}, xmm, imm8"},
+	{Intel: "VRNDSCALEPS", Go: "VRNDSCALEPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 08 /r ib", Operands: "xmm, {k}, m, imm8"},
+	{Intel: "VRNDSCALEPS", Go: "VRNDSCALEPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 08 /r ib", Operands: "ymm, {k}, ymm, imm8"},
+	{Intel: "VRNDSCALEPS", Go: "VRNDSCALEPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 08 /r ib", Operands: "ymm, {k}, m, imm8"},
+	{Intel: "VRSQRT14PD", Go: "VRSQRT14PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 4E /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VRSQRT14PD", Go: "VRSQRT14PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 4E /r", Operands: "xmm, {k}, m"},
+	{Intel: "VRSQRT14PD", Go: "VRSQRT14PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 4E /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VRSQRT14PD", Go: "VRSQRT14PD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 4E /r", Operands: "ymm, {k}, m"},
+	{Intel: "VRSQRT14PS", Go: "VRSQRT14PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 4E /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VRSQRT14PS", Go: "VRSQRT14PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 4E /r", Operands: "xmm, {k}, m"},
+	{Intel: "VRSQRT14PS", Go: "VRSQRT14PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 4E /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VRSQRT14PS", Go: "VRSQRT14PS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 4E /r", Operands: "ymm, {k}, m"},
+	{Intel: "VSCALEFPD", Go: "VSCALEFPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 2C /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VSCALEFPD", Go: "VSCALEFPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 2C /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VSCALEFPD", Go: "VSCALEFPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 2C /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VSCALEFPD", Go: "VSCALEFPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 2C /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VSCALEFPS", Go: "VSCALEFPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 2C /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VSCALEFPS", Go: "VSCALEFPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 2C /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VSCALEFPS", Go: "VSCALEFPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 2C /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VSCALEFPS", Go: "VSCALEFPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 2C /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VSCATTERDPD", Go: "VSCATTERDPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 A2 /r", Operands: "m, xmm"},
+	{Intel: "VSCATTERDPD", Go: "VSCATTERDPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 A2 /r", Operands: "m, ymm"},
+	{Intel: "VSCATTERDPS", Go: "VSCATTERDPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 A2 /r", Operands: "m, xmm"},
+	{Intel: "VSCATTERDPS", Go: "VSCATTERDPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 A2 /r", Operands: "m, ymm"},
+	{Intel: "VSCATTERQPD", Go: "VSCATTERQPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 A3 /r", Operands: "m, xmm"},
+	{Intel: "VSCATTERQPD", Go: "VSCATTERQPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 A3 /r", Operands: "m, ymm"},
+	{Intel: "VSCATTERQPS", Go: "VSCATTERQPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 A3 /r", Operands: "m, xmm"},
+	{Intel: "VSCATTERQPS", Go: "VSCATTERQPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 A3 /r", Operands: "m, xmm"},
+	{Intel: "VSHUFF32X4", Go: "VSHUFF32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 23 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VSHUFF32X4", Go: "VSHUFF32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 23 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VSHUFF64X2", Go: "VSHUFF64X2", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 23 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VSHUFF64X2", Go: "VSHUFF64X2", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 23 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VSHUFI32X4", Go: "VSHUFI32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 43 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VSHUFI32X4", Go: "VSHUFI32X4", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 43 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VSHUFI64X2", Go: "VSHUFI64X2", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 43 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VSHUFI64X2", Go: "VSHUFI64X2", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 43 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VSHUFPD", Go: "VSHUFPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 C6 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VSHUFPD", Go: "VSHUFPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 C6 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VSHUFPD", Go: "VSHUFPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 C6 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VSHUFPD", Go: "VSHUFPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 C6 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VSHUFPS", Go: "VSHUFPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 C6 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VSHUFPS", Go: "VSHUFPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 C6 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VSHUFPS", Go: "VSHUFPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 C6 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VSHUFPS", Go: "VSHUFPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 C6 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VSQRTPD", Go: "VSQRTPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 51 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VSQRTPD", Go: "VSQRTPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 51 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VSQRTPD", Go: "VSQRTPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 51 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VSQRTPD", Go: "VSQRTPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 51 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VSQRTPS", Go: "VSQRTPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 51 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VSQRTPS", Go: "VSQRTPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 51 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VSQRTPS", Go: "VSQRTPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 51 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VSQRTPS", Go: "VSQRTPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 51 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VSUBPD", Go: "VSUBPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 5C /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VSUBPD", Go: "VSUBPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 5C /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VSUBPD", Go: "VSUBPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 5C /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VSUBPD", Go: "VSUBPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 5C /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VSUBPS", Go: "VSUBPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 5C /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VSUBPS", Go: "VSUBPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 5C /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VSUBPS", Go: "VSUBPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 5C /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VSUBPS", Go: "VSUBPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 5C /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VUNPCKHPD", Go: "VUNPCKHPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 15 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VUNPCKHPD", Go: "VUNPCKHPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 15 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VUNPCKHPD", Go: "VUNPCKHPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 15 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VUNPCKHPD", Go: "VUNPCKHPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 15 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VUNPCKHPS", Go: "VUNPCKHPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 15 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VUNPCKHPS", Go: "VUNPCKHPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 15 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VUNPCKHPS", Go: "VUNPCKHPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 15 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VUNPCKHPS", Go: "VUNPCKHPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 15 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VUNPCKLPD", Go: "VUNPCKLPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 14 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VUNPCKLPD", Go: "VUNPCKLPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 14 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VUNPCKLPD", Go: "VUNPCKLPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 14 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VUNPCKLPD", Go: "VUNPCKLPD", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 14 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VUNPCKLPS", Go: "VUNPCKLPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 14 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VUNPCKLPS", Go: "VUNPCKLPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 14 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VUNPCKLPS", Go: "VUNPCKLPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 14 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VUNPCKLPS", Go: "VUNPCKLPS", CPUID: "AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 14 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VXORPD", Go: "VXORPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 57 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VXORPD", Go: "VXORPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F.W1 57 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VXORPD", Go: "VXORPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 57 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VXORPD", Go: "VXORPD", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F.W1 57 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VXORPD", Go: "VXORPD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 57 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VXORPD", Go: "VXORPD", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.66.0F.W1 57 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VXORPS", Go: "VXORPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 57 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VXORPS", Go: "VXORPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.128.0F.W0 57 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VXORPS", Go: "VXORPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 57 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VXORPS", Go: "VXORPS", CPUID: "AVX512DQ,AVX512F,AVX512VL", Encoding: "EVEX.256.0F.W0 57 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VXORPS", Go: "VXORPS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.0F.W0 57 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VXORPS", Go: "VXORPS", CPUID: "AVX512DQ,AVX512F", Encoding: "EVEX.512.0F.W0 57 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "KADDB", Go: "KADDB", CPUID: "AVX512DQ", Encoding: "VEX.256.66.0F.W0 4A /r", Operands: "k, k, k"},
+	{Intel: "KADDD", Go: "KADDD", CPUID: "AVX512BW", Encoding: "VEX.256.66.0F.W1 4A /r", Operands: "k, k, k"},
+	{Intel: "KADDQ", Go: "KADDQ", CPUID: "AVX512BW", Encoding: "VEX.256.0F.W1 4A /r", Operands: "k, k, k"},
+	{Intel: "KADDW", Go: "KADDW", CPUID: "AVX512DQ", Encoding: "VEX.256.0F.W0 4A /r", Operands: "k, k, k"},
+	{Intel: "KANDB", Go: "KANDB", CPUID: "AVX512DQ", Encoding: "VEX.256.66.0F.W0 41 /r", Operands: "k, k, k"},
+	{Intel: "KANDD", Go: "KANDD", CPUID: "AVX512BW", Encoding: "VEX.256.66.0F.W1 41 /r", Operands: "k, k, k"},
+	{Intel: "KANDNB", Go: "KANDNB", CPUID: "AVX512DQ", Encoding: "VEX.256.66.0F.W0 42 /r", Operands: "k, k, k"},
+	{Intel: "KANDND", Go: "KANDND", CPUID: "AVX512BW", Encoding: "VEX.256.66.0F.W1 42 /r", Operands: "k, k, k"},
+	{Intel: "KANDNQ", Go: "KANDNQ", CPUID: "AVX512BW", Encoding: "VEX.256.0F.W1 42 /r", Operands: "k, k, k"},
+	{Intel: "KANDQ", Go: "KANDQ", CPUID: "AVX512BW", Encoding: "VEX.256.0F.W1 41 /r", Operands: "k, k, k"},
+	{Intel: "KMOVB", Go: "KMOVB", CPUID: "AVX512DQ", Encoding: "VEX.128.66.0F.W0 90 /r", Operands: "k, k"},
+	{Intel: "KMOVB", Go: "KMOVB", CPUID: "AVX512DQ", Encoding: "VEX.128.66.0F.W0 90 /r", Operands: "k, m"},
+	{Intel: "KMOVB", Go: "KMOVB", CPUID: "AVX512DQ", Encoding: "VEX.128.66.0F.W0 91 /r", Operands: "m, k"},
+	{Intel: "KMOVB", Go: "KMOVB", CPUID: "AVX512DQ", Encoding: "VEX.128.66.0F.W0 92 /r", Operands: "k, r32"},
+	{Intel: "KMOVB", Go: "KMOVB", CPUID: "AVX512DQ", Encoding: "VEX.128.66.0F.W0 93 /r", Operands: "r32, k"},
+	{Intel: "KMOVD", Go: "KMOVD", CPUID: "AVX512BW", Encoding: "VEX.128.66.0F.W1 90 /r", Operands: "k, k"},
+	{Intel: "KMOVD", Go: "KMOVD", CPUID: "AVX512BW", Encoding: "VEX.128.66.0F.W1 90 /r", Operands: "k, m"},
+	{Intel: "KMOVD", Go: "KMOVD", CPUID: "AVX512BW", Encoding: "VEX.128.66.0F.W1 91 /r", Operands: "m, k"},
+	{Intel: "KMOVD", Go: "KMOVD", CPUID: "AVX512BW", Encoding: "VEX.128.F2.0F.W0 92 /r", Operands: "k, r32"},
+	{Intel: "KMOVD", Go: "KMOVD", CPUID: "AVX512BW", Encoding: "VEX.128.F2.0F.WIG 92 /r", Operands: "k, r32"},
+	{Intel: "KMOVD", Go: "KMOVD", CPUID: "AVX512BW", Encoding: "VEX.128.F2.0F.W0 93 /r", Operands: "r32, k"},
+	{Intel: "KMOVD", Go: "KMOVD", CPUID: "AVX512BW", Encoding: "VEX.128.F2.0F.WIG 93 /r", Operands: "r32, k"},
+	{Intel: "KMOVQ", Go: "KMOVQ", CPUID: "AVX512BW", Encoding: "VEX.128.0F.W1 90 /r", Operands: "k, k"},
+	{Intel: "KMOVQ", Go: "KMOVQ", CPUID: "AVX512BW", Encoding: "VEX.128.0F.W1 90 /r", Operands: "k, m"},
+	{Intel: "KMOVQ", Go: "KMOVQ", CPUID: "AVX512BW", Encoding: "VEX.128.0F.W1 91 /r", Operands: "m, k"},
+	{Intel: "KMOVQ", Go: "KMOVQ", CPUID: "AVX512BW", Encoding: "VEX.128.F2.0F.W1 92 /r", Operands: "k, r64"},
+	{Intel: "KMOVQ", Go: "KMOVQ", CPUID: "AVX512BW", Encoding: "VEX.128.F2.0F.W1 93 /r", Operands: "r64, k"},
+	{Intel: "KNOTB", Go: "KNOTB", CPUID: "AVX512DQ", Encoding: "VEX.128.66.0F.W0 44 /r", Operands: "k, k"},
+	{Intel: "KNOTD", Go: "KNOTD", CPUID: "AVX512BW", Encoding: "VEX.128.66.0F.W1 44 /r", Operands: "k, k"},
+	{Intel: "KNOTQ", Go: "KNOTQ", CPUID: "AVX512BW", Encoding: "VEX.128.0F.W1 44 /r", Operands: "k, k"},
+	{Intel: "KORB", Go: "KORB", CPUID: "AVX512DQ", Encoding: "VEX.256.66.0F.W0 45 /r", Operands: "k, k, k"},
+	{Intel: "KORD", Go: "KORD", CPUID: "AVX512BW", Encoding: "VEX.256.66.0F.W1 45 /r", Operands: "k, k, k"},
+	{Intel: "KORQ", Go: "KORQ", CPUID: "AVX512BW", Encoding: "VEX.256.0F.W1 45 /r", Operands: "k, k, k"},
+	{Intel: "KORTESTB", Go: "KORTESTB", CPUID: "AVX512DQ", Encoding: "VEX.128.66.0F.W0 98 /r", Operands: "k, k"},
+	{Intel: "KORTESTD", Go: "KORTESTD", CPUID: "AVX512BW", Encoding: "VEX.128.66.0F.W1 98 /r", Operands: "k, k"},
+	{Intel: "KORTESTQ", Go: "KORTESTQ", CPUID: "AVX512BW", Encoding: "VEX.128.0F.W1 98 /r", Operands: "k, k"},
+	{Intel: "KSHIFTLB", Go: "KSHIFTLB", CPUID: "AVX512DQ", Encoding: "VEX.128.66.0F3A.W0 32 /r ib", Operands: "k, k, imm8"},
+	{Intel: "KSHIFTLD", Go: "KSHIFTLD", CPUID: "AVX512BW", Encoding: "VEX.128.66.0F3A.W0 33 /r ib", Operands: "k, k, imm8"},
+	{Intel: "KSHIFTLQ", Go: "KSHIFTLQ", CPUID: "AVX512BW", Encoding: "VEX.128.66.0F3A.W1 33 /r ib", Operands: "k, k, imm8"},
+	{Intel: "KSHIFTRB", Go: "KSHIFTRB", CPUID: "AVX512DQ", Encoding: "VEX.128.66.0F3A.W0 30 /r ib", Operands: "k, k, imm8"},
+	{Intel: "KSHIFTRD", Go: "KSHIFTRD", CPUID: "AVX512BW", Encoding: "VEX.128.66.0F3A.W0 31 /r ib", Operands: "k, k, imm8"},
+	{Intel: "KSHIFTRQ", Go: "KSHIFTRQ", CPUID: "AVX512BW", Encoding: "VEX.128.66.0F3A.W1 31 /r ib", Operands: "k, k, imm8"},
+	{Intel: "KTESTB", Go: "KTESTB", CPUID: "AVX512DQ", Encoding: "VEX.128.66.0F.W0 99 /r", Operands: "k, k"},
+	{Intel: "KTESTD", Go: "KTESTD", CPUID: "AVX512BW", Encoding: "VEX.128.66.0F.W1 99 /r", Operands: "k, k"},
+	{Intel: "KTESTQ", Go: "KTESTQ", CPUID: "AVX512BW", Encoding: "VEX.128.0F.W1 99 /r", Operands: "k, k"},
+	{Intel: "KTESTW", Go: "KTESTW", CPUID: "AVX512DQ", Encoding: "VEX.128.0F.W0 99 /r", Operands: "k, k"},
+	{Intel: "KUNPCKDQ", Go: "KUNPCKDQ", CPUID: "AVX512BW", Encoding: "VEX.256.0F.W1 4B /r", Operands: "k, k, k"},
+	{Intel: "KUNPCKWD", Go: "KUNPCKWD", CPUID: "AVX512BW", Encoding: "VEX.256.0F.W0 4B /r", Operands: "k, k, k"},
+	{Intel: "KXNORB", Go: "KXNORB", CPUID: "AVX512DQ", Encoding: "VEX.256.66.0F.W0 46 /r", Operands: "k, k, k"},
+	{Intel: "KXNORD", Go: "KXNORD", CPUID: "AVX512BW", Encoding: "VEX.256.66.0F.W1 46 /r", Operands: "k, k, k"},
+	{Intel: "KXNORQ", Go: "KXNORQ", CPUID: "AVX512BW", Encoding: "VEX.256.0F.W1 46 /r", Operands: "k, k, k"},
+	{Intel: "KXORB", Go: "KXORB", CPUID: "AVX512DQ", Encoding: "VEX.256.66.0F.W0 47 /r", Operands: "k, k, k"},
+	{Intel: "KXORD", Go: "KXORD", CPUID: "AVX512BW", Encoding: "VEX.256.66.0F.W1 47 /r", Operands: "k, k, k"},
+	{Intel: "KXORQ", Go: "KXORQ", CPUID: "AVX512BW", Encoding: "VEX.256.0F.W1 47 /r", Operands: "k, k, k"},
+	{Intel: "VPMADD52HUQ", Go: "VPMADD52HUQ", CPUID: "AVX512IFMA,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 B5 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMADD52HUQ", Go: "VPMADD52HUQ", CPUID: "AVX512IFMA,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 B5 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMADD52HUQ", Go: "VPMADD52HUQ", CPUID: "AVX512IFMA,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 B5 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMADD52HUQ", Go: "VPMADD52HUQ", CPUID: "AVX512IFMA,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 B5 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMADD52HUQ", Go: "VPMADD52HUQ", CPUID: "AVX512IFMA,AVX512F", Encoding: "EVEX.512.66.0F38.W1 B5 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMADD52HUQ", Go: "VPMADD52HUQ", CPUID: "AVX512IFMA,AVX512F", Encoding: "EVEX.512.66.0F38.W1 B5 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMADD52LUQ", Go: "VPMADD52LUQ", CPUID: "AVX512IFMA,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 B4 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMADD52LUQ", Go: "VPMADD52LUQ", CPUID: "AVX512IFMA,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 B4 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMADD52LUQ", Go: "VPMADD52LUQ", CPUID: "AVX512IFMA,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 B4 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMADD52LUQ", Go: "VPMADD52LUQ", CPUID: "AVX512IFMA,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 B4 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMADD52LUQ", Go: "VPMADD52LUQ", CPUID: "AVX512IFMA,AVX512F", Encoding: "EVEX.512.66.0F38.W1 B4 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMADD52LUQ", Go: "VPMADD52LUQ", CPUID: "AVX512IFMA,AVX512F", Encoding: "EVEX.512.66.0F38.W1 B4 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMB", Go: "VPERMB", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 8D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMB", Go: "VPERMB", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 8D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMB", Go: "VPERMB", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 8D /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMB", Go: "VPERMB", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 8D /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMB", Go: "VPERMB", CPUID: "AVX512VBMI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 8D /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMB", Go: "VPERMB", CPUID: "AVX512VBMI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 8D /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMI2B", Go: "VPERMI2B", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 75 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMI2B", Go: "VPERMI2B", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 75 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMI2B", Go: "VPERMI2B", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 75 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMI2B", Go: "VPERMI2B", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 75 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMI2B", Go: "VPERMI2B", CPUID: "AVX512VBMI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 75 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMI2B", Go: "VPERMI2B", CPUID: "AVX512VBMI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 75 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPERMT2B", Go: "VPERMT2B", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 7D /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPERMT2B", Go: "VPERMT2B", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 7D /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPERMT2B", Go: "VPERMT2B", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 7D /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPERMT2B", Go: "VPERMT2B", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 7D /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPERMT2B", Go: "VPERMT2B", CPUID: "AVX512VBMI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 7D /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPERMT2B", Go: "VPERMT2B", CPUID: "AVX512VBMI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 7D /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPMULTISHIFTQB", Go: "VPMULTISHIFTQB", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 83 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPMULTISHIFTQB", Go: "VPMULTISHIFTQB", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 83 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPMULTISHIFTQB", Go: "VPMULTISHIFTQB", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 83 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPMULTISHIFTQB", Go: "VPMULTISHIFTQB", CPUID: "AVX512VBMI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 83 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPMULTISHIFTQB", Go: "VPMULTISHIFTQB", CPUID: "AVX512VBMI,AVX512F", Encoding: "EVEX.512.66.0F38.W1 83 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPMULTISHIFTQB", Go: "VPMULTISHIFTQB", CPUID: "AVX512VBMI,AVX512F", Encoding: "EVEX.512.66.0F38.W1 83 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPOPCNTB", Go: "VPOPCNTB", CPUID: "AVX512BITALG,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 54 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPOPCNTB", Go: "VPOPCNTB", CPUID: "AVX512BITALG,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 54 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPOPCNTB", Go: "VPOPCNTB", CPUID: "AVX512BITALG,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 54 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPOPCNTB", Go: "VPOPCNTB", CPUID: "AVX512BITALG,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 54 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPOPCNTB", Go: "VPOPCNTB", CPUID: "AVX512BITALG,AVX512F", Encoding: "EVEX.512.66.0F38.W0 54 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPOPCNTB", Go: "VPOPCNTB", CPUID: "AVX512BITALG,AVX512F", Encoding: "EVEX.512.66.0F38.W0 54 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPOPCNTW", Go: "VPOPCNTW", CPUID: "AVX512BITALG,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 54 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPOPCNTW", Go: "VPOPCNTW", CPUID: "AVX512BITALG,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 54 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPOPCNTW", Go: "VPOPCNTW", CPUID: "AVX512BITALG,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 54 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPOPCNTW", Go: "VPOPCNTW", CPUID: "AVX512BITALG,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 54 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPOPCNTW", Go: "VPOPCNTW", CPUID: "AVX512BITALG,AVX512F", Encoding: "EVEX.512.66.0F38.W1 54 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPOPCNTW", Go: "VPOPCNTW", CPUID: "AVX512BITALG,AVX512F", Encoding: "EVEX.512.66.0F38.W1 54 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPSHUFBITQMB", Go: "VPSHUFBITQMB", CPUID: "AVX512BITALG,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 8F /r", Operands: "k, {k}, xmm, xmm"},
+	{Intel: "VPSHUFBITQMB", Go: "VPSHUFBITQMB", CPUID: "AVX512BITALG,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 8F /r", Operands: "k, {k}, xmm, m"},
+	{Intel: "VPSHUFBITQMB", Go: "VPSHUFBITQMB", CPUID: "AVX512BITALG,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 8F /r", Operands: "k, {k}, ymm, ymm"},
+	{Intel: "VPSHUFBITQMB", Go: "VPSHUFBITQMB", CPUID: "AVX512BITALG,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 8F /r", Operands: "k, {k}, ymm, m"},
+	{Intel: "VPSHUFBITQMB", Go: "VPSHUFBITQMB", CPUID: "AVX512BITALG,AVX512F", Encoding: "EVEX.512.66.0F38.W0 8F /r", Operands: "k, {k}, zmm, zmm"},
+	{Intel: "VPSHUFBITQMB", Go: "VPSHUFBITQMB", CPUID: "AVX512BITALG,AVX512F", Encoding: "EVEX.512.66.0F38.W0 8F /r", Operands: "k, {k}, zmm, m"},
+	{Intel: "VPCOMPRESSB", Go: "VPCOMPRESSB", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 63 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPCOMPRESSB", Go: "VPCOMPRESSB", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 63 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPCOMPRESSB", Go: "VPCOMPRESSB", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 63 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPCOMPRESSB", Go: "VPCOMPRESSB", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 63 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPCOMPRESSB", Go: "VPCOMPRESSB", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W0 63 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPCOMPRESSB", Go: "VPCOMPRESSB", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W0 63 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPCOMPRESSW", Go: "VPCOMPRESSW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 63 /r", Operands: "m, {k}, xmm"},
+	{Intel: "VPCOMPRESSW", Go: "VPCOMPRESSW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 63 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPCOMPRESSW", Go: "VPCOMPRESSW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 63 /r", Operands: "m, {k}, ymm"},
+	{Intel: "VPCOMPRESSW", Go: "VPCOMPRESSW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 63 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPCOMPRESSW", Go: "VPCOMPRESSW", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W1 63 /r", Operands: "m, {k}, zmm"},
+	{Intel: "VPCOMPRESSW", Go: "VPCOMPRESSW", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W1 63 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPEXPANDB", Go: "VPEXPANDB", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 62 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPEXPANDB", Go: "VPEXPANDB", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 62 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPEXPANDB", Go: "VPEXPANDB", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 62 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPEXPANDB", Go: "VPEXPANDB", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 62 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPEXPANDB", Go: "VPEXPANDB", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W0 62 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPEXPANDB", Go: "VPEXPANDB", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W0 62 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPEXPANDW", Go: "VPEXPANDW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 62 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPEXPANDW", Go: "VPEXPANDW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 62 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPEXPANDW", Go: "VPEXPANDW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 62 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPEXPANDW", Go: "VPEXPANDW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 62 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPEXPANDW", Go: "VPEXPANDW", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W1 62 /r", Operands: "zmm, {k}, m"},
+	{Intel: "VPEXPANDW", Go: "VPEXPANDW", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W1 62 /r", Operands: "zmm, {k}, zmm"},
+	{Intel: "VPSHLDD", Go: "VPSHLDD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 71 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VPSHLDD", Go: "VPSHLDD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 71 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VPSHLDD", Go: "VPSHLDD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 71 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VPSHLDD", Go: "VPSHLDD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 71 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VPSHLDD", Go: "VPSHLDD", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 71 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VPSHLDD", Go: "VPSHLDD", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 71 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VPSHLDQ", Go: "VPSHLDQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 71 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VPSHLDQ", Go: "VPSHLDQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 71 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VPSHLDQ", Go: "VPSHLDQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 71 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VPSHLDQ", Go: "VPSHLDQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 71 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VPSHLDQ", Go: "VPSHLDQ", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 71 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VPSHLDQ", Go: "VPSHLDQ", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 71 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VPSHLDVD", Go: "VPSHLDVD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 71 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSHLDVD", Go: "VPSHLDVD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 71 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSHLDVD", Go: "VPSHLDVD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 71 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSHLDVD", Go: "VPSHLDVD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 71 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSHLDVD", Go: "VPSHLDVD", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W0 71 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSHLDVD", Go: "VPSHLDVD", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W0 71 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSHLDVQ", Go: "VPSHLDVQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 71 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSHLDVQ", Go: "VPSHLDVQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 71 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSHLDVQ", Go: "VPSHLDVQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 71 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSHLDVQ", Go: "VPSHLDVQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 71 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSHLDVQ", Go: "VPSHLDVQ", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W1 71 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSHLDVQ", Go: "VPSHLDVQ", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W1 71 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSHLDVW", Go: "VPSHLDVW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 70 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSHLDVW", Go: "VPSHLDVW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 70 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSHLDVW", Go: "VPSHLDVW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 70 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSHLDVW", Go: "VPSHLDVW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 70 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSHLDVW", Go: "VPSHLDVW", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W1 70 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSHLDVW", Go: "VPSHLDVW", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W1 70 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSHLDW", Go: "VPSHLDW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 70 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VPSHLDW", Go: "VPSHLDW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 70 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VPSHLDW", Go: "VPSHLDW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 70 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VPSHLDW", Go: "VPSHLDW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 70 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VPSHLDW", Go: "VPSHLDW", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 70 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VPSHLDW", Go: "VPSHLDW", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 70 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VPSHRDD", Go: "VPSHRDD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 73 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VPSHRDD", Go: "VPSHRDD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W0 73 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VPSHRDD", Go: "VPSHRDD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 73 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VPSHRDD", Go: "VPSHRDD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W0 73 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VPSHRDD", Go: "VPSHRDD", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 73 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VPSHRDD", Go: "VPSHRDD", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F3A.W0 73 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VPSHRDQ", Go: "VPSHRDQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 73 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VPSHRDQ", Go: "VPSHRDQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 73 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VPSHRDQ", Go: "VPSHRDQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 73 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VPSHRDQ", Go: "VPSHRDQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 73 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VPSHRDQ", Go: "VPSHRDQ", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 73 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VPSHRDQ", Go: "VPSHRDQ", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 73 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VPSHRDVD", Go: "VPSHRDVD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 73 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSHRDVD", Go: "VPSHRDVD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 73 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSHRDVD", Go: "VPSHRDVD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 73 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSHRDVD", Go: "VPSHRDVD", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 73 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSHRDVD", Go: "VPSHRDVD", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W0 73 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSHRDVD", Go: "VPSHRDVD", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W0 73 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSHRDVQ", Go: "VPSHRDVQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 73 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSHRDVQ", Go: "VPSHRDVQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 73 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSHRDVQ", Go: "VPSHRDVQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 73 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSHRDVQ", Go: "VPSHRDVQ", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 73 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSHRDVQ", Go: "VPSHRDVQ", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W1 73 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSHRDVQ", Go: "VPSHRDVQ", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W1 73 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSHRDVW", Go: "VPSHRDVW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 72 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPSHRDVW", Go: "VPSHRDVW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 72 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPSHRDVW", Go: "VPSHRDVW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 72 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPSHRDVW", Go: "VPSHRDVW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 72 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPSHRDVW", Go: "VPSHRDVW", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W1 72 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPSHRDVW", Go: "VPSHRDVW", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F38.W1 72 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPSHRDW", Go: "VPSHRDW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 72 /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VPSHRDW", Go: "VPSHRDW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 72 /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VPSHRDW", Go: "VPSHRDW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 72 /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VPSHRDW", Go: "VPSHRDW", CPUID: "AVX512VBMI2,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 72 /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VPSHRDW", Go: "VPSHRDW", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 72 /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VPSHRDW", Go: "VPSHRDW", CPUID: "AVX512VBMI2,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 72 /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VPDPBUSD", Go: "VPDPBUSD", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 50 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPDPBUSD", Go: "VPDPBUSD", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 50 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPDPBUSD", Go: "VPDPBUSD", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 50 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPDPBUSD", Go: "VPDPBUSD", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 50 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPDPBUSD", Go: "VPDPBUSD", CPUID: "AVX512VNNI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 50 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPDPBUSD", Go: "VPDPBUSD", CPUID: "AVX512VNNI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 50 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPDPBUSDS", Go: "VPDPBUSDS", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 51 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPDPBUSDS", Go: "VPDPBUSDS", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 51 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPDPBUSDS", Go: "VPDPBUSDS", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 51 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPDPBUSDS", Go: "VPDPBUSDS", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 51 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPDPBUSDS", Go: "VPDPBUSDS", CPUID: "AVX512VNNI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 51 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPDPBUSDS", Go: "VPDPBUSDS", CPUID: "AVX512VNNI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 51 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPDPWSSD", Go: "VPDPWSSD", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 52 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPDPWSSD", Go: "VPDPWSSD", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 52 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPDPWSSD", Go: "VPDPWSSD", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 52 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPDPWSSD", Go: "VPDPWSSD", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 52 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPDPWSSD", Go: "VPDPWSSD", CPUID: "AVX512VNNI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 52 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPDPWSSD", Go: "VPDPWSSD", CPUID: "AVX512VNNI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 52 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VPDPWSSDS", Go: "VPDPWSSDS", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 53 /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VPDPWSSDS", Go: "VPDPWSSDS", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 53 /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VPDPWSSDS", Go: "VPDPWSSDS", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 53 /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VPDPWSSDS", Go: "VPDPWSSDS", CPUID: "AVX512VNNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 53 /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VPDPWSSDS", Go: "VPDPWSSDS", CPUID: "AVX512VNNI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 53 /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VPDPWSSDS", Go: "VPDPWSSDS", CPUID: "AVX512VNNI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 53 /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "GF2P8AFFINEINVQB", Go: "GF2P8AFFINEINVQB", CPUID: "GFNI", Encoding: "66 0F 3A CF /r ib", Operands: "xmm, xmm, imm8"},
+	{Intel: "GF2P8AFFINEINVQB", Go: "GF2P8AFFINEINVQB", CPUID: "GFNI", Encoding: "66 0F 3A CF /r ib", Operands: "xmm, m, imm8"},
+	{Intel: "GF2P8AFFINEQB", Go: "GF2P8AFFINEQB", CPUID: "GFNI", Encoding: "66 0F 3A CE /r ib", Operands: "xmm, xmm, imm8"},
+	{Intel: "GF2P8AFFINEQB", Go: "GF2P8AFFINEQB", CPUID: "GFNI", Encoding: "66 0F 3A CE /r ib", Operands: "xmm, m, imm8"},
+	{Intel: "GF2P8MULB", Go: "GF2P8MULB", CPUID: "GFNI", Encoding: "66 0F 38 CF /r", Operands: "xmm, xmm"},
+	{Intel: "GF2P8MULB", Go: "GF2P8MULB", CPUID: "GFNI", Encoding: "66 0F 38 CF /r", Operands: "xmm, m"},
+	{Intel: "VGF2P8AFFINEINVQB", Go: "VGF2P8AFFINEINVQB", CPUID: "GFNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 CF /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VGF2P8AFFINEINVQB", Go: "VGF2P8AFFINEINVQB", CPUID: "GFNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 CF /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VGF2P8AFFINEINVQB", Go: "VGF2P8AFFINEINVQB", CPUID: "GFNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 CF /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VGF2P8AFFINEINVQB", Go: "VGF2P8AFFINEINVQB", CPUID: "GFNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 CF /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VGF2P8AFFINEINVQB", Go: "VGF2P8AFFINEINVQB", CPUID: "GFNI,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 CF /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VGF2P8AFFINEINVQB", Go: "VGF2P8AFFINEINVQB", CPUID: "GFNI,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 CF /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VGF2P8AFFINEQB", Go: "VGF2P8AFFINEQB", CPUID: "GFNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 CE /r ib", Operands: "xmm, {k}, xmm, xmm, imm8"},
+	{Intel: "VGF2P8AFFINEQB", Go: "VGF2P8AFFINEQB", CPUID: "GFNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.W1 CE /r ib", Operands: "xmm, {k}, xmm, m, imm8"},
+	{Intel: "VGF2P8AFFINEQB", Go: "VGF2P8AFFINEQB", CPUID: "GFNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 CE /r ib", Operands: "ymm, {k}, ymm, ymm, imm8"},
+	{Intel: "VGF2P8AFFINEQB", Go: "VGF2P8AFFINEQB", CPUID: "GFNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.W1 CE /r ib", Operands: "ymm, {k}, ymm, m, imm8"},
+	{Intel: "VGF2P8AFFINEQB", Go: "VGF2P8AFFINEQB", CPUID: "GFNI,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 CE /r ib", Operands: "zmm, {k}, zmm, zmm, imm8"},
+	{Intel: "VGF2P8AFFINEQB", Go: "VGF2P8AFFINEQB", CPUID: "GFNI,AVX512F", Encoding: "EVEX.512.66.0F3A.W1 CE /r ib", Operands: "zmm, {k}, zmm, m, imm8"},
+	{Intel: "VGF2P8MULB", Go: "VGF2P8MULB", CPUID: "GFNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 CF /r", Operands: "xmm, {k}, xmm, xmm"},
+	{Intel: "VGF2P8MULB", Go: "VGF2P8MULB", CPUID: "GFNI,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 CF /r", Operands: "xmm, {k}, xmm, m"},
+	{Intel: "VGF2P8MULB", Go: "VGF2P8MULB", CPUID: "GFNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 CF /r", Operands: "ymm, {k}, ymm, ymm"},
+	{Intel: "VGF2P8MULB", Go: "VGF2P8MULB", CPUID: "GFNI,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 CF /r", Operands: "ymm, {k}, ymm, m"},
+	{Intel: "VGF2P8MULB", Go: "VGF2P8MULB", CPUID: "GFNI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 CF /r", Operands: "zmm, {k}, zmm, zmm"},
+	{Intel: "VGF2P8MULB", Go: "VGF2P8MULB", CPUID: "GFNI,AVX512F", Encoding: "EVEX.512.66.0F38.W0 CF /r", Operands: "zmm, {k}, zmm, m"},
+	{Intel: "VGF2P8AFFINEINVQB", Go: "VGF2P8AFFINEINVQB", CPUID: "GFNI,AVX", Encoding: "VEX.128.66.0F3A.W1 CF /r ib", Operands: "xmm, xmm, xmm, imm8"},
+	{Intel: "VGF2P8AFFINEINVQB", Go: "VGF2P8AFFINEINVQB", CPUID: "GFNI,AVX", Encoding: "VEX.128.66.0F3A.W1 CF /r ib", Operands: "xmm, xmm, m, imm8"},
+	{Intel: "VGF2P8AFFINEINVQB", Go: "VGF2P8AFFINEINVQB", CPUID: "GFNI,AVX", Encoding: "VEX.256.66.0F3A.W1 CF /r ib", Operands: "ymm, ymm, ymm, imm8"},
+	{Intel: "VGF2P8AFFINEINVQB", Go: "VGF2P8AFFINEINVQB", CPUID: "GFNI,AVX", Encoding: "VEX.256.66.0F3A.W1 CF /r ib", Operands: "ymm, ymm, m, imm8"},
+	{Intel: "VGF2P8AFFINEQB", Go: "VGF2P8AFFINEQB", CPUID: "GFNI,AVX", Encoding: "VEX.128.66.0F3A.W1 CE /r ib", Operands: "xmm, xmm, xmm, imm8"},
+	{Intel: "VGF2P8AFFINEQB", Go: "VGF2P8AFFINEQB", CPUID: "GFNI,AVX", Encoding: "VEX.128.66.0F3A.W1 CE /r ib", Operands: "xmm, xmm, m, imm8"},
+	{Intel: "VGF2P8AFFINEQB", Go: "VGF2P8AFFINEQB", CPUID: "GFNI,AVX", Encoding: "VEX.256.66.0F3A.W1 CE /r ib", Operands: "ymm, ymm, ymm, imm8"},
+	{Intel: "VGF2P8AFFINEQB", Go: "VGF2P8AFFINEQB", CPUID: "GFNI,AVX", Encoding: "VEX.256.66.0F3A.W1 CE /r ib", Operands: "ymm, ymm, m, imm8"},
+	{Intel: "VGF2P8MULB", Go: "VGF2P8MULB", CPUID: "GFNI,AVX", Encoding: "VEX.128.66.0F38.W0 CF /r", Operands: "xmm, xmm, xmm"},
+	{Intel: "VGF2P8MULB", Go: "VGF2P8MULB", CPUID: "GFNI,AVX", Encoding: "VEX.128.66.0F38.W0 CF /r", Operands: "xmm, xmm, m"},
+	{Intel: "VGF2P8MULB", Go: "VGF2P8MULB", CPUID: "GFNI,AVX", Encoding: "VEX.256.66.0F38.W0 CF /r", Operands: "ymm, ymm, ymm"},
+	{Intel: "VGF2P8MULB", Go: "VGF2P8MULB", CPUID: "GFNI,AVX", Encoding: "VEX.256.66.0F38.W0 CF /r", Operands: "ymm, ymm, m"},
+	{Intel: "VAESDEC", Go: "VAESDEC", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG DE /r", Operands: "xmm, xmm, xmm"},
+	{Intel: "VAESDEC", Go: "VAESDEC", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG DE /r", Operands: "xmm, xmm, m"},
+	{Intel: "VAESDEC", Go: "VAESDEC", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG DE /r", Operands: "ymm, ymm, ymm"},
+	{Intel: "VAESDEC", Go: "VAESDEC", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG DE /r", Operands: "ymm, ymm, m"},
+	{Intel: "VAESDEC", Go: "VAESDEC", CPUID: "VAES,AVX512F", Encoding: "EVEX.512.66.0F38.WIG DE /r", Operands: "zmm, zmm, zmm"},
+	{Intel: "VAESDEC", Go: "VAESDEC", CPUID: "VAES,AVX512F", Encoding: "EVEX.512.66.0F38.WIG DE /r", Operands: "zmm, zmm, m"},
+	{Intel: "VAESDECLAST", Go: "VAESDECLAST", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG DF /r", Operands: "xmm, xmm, xmm"},
+	{Intel: "VAESDECLAST", Go: "VAESDECLAST", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG DF /r", Operands: "xmm, xmm, m"},
+	{Intel: "VAESDECLAST", Go: "VAESDECLAST", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG DF /r", Operands: "ymm, ymm, ymm"},
+	{Intel: "VAESDECLAST", Go: "VAESDECLAST", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG DF /r", Operands: "ymm, ymm, m"},
+	{Intel: "VAESDECLAST", Go: "VAESDECLAST", CPUID: "VAES,AVX512F", Encoding: "EVEX.512.66.0F38.WIG DF /r", Operands: "zmm, zmm, zmm"},
+	{Intel: "VAESDECLAST", Go: "VAESDECLAST", CPUID: "VAES,AVX512F", Encoding: "EVEX.512.66.0F38.WIG DF /r", Operands: "zmm, zmm, m"},
+	{Intel: "VAESENC", Go: "VAESENC", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG DC /r", Operands: "xmm, xmm, xmm"},
+	{Intel: "VAESENC", Go: "VAESENC", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG DC /r", Operands: "xmm, xmm, m"},
+	{Intel: "VAESENC", Go: "VAESENC", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG DC /r", Operands: "ymm, ymm, ymm"},
+	{Intel: "VAESENC", Go: "VAESENC", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG DC /r", Operands: "ymm, ymm, m"},
+	{Intel: "VAESENC", Go: "VAESENC", CPUID: "VAES,AVX512F", Encoding: "EVEX.512.66.0F38.WIG DC /r", Operands: "zmm, zmm, zmm"},
+	{Intel: "VAESENC", Go: "VAESENC", CPUID: "VAES,AVX512F", Encoding: "EVEX.512.66.0F38.WIG DC /r", Operands: "zmm, zmm, m"},
+	{Intel: "VAESENCLAST", Go: "VAESENCLAST", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG DD /r", Operands: "xmm, xmm, xmm"},
+	{Intel: "VAESENCLAST", Go: "VAESENCLAST", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.WIG DD /r", Operands: "xmm, xmm, m"},
+	{Intel: "VAESENCLAST", Go: "VAESENCLAST", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG DD /r", Operands: "ymm, ymm, ymm"},
+	{Intel: "VAESENCLAST", Go: "VAESENCLAST", CPUID: "VAES,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.WIG DD /r", Operands: "ymm, ymm, m"},
+	{Intel: "VAESENCLAST", Go: "VAESENCLAST", CPUID: "VAES,AVX512F", Encoding: "EVEX.512.66.0F38.WIG DD /r", Operands: "zmm, zmm, zmm"},
+	{Intel: "VAESENCLAST", Go: "VAESENCLAST", CPUID: "VAES,AVX512F", Encoding: "EVEX.512.66.0F38.WIG DD /r", Operands: "zmm, zmm, m"},
+	{Intel: "VPCLMULQDQ", Go: "VPCLMULQDQ", CPUID: "VPCLMULQDQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.WIG 44 /r ib", Operands: "xmm, xmm, xmm, imm8"},
+	{Intel: "VPCLMULQDQ", Go: "VPCLMULQDQ", CPUID: "VPCLMULQDQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F3A.WIG 44 /r ib", Operands: "xmm, xmm, m, imm8"},
+	{Intel: "VPCLMULQDQ", Go: "VPCLMULQDQ", CPUID: "VPCLMULQDQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.WIG 44 /r ib", Operands: "ymm, ymm, ymm, imm8"},
+	{Intel: "VPCLMULQDQ", Go: "VPCLMULQDQ", CPUID: "VPCLMULQDQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F3A.WIG 44 /r ib", Operands: "ymm, ymm, m, imm8"},
+	{Intel: "VPCLMULQDQ", Go: "VPCLMULQDQ", CPUID: "VPCLMULQDQ,AVX512F", Encoding: "EVEX.512.66.0F3A.WIG 44 /r ib", Operands: "zmm, zmm, zmm, imm8"},
+	{Intel: "VPCLMULQDQ", Go: "VPCLMULQDQ", CPUID: "VPCLMULQDQ,AVX512F", Encoding: "EVEX.512.66.0F3A.WIG 44 /r ib", Operands: "zmm, zmm, m, imm8"},
+	{Intel: "VAESDEC", Go: "VAESDEC", CPUID: "VAES,AVX", Encoding: "VEX.256.66.0F38.WIG DE /r", Operands: "ymm, ymm, ymm"},
+	{Intel: "VAESDEC", Go: "VAESDEC", CPUID: "VAES,AVX", Encoding: "VEX.256.66.0F38.WIG DE /r", Operands: "ymm, ymm, m"},
+	{Intel: "VAESDECLAST", Go: "VAESDECLAST", CPUID: "VAES,AVX", Encoding: "VEX.256.66.0F38.WIG DF /r", Operands: "ymm, ymm, ymm"},
+	{Intel: "VAESDECLAST", Go: "VAESDECLAST", CPUID: "VAES,AVX", Encoding: "VEX.256.66.0F38.WIG DF /r", Operands: "ymm, ymm, m"},
+	{Intel: "VAESENC", Go: "VAESENC", CPUID: "VAES,AVX", Encoding: "VEX.256.66.0F38.WIG DC /r", Operands: "ymm, ymm, ymm"},
+	{Intel: "VAESENC", Go: "VAESENC", CPUID: "VAES,AVX", Encoding: "VEX.256.66.0F38.WIG DC /r", Operands: "ymm, ymm, m"},
+	{Intel: "VAESENCLAST", Go: "VAESENCLAST", CPUID: "VAES,AVX", Encoding: "VEX.256.66.0F38.WIG DD /r", Operands: "ymm, ymm, ymm"},
+	{Intel: "VAESENCLAST", Go: "VAESENCLAST", CPUID: "VAES,AVX", Encoding: "VEX.256.66.0F38.WIG DD /r", Operands: "ymm, ymm, m"},
+	{Intel: "VPCLMULQDQ", Go: "VPCLMULQDQ", CPUID: "VPCLMULQDQ,AVX", Encoding: "VEX.256.66.0F3A.WIG 44 /r ib", Operands: "ymm, ymm, ymm, imm8"},
+	{Intel: "VPCLMULQDQ", Go: "VPCLMULQDQ", CPUID: "VPCLMULQDQ,AVX", Encoding: "VEX.256.66.0F3A.WIG 44 /r ib", Operands: "ymm, ymm, m, imm8"},
+	{Intel: "VPOPCNTD", Go: "VPOPCNTD", CPUID: "AVX512VPOPCNTDQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 55 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPOPCNTD", Go: "VPOPCNTD", CPUID: "AVX512VPOPCNTDQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W0 55 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPOPCNTD", Go: "VPOPCNTD", CPUID: "AVX512VPOPCNTDQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 55 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPOPCNTD", Go: "VPOPCNTD", CPUID: "AVX512VPOPCNTDQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W0 55 /r", Operands: "ymm, {k}, m"},
+	{Intel: "VPOPCNTQ", Go: "VPOPCNTQ", CPUID: "AVX512VPOPCNTDQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 55 /r", Operands: "xmm, {k}, xmm"},
+	{Intel: "VPOPCNTQ", Go: "VPOPCNTQ", CPUID: "AVX512VPOPCNTDQ,AVX512F,AVX512VL", Encoding: "EVEX.128.66.0F38.W1 55 /r", Operands: "xmm, {k}, m"},
+	{Intel: "VPOPCNTQ", Go: "VPOPCNTQ", CPUID: "AVX512VPOPCNTDQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 55 /r", Operands: "ymm, {k}, ymm"},
+	{Intel: "VPOPCNTQ", Go: "VPOPCNTQ", CPUID: "AVX512VPOPCNTDQ,AVX512F,AVX512VL", Encoding: "EVEX.256.66.0F38.W1 55 /r", Operands: "ymm, {k}, m"},
+	{Intel: "RDPID", Go: "RDPID", CPUID: "RDPID", Encoding: "F3 0F C7 /7", Operands: "r32"},
+	{Intel: "RDPID", Go: "RDPID", CPUID: "RDPID", Encoding: "F3 0F C7 /7", Operands: "r64"},
+	{Intel: "PTWRITE", Go: "PTWRITE", CPUID: "PT", Encoding: "F3 0F AE /4", Operands: "r"},
+	{Intel: "PTWRITE", Go: "PTWRITE", CPUID: "PT", Encoding: "F3 0F AE /4", Operands: "m"},
+	{Intel: "CLDEMOTE", Go: "", CPUID: "CLDEMOTE", Encoding: "NP 0F 1C /0", Operands: "m8"},
+	{Intel: "MOVDIR64B", Go: "", CPUID: "MOVDIR64B", Encoding: "66 0F 38 F8 /r", Operands: "r64, m512"},
+	{Intel: "MOVDIRI", Go: "", CPUID: "MOVDIRI", Encoding: "NP 0F 38 F9 /r", Operands: "m32, r32"},
+	{Intel: "MOVDIRI", Go: "", CPUID: "MOVDIRI", Encoding: "NP REX.W 0F 38 F9 /r", Operands: "m64, r64"},
+	{Intel: "SERIALIZE", Go: "", CPUID: "SERIALIZE", Encoding: "NP 0F 01 E8", Operands: ""},
+	{Intel: "LDTILECFG", Go: "", CPUID: "AMXTILE", Encoding: "VEX.128.NP.0F38.W0 49 /0", Operands: "m512"},
+	{Intel: "STTILECFG", Go: "", CPUID: "AMXTILE", Encoding: "VEX.128.66.0F38.W0 49 /0", Operands: "m512"},
+	{Intel: "TILERELEASE", Go: "", CPUID: "AMXTILE", Encoding: "VEX.128.NP.0F38.W0 49 C0", Operands: ""},
+	{Intel: "TILEZERO", Go: "", CPUID: "AMXTILE", Encoding: "VEX.128.F2.0F38.W0 49 /r", Operands: "tmm1"},
+	{Intel: "TILELOADD", Go: "", CPUID: "AMXTILE", Encoding: "VEX.128.F2.0F38.W0 4B /r", Operands: "tmm1, sibmem"},
+	{Intel: "TILELOADDT1", Go: "", CPUID: "AMXTILE", Encoding: "VEX.128.66.0F38.W0 4B /r", Operands: "tmm1, sibmem"},
+	{Intel: "TILESTORED", Go: "", CPUID: "AMXTILE", Encoding: "VEX.128.F3.0F38.W0 4B /r", Operands: "sibmem, tmm1"},
+	{Intel: "TDPBF16PS", Go: "", CPUID: "AMXBF16", Encoding: "VEX.128.F3.0F38.W0 5C /r", Operands: "tmm1, tmm2, tmm3"},
+	{Intel: "TDPBSSD", Go: "", CPUID: "AMXINT8", Encoding: "VEX.128.F2.0F38.W0 5E /r", Operands: "tmm1, tmm2, tmm3"},
+	{Intel: "TDPBSUD", Go: "", CPUID: "AMXINT8", Encoding: "VEX.128.F3.0F38.W0 5E /r", Operands: "tmm1, tmm2, tmm3"},
+	{Intel: "TDPBUSD", Go: "", CPUID: "AMXINT8", Encoding: "VEX.128.66.0F38.W0 5E /r", Operands: "tmm1, tmm2, tmm3"},
+	{Intel: "TDPBUUD", Go: "", CPUID: "AMXINT8", Encoding: "VEX.128.NP.0F38.W0 5E /r", Operands: "tmm1, tmm2, tmm3"},
+	{Intel: "PUSH2", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.W0 FF /6", Operands: "r64, r64"},
+	{Intel: "PUSH2P", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.W1 FF /6", Operands: "r64, r64"},
+	{Intel: "POP2", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.W0 8F /0", Operands: "r64, r64"},
+	{Intel: "POP2P", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.W1 8F /0", Operands: "r64, r64"},
+	{Intel: "PUSHP", Go: "", CPUID: "APXF", Encoding: "REX2.W1 50+rd", Operands: "r64"},
+	{Intel: "POPP", Go: "", CPUID: "APXF", Encoding: "REX2.W1 58+rd", Operands: "r64"},
+	{Intel: "JMPABS", Go: "", CPUID: "APXF", Encoding: "REX2.M0.W0 A1 i64", Operands: "imm64"},
+	{Intel: "VPDPBUSD", Go: "", CPUID: "AVXVNNI,AVX", Encoding: "VEX.128.66.0F38.W0 50 /r", Operands: "xmm1, xmm2, xmm3/m128"},
+	{Intel: "VPDPBUSD", Go: "", CPUID: "AVXVNNI,AVX", Encoding: "VEX.256.66.0F38.W0 50 /r", Operands: "ymm1, ymm2, ymm3/m256"},
+	{Intel: "VPDPBUSDS", Go: "", CPUID: "AVXVNNI,AVX", Encoding: "VEX.128.66.0F38.W0 51 /r", Operands: "xmm1, xmm2, xmm3/m128"},
+	{Intel: "VPDPBUSDS", Go: "", CPUID: "AVXVNNI,AVX", Encoding: "VEX.256.66.0F38.W0 51 /r", Operands: "ymm1, ymm2, ymm3/m256"},
+	{Intel: "VPDPWSSD", Go: "", CPUID: "AVXVNNI,AVX", Encoding: "VEX.128.66.0F38.W0 52 /r", Operands: "xmm1, xmm2, xmm3/m128"},
+	{Intel: "VPDPWSSD", Go: "", CPUID: "AVXVNNI,AVX", Encoding: "VEX.256.66.0F38.W0 52 /r", Operands: "ymm1, ymm2, ymm3/m256"},
+	{Intel: "VPDPWSSDS", Go: "", CPUID: "AVXVNNI,AVX", Encoding: "VEX.128.66.0F38.W0 53 /r", Operands: "xmm1, xmm2, xmm3/m128"},
+	{Intel: "VPDPWSSDS", Go: "", CPUID: "AVXVNNI,AVX", Encoding: "VEX.256.66.0F38.W0 53 /r", Operands: "ymm1, ymm2, ymm3/m256"},
+	{Intel: "VPMADD52LUQ", Go: "", CPUID: "AVXIFMA,AVX", Encoding: "VEX.128.66.0F38.W1 B4 /r", Operands: "xmm1, xmm2, xmm3/m128"},
+	{Intel: "VPMADD52LUQ", Go: "", CPUID: "AVXIFMA,AVX", Encoding: "VEX.256.66.0F38.W1 B4 /r", Operands: "ymm1, ymm2, ymm3/m256"},
+	{Intel: "VPMADD52HUQ", Go: "", CPUID: "AVXIFMA,AVX", Encoding: "VEX.128.66.0F38.W1 B5 /r", Operands: "xmm1, xmm2, xmm3/m128"},
+	{Intel: "VPMADD52HUQ", Go: "", CPUID: "AVXIFMA,AVX", Encoding: "VEX.256.66.0F38.W1 B5 /r", Operands: "ymm1, ymm2, ymm3/m256"},
+	{Intel: "VADDPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.MAP5.W0 58 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VADDPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.MAP5.W0 58 /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VADDPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.MAP5.W0 58 /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VSUBPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.MAP5.W0 5C /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VSUBPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.MAP5.W0 5C /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VSUBPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.MAP5.W0 5C /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VMULPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.MAP5.W0 59 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VMULPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.MAP5.W0 59 /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VMULPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.MAP5.W0 59 /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VDIVPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.MAP5.W0 5E /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VDIVPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.MAP5.W0 5E /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VDIVPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.MAP5.W0 5E /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VMINPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.MAP5.W0 5D /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VMINPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.MAP5.W0 5D /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VMINPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.MAP5.W0 5D /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VMAXPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.MAP5.W0 5F /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VMAXPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.MAP5.W0 5F /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VMAXPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.MAP5.W0 5F /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VSQRTPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.MAP5.W0 51 /r", Operands: "xmm1{k1}{z}, xmm2/m128/m16bcst"},
+	{Intel: "VSQRTPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.MAP5.W0 51 /r", Operands: "ymm1{k1}{z}, ymm2/m256/m16bcst"},
+	{Intel: "VSQRTPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.MAP5.W0 51 /r", Operands: "zmm1{k1}{z}, zmm2/m512/m16bcst"},
+	{Intel: "VCMPPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.0F3A.W0 C2 /r ib", Operands: "k1{k2}, xmm2, xmm3/m128/m16bcst, imm8"},
+	{Intel: "VCMPPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.0F3A.W0 C2 /r ib", Operands: "k1{k2}, ymm2, ymm3/m256/m16bcst, imm8"},
+	{Intel: "VCMPPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.0F3A.W0 C2 /r ib", Operands: "k1{k2}, zmm2, zmm3/m512/m16bcst, imm8"},
+	{Intel: "VFPCLASSPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.0F3A.W0 66 /r ib", Operands: "k1{k2}, xmm2/m128/m16bcst, imm8"},
+	{Intel: "VFPCLASSPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.0F3A.W0 66 /r ib", Operands: "k1{k2}, ymm2/m256/m16bcst, imm8"},
+	{Intel: "VFPCLASSPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.0F3A.W0 66 /r ib", Operands: "k1{k2}, zmm2/m512/m16bcst, imm8"},
+	{Intel: "VGETMANTPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.0F3A.W0 26 /r ib", Operands: "xmm1{k1}{z}, xmm2/m128/m16bcst, imm8"},
+	{Intel: "VGETMANTPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.0F3A.W0 26 /r ib", Operands: "ymm1{k1}{z}, ymm2/m256/m16bcst, imm8"},
+	{Intel: "VGETMANTPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.0F3A.W0 26 /r ib", Operands: "zmm1{k1}{z}, zmm2/m512/m16bcst, imm8"},
+	{Intel: "VREDUCEPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.0F3A.W0 56 /r ib", Operands: "xmm1{k1}{z}, xmm2/m128/m16bcst, imm8"},
+	{Intel: "VREDUCEPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.0F3A.W0 56 /r ib", Operands: "ymm1{k1}{z}, ymm2/m256/m16bcst, imm8"},
+	{Intel: "VREDUCEPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.0F3A.W0 56 /r ib", Operands: "zmm1{k1}{z}, zmm2/m512/m16bcst, imm8"},
+	{Intel: "VRNDSCALEPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.0F3A.W0 08 /r ib", Operands: "xmm1{k1}{z}, xmm2/m128/m16bcst, imm8"},
+	{Intel: "VRNDSCALEPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.0F3A.W0 08 /r ib", Operands: "ymm1{k1}{z}, ymm2/m256/m16bcst, imm8"},
+	{Intel: "VRNDSCALEPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.0F3A.W0 08 /r ib", Operands: "zmm1{k1}{z}, zmm2/m512/m16bcst, imm8"},
+	{Intel: "VGETEXPPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 42 /r", Operands: "xmm1{k1}{z}, xmm2/m128/m16bcst"},
+	{Intel: "VGETEXPPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 42 /r", Operands: "ymm1{k1}{z}, ymm2/m256/m16bcst"},
+	{Intel: "VGETEXPPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 42 /r", Operands: "zmm1{k1}{z}, zmm2/m512/m16bcst"},
+	{Intel: "VRCPPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 4C /r", Operands: "xmm1{k1}{z}, xmm2/m128/m16bcst"},
+	{Intel: "VRCPPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 4C /r", Operands: "ymm1{k1}{z}, ymm2/m256/m16bcst"},
+	{Intel: "VRCPPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 4C /r", Operands: "zmm1{k1}{z}, zmm2/m512/m16bcst"},
+	{Intel: "VRSQRTPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 4E /r", Operands: "xmm1{k1}{z}, xmm2/m128/m16bcst"},
+	{Intel: "VRSQRTPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 4E /r", Operands: "ymm1{k1}{z}, ymm2/m256/m16bcst"},
+	{Intel: "VRSQRTPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 4E /r", Operands: "zmm1{k1}{z}, zmm2/m512/m16bcst"},
+	{Intel: "VSCALEFPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 2C /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VSCALEFPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 2C /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VSCALEFPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 2C /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VFMADD132PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 98 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VFMADD132PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 98 /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VFMADD132PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 98 /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VFMADD213PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 A8 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VFMADD213PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 A8 /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VFMADD213PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 A8 /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VFMADD231PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 B8 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VFMADD231PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 B8 /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VFMADD231PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 B8 /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VFMSUB132PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 9A /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VFMSUB132PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 9A /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VFMSUB132PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 9A /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VFMSUB213PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 AA /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VFMSUB213PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 AA /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VFMSUB213PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 AA /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VFMSUB231PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 BA /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VFMSUB231PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 BA /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VFMSUB231PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 BA /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VFNMADD132PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 9C /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VFNMADD132PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 9C /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VFNMADD132PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 9C /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VFNMADD213PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 AC /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VFNMADD213PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 AC /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VFNMADD213PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 AC /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VFNMADD231PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 BC /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VFNMADD231PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 BC /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VFNMADD231PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 BC /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VFNMSUB132PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 9E /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VFNMSUB132PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 9E /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VFNMSUB132PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 9E /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VFNMSUB213PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 AE /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VFNMSUB213PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 AE /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VFNMSUB213PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 AE /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VFNMSUB231PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 BE /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m16bcst"},
+	{Intel: "VFNMSUB231PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 BE /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m16bcst"},
+	{Intel: "VFNMSUB231PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 BE /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m16bcst"},
+	{Intel: "VFMADDCPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.MAP6.W0 56 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m32bcst"},
+	{Intel: "VFMADDCPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.MAP6.W0 56 /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m32bcst"},
+	{Intel: "VFMADDCPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.F3.MAP6.W0 56 /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m32bcst"},
+	{Intel: "VFCMADDCPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.MAP6.W0 56 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m32bcst"},
+	{Intel: "VFCMADDCPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.MAP6.W0 56 /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m32bcst"},
+	{Intel: "VFCMADDCPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.F2.MAP6.W0 56 /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m32bcst"},
+	{Intel: "VFMULCPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.MAP6.W0 D6 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m32bcst"},
+	{Intel: "VFMULCPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.MAP6.W0 D6 /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m32bcst"},
+	{Intel: "VFMULCPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.F3.MAP6.W0 D6 /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m32bcst"},
+	{Intel: "VFCMULCPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.F2.MAP6.W0 D6 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m128/m32bcst"},
+	{Intel: "VFCMULCPH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.F2.MAP6.W0 D6 /r", Operands: "ymm1{k1}{z}, ymm2, ymm3/m256/m32bcst"},
+	{Intel: "VFCMULCPH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.F2.MAP6.W0 D6 /r", Operands: "zmm1{k1}{z}, zmm2, zmm3/m512/m32bcst"},
+	{Intel: "VCVTDQ2PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.MAP5.W0 5B /r", Operands: "xmm1{k1}{z}, xmm2/m128/m32bcst"},
+	{Intel: "VCVTDQ2PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.MAP5.W0 5B /r", Operands: "ymm1{k1}{z}, ymm2/m256/m32bcst"},
+	{Intel: "VCVTDQ2PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.MAP5.W0 5B /r", Operands: "zmm1{k1}{z}, zmm2/m512/m32bcst"},
+	{Intel: "VCVTPH2DQ", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP5.W0 5B /r", Operands: "xmm1{k1}{z}, xmm2/m128/m16bcst"},
+	{Intel: "VCVTPH2DQ", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP5.W0 5B /r", Operands: "ymm1{k1}{z}, ymm2/m256/m16bcst"},
+	{Intel: "VCVTPH2DQ", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP5.W0 5B /r", Operands: "zmm1{k1}{z}, zmm2/m512/m16bcst"},
+	{Intel: "VCVTPD2PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP5.W1 5A /r", Operands: "xmm1{k1}{z}, xmm2/m128/m64bcst"},
+	{Intel: "VCVTPD2PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP5.W1 5A /r", Operands: "ymm1{k1}{z}, ymm2/m256/m64bcst"},
+	{Intel: "VCVTPD2PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP5.W1 5A /r", Operands: "zmm1{k1}{z}, zmm2/m512/m64bcst"},
+	{Intel: "VCVTPH2PD", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.NP.MAP5.W0 5A /r", Operands: "xmm1{k1}{z}, xmm2/m128/m16bcst"},
+	{Intel: "VCVTPH2PD", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.NP.MAP5.W0 5A /r", Operands: "ymm1{k1}{z}, ymm2/m256/m16bcst"},
+	{Intel: "VCVTPH2PD", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.NP.MAP5.W0 5A /r", Operands: "zmm1{k1}{z}, zmm2/m512/m16bcst"},
+	{Intel: "VCVTPH2PSX", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP6.W0 13 /r", Operands: "xmm1{k1}{z}, xmm2/m128/m16bcst"},
+	{Intel: "VCVTPH2PSX", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP6.W0 13 /r", Operands: "ymm1{k1}{z}, ymm2/m256/m16bcst"},
+	{Intel: "VCVTPH2PSX", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP6.W0 13 /r", Operands: "zmm1{k1}{z}, zmm2/m512/m16bcst"},
+	{Intel: "VCVTPS2PHX", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP5.W0 1D /r", Operands: "xmm1{k1}{z}, xmm2/m128/m32bcst"},
+	{Intel: "VCVTPS2PHX", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP5.W0 1D /r", Operands: "ymm1{k1}{z}, ymm2/m256/m32bcst"},
+	{Intel: "VCVTPS2PHX", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP5.W0 1D /r", Operands: "zmm1{k1}{z}, zmm2/m512/m32bcst"},
+	{Intel: "VCVTPH2W", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.66.MAP5.W0 7D /r", Operands: "xmm1{k1}{z}, xmm2/m128/m16bcst"},
+	{Intel: "VCVTPH2W", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.66.MAP5.W0 7D /r", Operands: "ymm1{k1}{z}, ymm2/m256/m16bcst"},
+	{Intel: "VCVTPH2W", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.66.MAP5.W0 7D /r", Operands: "zmm1{k1}{z}, zmm2/m512/m16bcst"},
+	{Intel: "VCVTW2PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.128.F3.MAP5.W0 7D /r", Operands: "xmm1{k1}{z}, xmm2/m128/m16bcst"},
+	{Intel: "VCVTW2PH", Go: "", CPUID: "AVX512FP16,AVX512F,AVX512VL", Encoding: "EVEX.256.F3.MAP5.W0 7D /r", Operands: "ymm1{k1}{z}, ymm2/m256/m16bcst"},
+	{Intel: "VCVTW2PH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.512.F3.MAP5.W0 7D /r", Operands: "zmm1{k1}{z}, zmm2/m512/m16bcst"},
+	{Intel: "VADDSH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.F3.MAP5.W0 58 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{Intel: "VSUBSH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.F3.MAP5.W0 5C /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{Intel: "VMULSH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.F3.MAP5.W0 59 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{Intel: "VDIVSH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.F3.MAP5.W0 5E /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{Intel: "VMINSH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.F3.MAP5.W0 5D /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{Intel: "VMAXSH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.F3.MAP5.W0 5F /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{Intel: "VSQRTSH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.F3.MAP5.W0 51 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{Intel: "VCMPSH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.F3.0F3A.W0 C2 /r ib", Operands: "k1{k2}, xmm2, xmm3/m16, imm8"},
+	{Intel: "VCOMISH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.NP.MAP5.W0 2F /r", Operands: "xmm1, xmm2/m16"},
+	{Intel: "VUCOMISH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.NP.MAP5.W0 2E /r", Operands: "xmm1, xmm2/m16"},
+	{Intel: "VMOVSH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.F3.MAP5.W0 10 /r", Operands: "xmm1{k1}{z}, m16"},
+	{Intel: "VMOVSH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.F3.MAP5.W0 11 /r", Operands: "m16{k1}, xmm1"},
+	{Intel: "VMOVW", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.128.66.MAP5.WIG 6E /r", Operands: "xmm1, r32/m16"},
+	{Intel: "VMOVW", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.128.66.MAP5.WIG 7E /r", Operands: "r32/m16, xmm1"},
+	{Intel: "VCVTSH2SS", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.NP.MAP6.W0 13 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{Intel: "VCVTSS2SH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.NP.MAP5.W0 1D /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m32"},
+	{Intel: "VFMADD132SH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.66.MAP6.W0 99 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{Intel: "VFMADD213SH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.66.MAP6.W0 A9 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{Intel: "VFMADD231SH", Go: "", CPUID: "AVX512FP16,AVX512F", Encoding: "EVEX.LIG.66.MAP6.W0 B9 /r", Operands: "xmm1{k1}{z}, xmm2, xmm3/m16"},
+	{Intel: "CCMPO", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPNO", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPB", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPNB", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPZ", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPNZ", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPBE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPNBE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPS", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPNS", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPT", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPF", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPL", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPNL", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPLE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CCMPNLE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 39 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTO", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTNO", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTB", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTNB", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTZ", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTNZ", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTBE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTNBE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTS", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTNS", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTT", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTF", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTL", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTNL", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTLE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CTESTNLE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4.SCC 85 /r", Operands: "r/m64, r64"},
+	{Intel: "CFCMOVO", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVNO", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVB", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVNB", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVZ", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVNZ", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVBE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVNBE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVS", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVNS", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVP", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVNP", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVL", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVNL", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVLE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "CFCMOVNLE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.NP.MAP4 40+cc /r", Operands: "r64, r/m64"},
+	{Intel: "SETZUO", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUNO", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUB", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUNB", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUZ", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUNZ", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUBE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUNBE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUS", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUNS", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUP", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUNP", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUL", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUNL", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZULE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+	{Intel: "SETZUNLE", Go: "", CPUID: "APXF", Encoding: "EVEX.L0.F2.MAP4 40+cc /r", Operands: "r/m8"},
+}
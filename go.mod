@@ -1,3 +1,8 @@
 module github.com/ahysing/listx86levels
 
 go 1.18
+
+require (
+	github.com/ulikunitz/xz v0.5.12
+	golang.org/x/arch v0.8.0
+)